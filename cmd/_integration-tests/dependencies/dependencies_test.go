@@ -0,0 +1,36 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/dependencies/proto"
+)
+
+func TestNewServiceWithDependencies(t *testing.T) {
+	resp, err := dependenciesEndpoints.Count(context.Background(), &pb.CountRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := resp.Owner, "NewDependencies"; got != want {
+		t.Errorf("Owner = %q, want %q", got, want)
+	}
+}
+
+func TestPointerReceiversKeepState(t *testing.T) {
+	ctx := context.Background()
+
+	first, err := dependenciesEndpoints.Count(ctx, &pb.CountRequest{N: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := dependenciesEndpoints.Count(ctx, &pb.CountRequest{N: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := second.Total-first.Total, int64(3); got != want {
+		t.Errorf("second Total - first Total = %d, want %d", got, want)
+	}
+}
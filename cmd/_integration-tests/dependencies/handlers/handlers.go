@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/dependencies/proto"
+)
+
+// Dependencies holds what the handlers depend on.
+type Dependencies struct {
+	Owner string
+}
+
+// NewService returns an implementation of Service using deps, which keeps
+// the total of its calls.
+func NewService(deps Dependencies) pb.DependenciesTestServer {
+	return &dependenciestestService{deps: deps}
+}
+
+type dependenciestestService struct {
+	deps Dependencies
+
+	mtx   sync.Mutex
+	total int64
+}
+
+// Count implements Service.
+func (s *dependenciestestService) Count(ctx context.Context, in *pb.CountRequest) (*pb.CountResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.total += in.N
+
+	return &pb.CountResponse{
+		Total: s.total,
+		Owner: s.deps.Owner,
+	}, nil
+}
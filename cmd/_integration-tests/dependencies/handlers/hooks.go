@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/metaverse/truss/cmd/_integration-tests/dependencies/dependenciestest-service/svc"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func InterruptHandler(errc chan<- error) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+	terminateError := fmt.Errorf("%s", <-c)
+
+	// Place whatever shutdown handling you want here
+
+	errc <- terminateError
+}
+
+func SetConfig(cfg svc.Config) svc.Config {
+	// Set cfg.Recover to handle the panics of the handlers otherwise than
+	// by logging them
+
+	// Set cfg.Limits to limit the rate or the concurrency of the calls of
+	// some methods, e.g.
+	// cfg.Limits = map[string]svc.Limit{"Search": {Rate: 10, Burst: 20, MaxInFlight: 4}}
+
+	return cfg
+}
+
+func NewDependencies(cfg svc.Config) (Dependencies, error) {
+	return Dependencies{Owner: "NewDependencies"}, nil
+}
@@ -0,0 +1,25 @@
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/metaverse/truss/cmd/_integration-tests/dependencies/dependenciestest-service/handlers"
+	"github.com/metaverse/truss/cmd/_integration-tests/dependencies/dependenciestest-service/svc"
+	"github.com/metaverse/truss/cmd/_integration-tests/dependencies/dependenciestest-service/svc/server"
+)
+
+var dependenciesEndpoints svc.Endpoints
+
+func TestMain(m *testing.M) {
+	// Create the service the way the generated server.Run does
+	deps, err := handlers.NewDependencies(server.DefaultConfig)
+	if err != nil {
+		panic(err)
+	}
+	service := handlers.NewService(deps)
+
+	dependenciesEndpoints = server.NewEndpoints(service, server.DefaultConfig)
+
+	os.Exit(m.Run())
+}
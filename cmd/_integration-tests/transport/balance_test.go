@@ -0,0 +1,249 @@
+package test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/sd"
+	"github.com/go-kit/kit/sd/lb"
+	"google.golang.org/grpc"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	grpcclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/grpc"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+	svctesting "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/testing"
+)
+
+// replicas serves a mock over HTTP for each of n replicas, returning their
+// URLs and a func closing them.
+func replicas(t *testing.T, n int) ([]*mock.Service, []string, func()) {
+	var mocks []*mock.Service
+	var urls []string
+	var harnesses []*svctesting.Harness
+	closeAll := func() {
+		for _, h := range harnesses {
+			h.Close()
+		}
+	}
+	for i := 0; i < n; i++ {
+		var m mock.Service
+		h, err := svctesting.New(&m, svc.Config{})
+		if err != nil {
+			closeAll()
+			t.Fatal(err)
+		}
+		harnesses = append(harnesses, h)
+		mocks = append(mocks, &m)
+		urls = append(urls, h.HTTPServer.URL)
+	}
+	return mocks, urls, closeAll
+}
+
+// callsOf returns the number of calls of GetWithQuery of each mock.
+func callsOf(mocks []*mock.Service) []int {
+	var calls []int
+	for _, m := range mocks {
+		calls = append(calls, len(m.GetWithQueryCalls()))
+	}
+	return calls
+}
+
+func TestBalancedHTTP(t *testing.T) {
+	mocks, urls, closeAll := replicas(t, 2)
+	defer closeAll()
+	client, closer := httpclient.NewBalanced(sd.FixedInstancer(urls))
+	defer closer.Close()
+
+	for i := 0; i < 4; i++ {
+		if _, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := callsOf(mocks); calls[0] != 2 || calls[1] != 2 {
+		t.Errorf("calls by replica = %v, want [2 2]", calls)
+	}
+	if _, err := client.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBalancedGRPC(t *testing.T) {
+	var mocks []*mock.Service
+	var addrs []string
+	for i := 0; i < 2; i++ {
+		var m mock.Service
+		s := grpc.NewServer()
+		pb.RegisterTransportPermutationsServer(s, svc.MakeGRPCServer(m.Endpoints()))
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go s.Serve(ln)
+		defer s.Stop()
+		mocks = append(mocks, &m)
+		addrs = append(addrs, ln.Addr().String())
+	}
+
+	client, closer, err := grpcclient.NewBalanced(sd.FixedInstancer(addrs), []grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer.Close()
+	for i := 0; i < 4; i++ {
+		if _, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := callsOf(mocks); calls[0] != 2 || calls[1] != 2 {
+		t.Errorf("calls by replica = %v, want [2 2]", calls)
+	}
+}
+
+// closeCounter counts the calls of Close of the clients of each instance.
+type closeCounter struct {
+	mu     sync.Mutex
+	closed map[string]int
+}
+
+func (c *closeCounter) closer(instance string) io.Closer {
+	return closerFunc(func() error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.closed[instance]++
+		return nil
+	})
+}
+
+func (c *closeCounter) count(instance string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed[instance]
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+func TestBalancedClose(t *testing.T) {
+	counter := closeCounter{closed: make(map[string]int)}
+	var mu sync.Mutex
+	created := make(map[string]int)
+	client, closer := svc.Balanced(sd.FixedInstancer{"a", "b"}, func(instance string) (pb.TransportPermutationsServer, io.Closer, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		created[instance]++
+		return &mock.Service{}, counter.closer(instance), nil
+	})
+
+	// The methods share the client of each instance
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.Lock()
+	if created["a"] != 1 || created["b"] != 1 {
+		t.Errorf("clients created by instance = %v, want one each", created)
+	}
+	mu.Unlock()
+
+	if err := closer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if a, b := counter.count("a"), counter.count("b"); a != 1 || b != 1 {
+		t.Errorf("clients closed a: %d, b: %d times, want once each", a, b)
+	}
+	if _, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != lb.ErrNoEndpoints {
+		t.Errorf("call after Close: err = %v, want %v", err, lb.ErrNoEndpoints)
+	}
+
+	// Closing again closes nothing more
+	closer.Close()
+	if a, b := counter.count("a"), counter.count("b"); a != 1 || b != 1 {
+		t.Errorf("clients closed a: %d, b: %d times after a second Close, want once each", a, b)
+	}
+}
+
+func TestFileInstancer(t *testing.T) {
+	mocks, urls, closeAll := replicas(t, 2)
+	defer closeAll()
+	dir, err := ioutil.TempDir("", "instances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "instances")
+
+	// write replaces the file at once, so it is never read half written
+	write := func(content string) {
+		if err := ioutil.WriteFile(path+".new", []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(path+".new", path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("# The first replica\n" + urls[0] + "\n\n")
+
+	instancer := svc.NewFileInstancer(path, 5*time.Millisecond)
+	defer instancer.Stop()
+	client, closer := httpclient.NewBalanced(instancer)
+	defer closer.Close()
+
+	call := func() error {
+		_, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+		return err
+	}
+	if err := call(); err != nil {
+		t.Fatal(err)
+	}
+	if calls := callsOf(mocks); calls[0] != 1 || calls[1] != 0 {
+		t.Fatalf("calls by replica = %v, want [1 0]", calls)
+	}
+
+	// The first replica may get a few more calls until the file is read again
+	write(urls[1] + "\n")
+	deadline := time.Now().Add(5 * time.Second)
+	for callsOf(mocks)[1] == 0 {
+		if err := call(); err != nil {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the second replica is never called")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	write("")
+	deadline = time.Now().Add(5 * time.Second)
+	for call() != lb.ErrNoEndpoints {
+		if time.Now().After(deadline) {
+			t.Fatal("calls do not fail once the file lists no instance")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Once closed, the client no longer follows the file
+	if err := closer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	write(urls[0] + "\n")
+	time.Sleep(50 * time.Millisecond)
+	if err := call(); err != lb.ErrNoEndpoints {
+		t.Errorf("call after Close: err = %v, want %v", err, lb.ErrNoEndpoints)
+	}
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+)
+
+var bodyLimitAddr string
+
+func TestBodyLimit(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		want int
+	}{
+		{
+			name: "under global limit",
+			path: "/postwithnestedmessagebody",
+			body: `{"NM":{"A":1,"B":2}}`,
+			want: http.StatusOK,
+		},
+		{
+			name: "over global limit",
+			path: "/postwithnestedmessagebody",
+			body: `{"NM":{"A":1,"B":2}}` + strings.Repeat(" ", 64),
+			want: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "under method limit",
+			path: "/ctxtoctx",
+			body: fmt.Sprintf(`{"Key":%q}`, strings.Repeat("a", 512)),
+			want: http.StatusOK,
+		},
+		{
+			name: "over method limit",
+			path: "/ctxtoctx",
+			body: fmt.Sprintf(`{"Key":%q}`, strings.Repeat("a", 1024)),
+			want: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(bodyLimitAddr+tt.path, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("cannot make request: %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.want {
+				t.Fatalf("Expect status %d, got %d: %s", tt.want, resp.StatusCode, body)
+			}
+		})
+	}
+}
+
+func TestBodyLimitGRPCWeb(t *testing.T) {
+	req := pb.MetaRequest{Key: strings.Repeat("a", 2048)}
+	_, trailers := grpcWebCall(t, bodyLimitAddr, "CtxToCtx", &req, false, nil)
+	// codes.ResourceExhausted
+	if !strings.Contains(trailers, "grpc-status: 8\r\n") {
+		t.Fatalf("trailers do not contain ResourceExhausted status: %q", trailers)
+	}
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+)
+
+func TestGetWithBytesAndMapsRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		expects pb.BytesAndMapsMessage
+	}{
+		{
+			name: "bytes",
+			path: "-_8=?data=-_8&chunks=AQ==&chunks=Ag&token_bytes=%2B%2F8%3D&owner.id=AA",
+			expects: pb.BytesAndMapsMessage{
+				Key:    []byte{0xfb, 0xff},
+				Data:   []byte{0xfb, 0xff},
+				Chunks: [][]byte{{1}, {2}},
+				Owner:  &pb.BytesAndMapsOwner{Id: []byte{0}},
+				Token:  &pb.BytesAndMapsMessage_TokenBytes{TokenBytes: []byte{0xfb, 0xff}},
+			},
+		},
+		{
+			name: "map brackets",
+			path: "AA?labels[env]=prod&labels[team]=core&extraLabels[a]=b&owner.tags[x]=y",
+			expects: pb.BytesAndMapsMessage{
+				Key:         []byte{0},
+				Labels:      map[string]string{"env": "prod", "team": "core"},
+				ExtraLabels: map[string]string{"a": "b"},
+				Owner:       &pb.BytesAndMapsOwner{Tags: map[string]string{"x": "y"}},
+			},
+		},
+		{
+			name: "map key=value",
+			path: "AA?labels=env%3Dprod&labels=team%3Dcore&extra_labels=a%3Db%3Dc",
+			expects: pb.BytesAndMapsMessage{
+				Key:         []byte{0},
+				Labels:      map[string]string{"env": "prod", "team": "core"},
+				ExtraLabels: map[string]string{"a": "b=c"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp pb.BytesAndMapsMessage
+			if err := testHTTP(t, &resp, &tt.expects, nil, "GET", "getwithbytesandmaps/%s", tt.path); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestGetWithBytesAndMapsBadRequest(t *testing.T) {
+	for _, path := range []string{
+		"AA?data=not*base64",
+		"AA?labels=nokey",
+	} {
+		resp, err := http.Get(httpAddr + "/getwithbytesandmaps/" + path)
+		if err != nil {
+			t.Fatalf("cannot make request: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			t.Errorf("%s: expected an error status, got %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestGetWithBytesAndMapsClient(t *testing.T) {
+	req := pb.BytesAndMapsMessage{
+		Key:         []byte{0xfb, 0xff, 0xfe},
+		Data:        []byte("hello?&="),
+		Chunks:      [][]byte{{1}, {0xff, 0xfe}},
+		Labels:      map[string]string{"env": "prod", "a=b": "c&d", "[x]": ""},
+		ExtraLabels: map[string]string{"k": "v"},
+		Owner: &pb.BytesAndMapsOwner{
+			Id:   []byte{7},
+			Tags: map[string]string{"x": "y"},
+		},
+		Token: &pb.BytesAndMapsMessage_TokenBytes{TokenBytes: []byte{0xfb}},
+	}
+
+	svchttp, err := httpclient.New(httpAddr)
+	if err != nil {
+		t.Fatalf("failed to create httpclient: %q", err)
+	}
+	resp, err := svchttp.GetWithBytesAndMaps(context.Background(), &req)
+	if err != nil {
+		t.Fatalf("httpclient returned error: %q", err)
+	}
+	if !reflect.DeepEqual(*resp, req) {
+		t.Fatalf("Expect %v, got %v", req, *resp)
+	}
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+)
+
+var compressionAddr string
+
+func TestCompressedResponse(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"gzip", "gzip"},
+		{"deflate", "deflate"},
+		{"deflate;q=1.0, gzip;q=0.5", "deflate"},
+		{"gzip;q=0, deflate", "deflate"},
+		{"br", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			req, err := http.NewRequest("GET", compressionAddr+"/getwithrepeatedquery?A=1&A=2", nil)
+			if err != nil {
+				t.Fatalf("cannot create request: %v", err)
+			}
+			// Setting the header stops the transport from decompressing
+			// gzip itself
+			req.Header.Set("Accept-Encoding", tt.accept)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("cannot make request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if got := resp.Header.Get("Content-Encoding"); got != tt.want {
+				t.Fatalf("Content-Encoding: Expect %q, got %q", tt.want, got)
+			}
+			var body io.Reader = resp.Body
+			switch tt.want {
+			case "gzip":
+				if body, err = gzip.NewReader(resp.Body); err != nil {
+					t.Fatalf("cannot read gzip body: %v", err)
+				}
+			case "deflate":
+				if body, err = zlib.NewReader(resp.Body); err != nil {
+					t.Fatalf("cannot read deflate body: %v", err)
+				}
+			}
+			out, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatalf("cannot read body: %v", err)
+			}
+			if !strings.Contains(string(out), `"V":"3"`) {
+				t.Fatalf("unexpected body: %s", out)
+			}
+		})
+	}
+}
+
+func TestCompressedRequest(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(`{"NM":{"A":1,"B":2}}`))
+	zw.Close()
+
+	req, err := http.NewRequest("POST", compressionAddr+"/postwithnestedmessagebody", &buf)
+	if err != nil {
+		t.Fatalf("cannot create request: %v", err)
+	}
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot make request: %v", err)
+	}
+	defer resp.Body.Close()
+	out, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(out), `"V":"3"`) {
+		t.Fatalf("unexpected response %d: %s", resp.StatusCode, out)
+	}
+}
+
+func TestUnsupportedRequestEncoding(t *testing.T) {
+	req, err := http.NewRequest("POST", compressionAddr+"/postwithnestedmessagebody", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("cannot create request: %v", err)
+	}
+	req.Header.Set("Content-Encoding", "br")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot make request: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("Expect status %d, got %d", http.StatusUnsupportedMediaType, resp.StatusCode)
+	}
+}
+
+func TestCompressionClient(t *testing.T) {
+	svchttp, err := httpclient.New(compressionAddr, httpclient.GzipRequests())
+	if err != nil {
+		t.Fatalf("failed to create httpclient: %q", err)
+	}
+
+	req := pb.PostWithNestedMessageBodyRequest{
+		NM: &pb.NestedMessage{A: 1, B: 2},
+	}
+	resp, err := svchttp.PostWithNestedMessageBody(context.Background(), &req)
+	if err != nil {
+		t.Fatalf("httpclient returned error: %q", err)
+	}
+	if resp.V != 3 {
+		t.Fatalf("Expect: %d, got %d", 3, resp.V)
+	}
+}
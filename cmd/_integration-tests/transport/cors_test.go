@@ -0,0 +1,166 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	svc "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+)
+
+var corsAddr string
+
+const corsOrigin = "http://example.com"
+
+func corsRequest(t *testing.T, method, path string, header map[string]string) *http.Response {
+	req, err := http.NewRequest(method, corsAddr+path, nil)
+	if err != nil {
+		t.Fatalf("cannot create request: %v", err)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot make request: %v", err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func TestCORSPreflight(t *testing.T) {
+	resp := corsRequest(t, "OPTIONS", "/getwithquery", map[string]string{
+		"Origin":                         corsOrigin,
+		"Access-Control-Request-Method":  "GET",
+		"Access-Control-Request-Headers": "Content-Type, Truss-Auth-Header",
+	})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expect status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	want := map[string]string{
+		"Access-Control-Allow-Origin":      corsOrigin,
+		"Access-Control-Allow-Methods":     "GET",
+		"Access-Control-Allow-Headers":     "Content-Type, Truss-Auth-Header",
+		"Access-Control-Allow-Credentials": "true",
+		"Access-Control-Max-Age":           "600",
+		"Allow":                            "GET, OPTIONS",
+	}
+	for k, v := range want {
+		if got := resp.Header.Get(k); got != v {
+			t.Errorf("%s: Expect %q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestCORSPreflightPathParams(t *testing.T) {
+	resp := corsRequest(t, "OPTIONS", "/path/5/6", map[string]string{
+		"Origin":                        corsOrigin,
+		"Access-Control-Request-Method": "GET",
+	})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expect status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != corsOrigin {
+		t.Fatalf("Expect origin %q, got %q", corsOrigin, got)
+	}
+}
+
+func TestCORSPreflightDenied(t *testing.T) {
+	tests := []struct {
+		name   string
+		header map[string]string
+	}{
+		{
+			name: "origin",
+			header: map[string]string{
+				"Origin":                        "http://evil.com",
+				"Access-Control-Request-Method": "GET",
+			},
+		},
+		{
+			name: "method",
+			header: map[string]string{
+				"Origin":                        corsOrigin,
+				"Access-Control-Request-Method": "DELETE",
+			},
+		},
+		{
+			name: "header",
+			header: map[string]string{
+				"Origin":                         corsOrigin,
+				"Access-Control-Request-Method":  "GET",
+				"Access-Control-Request-Headers": "X-Not-Allowed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := corsRequest(t, "OPTIONS", "/getwithquery", tt.header)
+			if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "" {
+				t.Fatalf("Expect no allowed origin, got %q", got)
+			}
+		})
+	}
+}
+
+func TestCORSActualRequest(t *testing.T) {
+	resp := corsRequest(t, "GET", "/getwithquery?A=1&B=2", map[string]string{
+		"Origin": corsOrigin,
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expect status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != corsOrigin {
+		t.Fatalf("Expect origin %q, got %q", corsOrigin, got)
+	}
+	if got := resp.Header.Get("Access-Control-Expose-Headers"); got != "Grpc-Status" {
+		t.Fatalf("Expect exposed headers %q, got %q", "Grpc-Status", got)
+	}
+}
+
+func TestCORSUnannotatedPath(t *testing.T) {
+	resp := corsRequest(t, "OPTIONS", "/not/a/path", map[string]string{
+		"Origin":                        corsOrigin,
+		"Access-Control-Request-Method": "GET",
+	})
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expect status %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+func TestCORSWildcardWithoutCredentials(t *testing.T) {
+	ok := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	server := httptest.NewServer(svc.MakeCORSHandler(svc.CORSConfig{
+		AllowedOrigins:   []string{"*", corsOrigin},
+		AllowCredentials: true,
+	}, ok))
+	defer server.Close()
+
+	tests := []struct {
+		origin, method, wantOrigin, wantCredentials string
+	}{
+		{"http://evil.com", "OPTIONS", "*", ""},
+		{"http://evil.com", "GET", "*", ""},
+		{corsOrigin, "OPTIONS", corsOrigin, "true"},
+		{corsOrigin, "GET", corsOrigin, "true"},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, server.URL+"/getwithquery", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Origin", tt.origin)
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+			t.Errorf("%s from %s: Expect origin %q, got %q", tt.method, tt.origin, tt.wantOrigin, got)
+		}
+		if got := resp.Header.Get("Access-Control-Allow-Credentials"); got != tt.wantCredentials {
+			t.Errorf("%s from %s: Expect credentials %q, got %q", tt.method, tt.origin, tt.wantCredentials, got)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+)
+
+var grpcWebAddr string
+
+// grpcWebCall sends req to method of the server at addr over gRPC-Web and returns the message frame
+// and the trailers of the response.
+func grpcWebCall(t *testing.T, addr, method string, req proto.Message, text bool, header http.Header) ([]byte, string) {
+	msg, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("cannot marshal request: %v", err)
+	}
+	var frame bytes.Buffer
+	frame.WriteByte(0)
+	binary.Write(&frame, binary.BigEndian, uint32(len(msg)))
+	frame.Write(msg)
+
+	contentType := "application/grpc-web+proto"
+	body := frame.Bytes()
+	if text {
+		contentType = "application/grpc-web-text+proto"
+		body = []byte(base64.StdEncoding.EncodeToString(body))
+	}
+
+	httpReq, err := http.NewRequest("POST", addr+"/transport.TransportPermutations/"+method, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("cannot create request: %v", err)
+	}
+	for k, v := range header {
+		httpReq.Header[k] = v
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("cannot make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get("Content-Type"); got != contentType {
+		t.Fatalf("Content-Type: want %q, got %q", contentType, got)
+	}
+	out, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("cannot read response: %v", err)
+	}
+	if text {
+		if out, err = base64.StdEncoding.DecodeString(string(out)); err != nil {
+			t.Fatalf("cannot decode response: %v", err)
+		}
+	}
+
+	var data []byte
+	var trailers string
+	for len(out) >= 5 {
+		n := binary.BigEndian.Uint32(out[1:5])
+		if out[0]&0x80 != 0 {
+			trailers = string(out[5 : 5+n])
+		} else {
+			data = out[5 : 5+n]
+		}
+		out = out[5+n:]
+	}
+	return data, trailers
+}
+
+func TestGRPCWebBinary(t *testing.T) {
+	data, trailers := grpcWebCall(t, grpcWebAddr, "GetWithRepeatedQuery", &pb.GetWithRepeatedQueryRequest{A: []int64{12, 45360}}, false, nil)
+	if !strings.Contains(trailers, "grpc-status: 0\r\n") {
+		t.Fatalf("trailers do not contain OK status: %q", trailers)
+	}
+
+	var resp pb.GetWithRepeatedQueryResponse
+	if err := proto.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("cannot unmarshal response: %v", err)
+	}
+	if resp.V != 12+45360 {
+		t.Fatalf("Expect: %d, got %d", 12+45360, resp.V)
+	}
+}
+
+func TestGRPCWebText(t *testing.T) {
+	header := http.Header{}
+	header.Set("Truss-Auth-Header", "SECRET")
+	data, trailers := grpcWebCall(t, grpcWebAddr, "CtxToCtx", &pb.MetaRequest{Key: "Truss-Auth-Header"}, true, header)
+	if !strings.Contains(trailers, "grpc-status: 0\r\n") {
+		t.Fatalf("trailers do not contain OK status: %q", trailers)
+	}
+
+	var resp pb.MetaResponse
+	if err := proto.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("cannot unmarshal response: %v", err)
+	}
+	if resp.V != "SECRET" {
+		t.Fatalf("Expect: %q, got %q", "SECRET", resp.V)
+	}
+}
+
+func TestGRPCWebError(t *testing.T) {
+	data, trailers := grpcWebCall(t, grpcWebAddr, "ErrorRPC", &pb.Empty{}, false, nil)
+	if data != nil {
+		t.Fatalf("expected no message frame, got %q", data)
+	}
+	// codes.Unknown
+	if !strings.Contains(trailers, "grpc-status: 2\r\n") {
+		t.Fatalf("trailers do not contain Unknown status: %q", trailers)
+	}
+	if !strings.Contains(trailers, "This%20error%20should%20be%20json") {
+		t.Fatalf("trailers do not contain error message: %q", trailers)
+	}
+}
+
+func TestGRPCWebUnknownMethod(t *testing.T) {
+	_, trailers := grpcWebCall(t, grpcWebAddr, "NotAMethod", &pb.Empty{}, false, nil)
+	// codes.Unimplemented
+	if !strings.Contains(trailers, "grpc-status: 12\r\n") {
+		t.Fatalf("trailers do not contain Unimplemented status: %q", trailers)
+	}
+}
+
+func TestGRPCWebPassesThroughHTTP(t *testing.T) {
+	resp, err := http.Get(grpcWebAddr + "/getwithquery?A=1&B=2")
+	if err != nil {
+		t.Fatalf("cannot make request: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), `"V":"3"`) {
+		t.Fatalf("unexpected response %d: %s", resp.StatusCode, body)
+	}
+}
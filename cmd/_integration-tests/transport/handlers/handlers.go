@@ -190,3 +190,28 @@ func (s transportpermutationsService) CustomVerb(ctx context.Context, in *pb.Get
 	}
 	return &response, nil
 }
+
+// GetWithJSONNames implements Service.
+func (s transportpermutationsService) GetWithJSONNames(ctx context.Context, in *pb.JSONNamesMessage) (*pb.JSONNamesMessage, error) {
+	return in, nil
+}
+
+// GetWithNestedQuery implements Service.
+func (s transportpermutationsService) GetWithNestedQuery(ctx context.Context, in *pb.NestedQueryMessage) (*pb.NestedQueryMessage, error) {
+	return in, nil
+}
+
+// GetWithWellKnownTypes implements Service.
+func (s transportpermutationsService) GetWithWellKnownTypes(ctx context.Context, in *pb.WellKnownTypesMessage) (*pb.WellKnownTypesMessage, error) {
+	return in, nil
+}
+
+// GetWithBytesAndMaps implements Service.
+func (s transportpermutationsService) GetWithBytesAndMaps(ctx context.Context, in *pb.BytesAndMapsMessage) (*pb.BytesAndMapsMessage, error) {
+	return in, nil
+}
+
+// PostWithValidation implements Service.
+func (s transportpermutationsService) PostWithValidation(ctx context.Context, in *pb.ValidatedMessage) (*pb.ValidatedMessage, error) {
+	return in, nil
+}
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	handler "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/handlers"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+	svctesting "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/testing"
+)
+
+func TestHarness(t *testing.T) {
+	for _, name := range []string{"first", "second"} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			h, err := svctesting.New(handler.NewService(), svc.Config{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer h.Close()
+
+			for transport, client := range map[string]pb.TransportPermutationsServer{"HTTP": h.HTTP, "gRPC": h.GRPC} {
+				resp, err := client.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{A: []int64{2, 3}})
+				if err != nil {
+					t.Fatalf("%s client returned error: %v", transport, err)
+				}
+				if resp.V != 5 {
+					t.Errorf("%s client: V = %d, want 5", transport, resp.V)
+				}
+			}
+		})
+	}
+}
+
+func TestHarnessMiddlewares(t *testing.T) {
+	var m mock.Service
+	h, err := svctesting.New(&m, svc.Config{
+		HTTPLimits: svc.HTTPLimits{MaxBodyBytes: 16},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	// The Validate middleware rejects the request before it reaches the mock
+	_, err = h.GRPC.PostWithValidation(context.Background(), &pb.ValidatedMessage{})
+	if err == nil {
+		t.Error("invalid request returned no error")
+	}
+	if n := len(m.PostWithValidationCalls()); n != 0 {
+		t.Errorf("%d calls of PostWithValidation reached the service, want 0", n)
+	}
+
+	resp, err := http.Post(h.HTTPServer.URL+"/postwithvalidation", "application/json",
+		strings.NewReader(`{"name": "a name which is too long for the body limit"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusRequestEntityTooLarge)
+	}
+}
@@ -205,6 +205,11 @@ func TestGetWithEnumQueryRequest(t *testing.T) {
 	if err != nil {
 		t.Fatal(errors.Wrap(err, "cannot make http request"))
 	}
+	// enum value names
+	err = testHTTP(t, &resp, &expects, nil, "GET", "getwithenumquery?in=%s", pb.TestStatus_test_passed)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "cannot make http request"))
+	}
 }
 
 func TestGetWithOneofClient(t *testing.T) {
@@ -489,6 +494,11 @@ func TestGetWithEnumPathRequest(t *testing.T) {
 		t.Fatal(errors.Wrap(err, "cannot make http request"))
 	}
 	// multi / golang style
+	// enum value names
+	err = testHTTP(t, &resp, &expects, nil, "GET", "getwithenumpath/%s", pb.TestStatus_test_passed)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "cannot make http request"))
+	}
 }
 
 func TestErrorRPCReturnsJSONError(t *testing.T) {
@@ -820,6 +830,14 @@ func testHTTP(
 		err = jsonpb.UnmarshalString(string(respBytes), v)
 	case *pb.GetWithOneofResponse:
 		err = jsonpb.UnmarshalString(string(respBytes), v)
+	case *pb.JSONNamesMessage:
+		err = jsonpb.UnmarshalString(string(respBytes), v)
+	case *pb.NestedQueryMessage:
+		err = jsonpb.UnmarshalString(string(respBytes), v)
+	case *pb.WellKnownTypesMessage:
+		err = jsonpb.UnmarshalString(string(respBytes), v)
+	case *pb.BytesAndMapsMessage:
+		err = jsonpb.UnmarshalString(string(respBytes), v)
 	default:
 		t.Fatalf("Unknown response type: %T", v)
 	}
@@ -0,0 +1,104 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+)
+
+func TestGetWithJSONNamesRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"proto names", "page_size=5&page_token=abc&order_by=name"},
+		{"json names", "pageSize=5&pageToken=abc&orderBy=name"},
+		{"mixed names", "pageSize=5&page_token=abc&orderBy=name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp pb.JSONNamesMessage
+			expects := pb.JSONNamesMessage{
+				PageSize:  5,
+				PageToken: "abc",
+				Order:     &pb.JSONNamesMessage_OrderBy{OrderBy: "name"},
+			}
+			if err := testHTTP(t, &resp, &expects, nil, "GET", "getwithjsonnames?%s", tt.query); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestGetWithJSONNamesClient(t *testing.T) {
+	req := pb.JSONNamesMessage{
+		PageSize:  5,
+		PageToken: "abc",
+		Order:     &pb.JSONNamesMessage_ReverseOrderBy{ReverseOrderBy: "name"},
+	}
+
+	tests := []struct {
+		name      string
+		options   []httptransport.ClientOption
+		wantQuery url.Values
+	}{
+		{
+			name: "proto names",
+			wantQuery: url.Values{
+				"page_size":        {"5"},
+				"page_token":       {"abc"},
+				"reverse_order_by": {"name"},
+			},
+		},
+		{
+			name:    "json names",
+			options: []httptransport.ClientOption{httpclient.JSONQueryNames()},
+			wantQuery: url.Values{
+				"pageSize":       {"5"},
+				"pageToken":      {"abc"},
+				"reverseOrderBy": {"name"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery url.Values
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.Query()
+				w.Write([]byte("{}"))
+			}))
+			defer srv.Close()
+
+			svchttp, err := httpclient.New(srv.URL, tt.options...)
+			if err != nil {
+				t.Fatalf("failed to create httpclient: %q", err)
+			}
+			if _, err := svchttp.GetWithJSONNames(context.Background(), &req); err != nil {
+				t.Fatalf("httpclient returned error: %q", err)
+			}
+			if gotQuery.Encode() != tt.wantQuery.Encode() {
+				t.Fatalf("Expect query %q, got %q", tt.wantQuery.Encode(), gotQuery.Encode())
+			}
+
+			// The real server accepts either form
+			svchttp, err = httpclient.New(httpAddr, tt.options...)
+			if err != nil {
+				t.Fatalf("failed to create httpclient: %q", err)
+			}
+			resp, err := svchttp.GetWithJSONNames(context.Background(), &req)
+			if err != nil {
+				t.Fatalf("httpclient returned error: %q", err)
+			}
+			if resp.PageSize != req.PageSize || resp.PageToken != req.PageToken || resp.GetReverseOrderBy() != "name" {
+				t.Fatalf("Expect %v, got %v", req, *resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+	svctesting "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/testing"
+)
+
+// fakeClock is a clock which only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestLimitRate(t *testing.T) {
+	clock := fakeClock{now: time.Unix(0, 0)}
+	var m mock.Service
+	endpoints := m.Endpoints()
+	endpoints.WrapAllLabeledExcept(svc.Limiter(map[string]svc.Limit{
+		"GetWithQuery": {Rate: 2, Burst: 2},
+	}, clock.Now))
+
+	call := func() error {
+		_, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := call(); err != nil {
+			t.Fatalf("call %d within the burst: %v", i, err)
+		}
+	}
+	err := call()
+	lerr, ok := err.(svc.LimitError)
+	if !ok {
+		t.Fatalf("call beyond the burst: err = %v, want a LimitError", err)
+	}
+	if lerr.Method != "GetWithQuery" || lerr.RetryAfter != 500*time.Millisecond {
+		t.Errorf("LimitError = %+v, want GetWithQuery retried after 500ms", lerr)
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if err := call(); err != nil {
+		t.Errorf("call after refill: %v", err)
+	}
+	if err := call(); err == nil {
+		t.Error("second call after refill of a single token succeeded")
+	}
+
+	// Methods without a limit are not limited
+	for i := 0; i < 10; i++ {
+		if _, err := endpoints.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLimitInFlight(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var m mock.Service
+	m.SetGetWithQuery(func(context.Context, *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+		started <- struct{}{}
+		<-release
+		return &pb.GetWithQueryResponse{}, nil
+	})
+	endpoints := m.Endpoints()
+	endpoints.WrapAllLabeledExcept(svc.Limiter(map[string]svc.Limit{
+		"GetWithQuery": {MaxInFlight: 1},
+	}, nil))
+
+	done := make(chan error)
+	go func() {
+		_, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+		done <- err
+	}()
+	<-started
+
+	_, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+	if lerr, ok := err.(svc.LimitError); !ok || lerr.RetryAfter != 0 {
+		t.Errorf("call beyond MaxInFlight: err = %#v, want a LimitError without RetryAfter", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	go func() { <-started }()
+	if _, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+		t.Errorf("call after the first returned: %v", err)
+	}
+}
+
+func TestLimitTransports(t *testing.T) {
+	var m mock.Service
+	h, err := svctesting.New(&m, svc.Config{
+		Limits: map[string]svc.Limit{
+			"GetWithQuery": {Rate: 0.001},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	if _, err := h.GRPC.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GRPC.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("gRPC error = %v, want a ResourceExhausted status", err)
+	}
+
+	resp, err := http.Get(h.HTTPServer.URL + "/getwithquery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("HTTP status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := resp.Header.Get("Retry-After"); got == "" {
+		t.Error("no Retry-After header")
+	}
+}
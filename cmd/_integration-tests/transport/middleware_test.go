@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-kit/kit/endpoint"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/middleware"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+)
+
+// doubling overrides a single method of the service, forwarding the others.
+type doubling struct {
+	middleware.Base
+}
+
+func (d doubling) GetWithQuery(ctx context.Context, in *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+	resp, err := d.Base.GetWithQuery(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetWithQueryResponse{V: resp.V * 2}, nil
+}
+
+func TestMiddlewareOverride(t *testing.T) {
+	var m mock.Service
+	m.ReturnGetWithQuery(&pb.GetWithQueryResponse{V: 21}, nil)
+	m.ReturnGetWithRepeatedQuery(&pb.GetWithRepeatedQueryResponse{V: 21}, nil)
+
+	var service pb.TransportPermutationsServer = doubling{middleware.Base{Next: &m}}
+
+	resp, err := service.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.V != 42 {
+		t.Errorf("overridden GetWithQuery = %d, want 42", resp.V)
+	}
+
+	repeated, err := service.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repeated.V != 21 {
+		t.Errorf("forwarded GetWithRepeatedQuery = %d, want 21", repeated.V)
+	}
+}
+
+func TestMiddlewareHooks(t *testing.T) {
+	type key struct{}
+	var m mock.Service
+	m.SetGetWithQuery(func(ctx context.Context, in *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+		if ctx.Value(key{}) != "before" {
+			t.Error("context of Before not passed on")
+		}
+		return &pb.GetWithQueryResponse{V: in.A}, nil
+	})
+	m.ReturnErrorRPC(nil, errors.New("failure"))
+
+	var methods []string
+	var outs []interface{}
+	service := middleware.Base{
+		Next: &m,
+		Before: func(ctx context.Context, method string, in interface{}) (context.Context, error) {
+			methods = append(methods, method)
+			if r, ok := in.(*pb.GetWithQueryRequest); ok && r.A < 0 {
+				return ctx, errors.New("negative")
+			}
+			return context.WithValue(ctx, key{}, "before"), nil
+		},
+		After: func(ctx context.Context, method string, in, out interface{}, err error) error {
+			outs = append(outs, out)
+			if err != nil {
+				return errors.New(method + ": " + err.Error())
+			}
+			return nil
+		},
+	}
+
+	resp, err := service.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{A: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.V != 7 {
+		t.Errorf("GetWithQuery = %d, want 7", resp.V)
+	}
+	if r, ok := outs[0].(*pb.GetWithQueryResponse); !ok || r.V != 7 {
+		t.Errorf("After got response %v, want the response of GetWithQuery", outs[0])
+	}
+
+	if _, err := service.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{A: -1}); err == nil || err.Error() != "negative" {
+		t.Errorf("error = %v, want the error of Before", err)
+	}
+	if n := len(m.GetWithQueryCalls()); n != 1 {
+		t.Errorf("%d calls of GetWithQuery reached the service, want 1", n)
+	}
+
+	if _, err := service.ErrorRPC(context.Background(), &pb.Empty{}); err == nil || err.Error() != "ErrorRPC: failure" {
+		t.Errorf("error = %v, want the error of After", err)
+	}
+
+	// After cannot clear the error of a failed call, which has no response
+	service.After = func(ctx context.Context, method string, in, out interface{}, err error) error {
+		return nil
+	}
+	if _, err := service.ErrorRPC(context.Background(), &pb.Empty{}); err == nil || err.Error() != "failure" {
+		t.Errorf("error = %v, want the error of ErrorRPC kept", err)
+	}
+
+	want := []string{"GetWithQuery", "GetWithQuery", "ErrorRPC", "ErrorRPC"}
+	if len(methods) != len(want) {
+		t.Fatalf("Before called for %v, want %v", methods, want)
+	}
+	for i := range want {
+		if methods[i] != want[i] {
+			t.Errorf("Before called for %v, want %v", methods, want)
+		}
+	}
+}
+
+func TestWrapByOption(t *testing.T) {
+	want := map[string]string{"transport.public": "true"}
+	if got := svc.MethodOptions["GetWithQuery"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("options of GetWithQuery = %v, want %v", got, want)
+	}
+	want = map[string]string{"transport.role": "admin"}
+	if got := svc.MethodOptions["GetWithRepeatedQuery"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("options of GetWithRepeatedQuery = %v, want %v", got, want)
+	}
+	want = map[string]string{"transport.public": "false"}
+	if got := svc.MethodOptions["GetWithRepeatedStringQuery"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("options of GetWithRepeatedStringQuery = %v, want %v", got, want)
+	}
+
+	denied := errors.New("denied")
+	deny := func(endpoint.Endpoint) endpoint.Endpoint {
+		return func(context.Context, interface{}) (interface{}, error) {
+			return nil, denied
+		}
+	}
+
+	var m mock.Service
+	endpoints := m.Endpoints()
+	endpoints.WrapByOptionExcept("transport.public", "true", deny)
+
+	if _, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+		t.Errorf("public GetWithQuery: %v", err)
+	}
+	if _, err := endpoints.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != denied {
+		t.Errorf("GetWithRepeatedQuery without option: err = %v, want %v", err, denied)
+	}
+	if _, err := endpoints.GetWithRepeatedStringQuery(context.Background(), &pb.GetWithRepeatedStringQueryRequest{}); err != denied {
+		t.Errorf("GetWithRepeatedStringQuery not public: err = %v, want %v", err, denied)
+	}
+
+	endpoints = m.Endpoints()
+	endpoints.WrapByOption("transport.role", "admin", deny)
+
+	if _, err := endpoints.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err != nil {
+		t.Errorf("GetWithQuery without role: %v", err)
+	}
+	if _, err := endpoints.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != denied {
+		t.Errorf("admin GetWithRepeatedQuery: err = %v, want %v", err, denied)
+	}
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+)
+
+func TestMockDefaults(t *testing.T) {
+	var m mock.Service
+	resp, err := m.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{A: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp == nil || resp.V != 0 {
+		t.Errorf("response = %v, want an empty response", resp)
+	}
+	if n := len(m.GetWithQueryCalls()); n != 1 {
+		t.Errorf("%d calls of GetWithQuery recorded, want 1", n)
+	}
+	if n := len(m.CtxToCtxCalls()); n != 0 {
+		t.Errorf("%d calls of CtxToCtx recorded, want 0", n)
+	}
+}
+
+func TestMockOverHTTP(t *testing.T) {
+	var m mock.Service
+	m.SetGetWithQuery(func(_ context.Context, in *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+		return &pb.GetWithQueryResponse{V: in.A * in.B}, nil
+	})
+	m.ReturnErrorRPC(nil, errors.New("mocked failure"))
+
+	server := httptest.NewServer(svc.MakeHTTPHandler(m.Endpoints(), svc.EncodeHTTPGenericResponse))
+	defer server.Close()
+	client, err := httpclient.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{A: 3, B: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.V != 12 {
+		t.Errorf("V = %d, want 12", resp.V)
+	}
+	calls := m.GetWithQueryCalls()
+	if len(calls) != 1 || calls[0].In.A != 3 || calls[0].In.B != 4 {
+		t.Errorf("calls of GetWithQuery = %v, want one with A 3 and B 4", calls)
+	}
+
+	_, err = client.ErrorRPC(context.Background(), &pb.Empty{})
+	if err == nil || !strings.Contains(err.Error(), "mocked failure") {
+		t.Errorf("error = %v, want the mocked failure", err)
+	}
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+)
+
+func TestGetWithNestedQueryRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		expects pb.NestedQueryMessage
+	}{
+		{
+			name:  "dotted",
+			query: "filter.status=1&filter.owner.id=7&filter.owner.display_name=bob&filter.tags=a&filter.tags=b",
+			expects: pb.NestedQueryMessage{
+				Filter: &pb.QueryFilter{
+					Status: pb.TestStatus_test_passed,
+					Owner:  &pb.QueryOwner{Id: 7, DisplayName: "bob"},
+					Tags:   []string{"a", "b"},
+				},
+			},
+		},
+		{
+			name:  "enum names",
+			query: "filter.status=test_passed&filter.statuses=test_passed&filter.statuses=0",
+			expects: pb.NestedQueryMessage{
+				Filter: &pb.QueryFilter{
+					Status:   pb.TestStatus_test_passed,
+					Statuses: []pb.TestStatus{pb.TestStatus_test_passed, pb.TestStatus_test_failed},
+				},
+			},
+		},
+		{
+			name:  "repeated enum list",
+			query: `filter.statuses=test_failed,1`,
+			expects: pb.NestedQueryMessage{
+				Filter: &pb.QueryFilter{
+					Statuses: []pb.TestStatus{pb.TestStatus_test_failed, pb.TestStatus_test_passed},
+				},
+			},
+		},
+		{
+			name:  "json names",
+			query: "filter.owner.displayName=bob",
+			expects: pb.NestedQueryMessage{
+				Filter: &pb.QueryFilter{
+					Owner: &pb.QueryOwner{DisplayName: "bob"},
+				},
+			},
+		},
+		{
+			name:  "json and dotted",
+			query: `filter={"owner":{"id":7}}&filter.status=1`,
+			expects: pb.NestedQueryMessage{
+				Filter: &pb.QueryFilter{
+					Status: pb.TestStatus_test_passed,
+					Owner:  &pb.QueryOwner{Id: 7},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp pb.NestedQueryMessage
+			if err := testHTTP(t, &resp, &tt.expects, nil, "GET", "getwithnestedquery?%s", tt.query); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestGetWithNestedQueryClient(t *testing.T) {
+	req := pb.NestedQueryMessage{
+		Filter: &pb.QueryFilter{
+			Status:   pb.TestStatus_test_passed,
+			Owner:    &pb.QueryOwner{Id: 7, DisplayName: "bob"},
+			Tags:     []string{"a", "b"},
+			Statuses: []pb.TestStatus{pb.TestStatus_test_passed, pb.TestStatus_test_failed},
+		},
+	}
+
+	svchttp, err := httpclient.New(httpAddr)
+	if err != nil {
+		t.Fatalf("failed to create httpclient: %q", err)
+	}
+	resp, err := svchttp.GetWithNestedQuery(context.Background(), &req)
+	if err != nil {
+		t.Fatalf("httpclient returned error: %q", err)
+	}
+	if !reflect.DeepEqual(*resp, req) {
+		t.Fatalf("Expect %v, got %v", req, *resp)
+	}
+}
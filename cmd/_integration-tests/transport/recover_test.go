@@ -0,0 +1,81 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+	svctesting "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/testing"
+)
+
+// panicking returns a mock whose GetWithRepeatedQuery panics for requests
+// without A.
+func panicking() *mock.Service {
+	var m mock.Service
+	m.SetGetWithRepeatedQuery(func(_ context.Context, in *pb.GetWithRepeatedQueryRequest) (*pb.GetWithRepeatedQueryResponse, error) {
+		return &pb.GetWithRepeatedQueryResponse{V: in.A[0]}, nil
+	})
+	return &m
+}
+
+func TestRecover(t *testing.T) {
+	h, err := svctesting.New(panicking(), svc.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	_, err = h.GRPC.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("gRPC error = %v, want an Internal status", err)
+	}
+
+	resp, err := http.Get(h.HTTPServer.URL + "/getwithrepeatedquery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("HTTP status = %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+	}
+
+	// The service keeps serving
+	got, err := h.GRPC.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{A: []int64{4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.V != 4 {
+		t.Errorf("V = %d, want 4", got.V)
+	}
+}
+
+func TestRecoverConfig(t *testing.T) {
+	var methods []string
+	h, err := svctesting.New(panicking(), svc.Config{
+		Recover: func(_ context.Context, method string, p interface{}, stack []byte) error {
+			methods = append(methods, method)
+			if len(stack) == 0 {
+				t.Error("no stack trace for the panic")
+			}
+			return status.Error(codes.Unavailable, "try again")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	_, err = h.GRPC.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("gRPC error = %v, want the Unavailable status of Recover", err)
+	}
+	if len(methods) != 1 || methods[0] != "GetWithRepeatedQuery" {
+		t.Errorf("Recover called for %v, want [GetWithRepeatedQuery]", methods)
+	}
+}
@@ -0,0 +1,183 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+	"github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/mock"
+	svctesting "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/testing"
+)
+
+// failFirst returns a GetWithQuery func failing with err the first n times
+// it is called.
+func failFirst(n int, err error) func(context.Context, *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+	var mu sync.Mutex
+	var calls int
+	return func(context.Context, *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+		mu.Lock()
+		calls++
+		failed := calls <= n
+		mu.Unlock()
+		if failed {
+			return nil, err
+		}
+		return &pb.GetWithQueryResponse{V: 1}, nil
+	}
+}
+
+// clients returns the clients of h by transport.
+func clients(h *svctesting.Harness) map[string]pb.TransportPermutationsServer {
+	return map[string]pb.TransportPermutationsServer{"HTTP": h.HTTP, "gRPC": h.GRPC}
+}
+
+func TestResilientRetry(t *testing.T) {
+	// Sent as a 429 and as ResourceExhausted, both retryable
+	overloaded := svc.LimitError{Method: "GetWithQuery"}
+
+	var m mock.Service
+	h, err := svctesting.New(&m, svc.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	for _, retries := range []int{2, 1} {
+		for name, client := range clients(h) {
+			m.SetGetWithQuery(failFirst(2, overloaded))
+			before := len(m.GetWithQueryCalls())
+			client = svc.Resilient(client, svc.ClientPolicy{Retries: retries, Backoff: time.Millisecond}, nil)
+
+			_, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+			calls := len(m.GetWithQueryCalls()) - before
+			if calls != retries+1 {
+				t.Errorf("%s with %d retries: %d calls, want %d", name, retries, calls, retries+1)
+			}
+			if retries == 2 && err != nil {
+				t.Errorf("%s with 2 retries: %v", name, err)
+			}
+			if retries == 1 && !svc.IsRetryable(err) {
+				t.Errorf("%s with 1 retry: err = %v, want a retryable error", name, err)
+			}
+		}
+	}
+}
+
+func TestResilientNotRetryable(t *testing.T) {
+	var m mock.Service
+	h, err := svctesting.New(&m, svc.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	for name, client := range clients(h) {
+		m.SetGetWithQuery(failFirst(1, errors.New("failure")))
+		before := len(m.GetWithQueryCalls())
+		client = svc.Resilient(client, svc.ClientPolicy{Retries: 3, Backoff: time.Millisecond}, nil)
+		if _, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{}); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+		if calls := len(m.GetWithQueryCalls()) - before; calls != 1 {
+			t.Errorf("%s: %d calls, want 1", name, calls)
+		}
+	}
+}
+
+func TestResilientTimeout(t *testing.T) {
+	// The calls outlast the timeout of the client, rather than ending with
+	// the deadline passed on to the server, whose error would come back
+	// without the status of a timeout
+	release := make(chan struct{})
+	var m mock.Service
+	m.SetGetWithQuery(func(context.Context, *pb.GetWithQueryRequest) (*pb.GetWithQueryResponse, error) {
+		<-release
+		return &pb.GetWithQueryResponse{}, nil
+	})
+	h, err := svctesting.New(&m, svc.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+	defer close(release)
+
+	for name, client := range clients(h) {
+		before := len(m.GetWithQueryCalls())
+		client = svc.Resilient(client, svc.ClientPolicy{
+			Timeout: 20 * time.Millisecond,
+			Retries: 1,
+			Backoff: time.Millisecond,
+		}, nil)
+		_, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+		if !svc.IsRetryable(err) {
+			t.Errorf("%s: err = %v, want a retryable timeout", name, err)
+		}
+		// The server may not see the call of the last attempt yet
+		if calls := len(m.GetWithQueryCalls()) - before; calls < 1 || calls > 2 {
+			t.Errorf("%s: %d calls, want at most 2", name, calls)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	clock := fakeClock{now: time.Unix(0, 0)}
+	var m mock.Service
+	m.SetGetWithQuery(failFirst(2, svc.LimitError{Method: "GetWithQuery"}))
+	client := svc.Resilient(&m, svc.ClientPolicy{
+		BreakerFailures: 2,
+		BreakerCooldown: time.Second,
+	}, clock.Now)
+
+	call := func() error {
+		_, err := client.GetWithQuery(context.Background(), &pb.GetWithQueryRequest{})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := call(); err == nil {
+			t.Fatalf("call %d: no error", i)
+		}
+	}
+	err := call()
+	if _, ok := err.(svc.CircuitOpenError); !ok {
+		t.Errorf("call after %d failures: err = %v, want a CircuitOpenError", 2, err)
+	}
+	if n := len(m.GetWithQueryCalls()); n != 2 {
+		t.Errorf("%d calls reached the service, want 2", n)
+	}
+
+	// Other methods have circuits of their own
+	if _, err := client.GetWithRepeatedQuery(context.Background(), &pb.GetWithRepeatedQueryRequest{}); err != nil {
+		t.Errorf("GetWithRepeatedQuery: %v", err)
+	}
+
+	clock.Advance(time.Second)
+	if err := call(); err != nil {
+		t.Errorf("probe after cooldown: %v", err)
+	}
+	if err := call(); err != nil {
+		t.Errorf("call after closing: %v", err)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	for err, want := range map[error]bool{
+		nil:                                 false,
+		errors.New("failure"):               false,
+		context.DeadlineExceeded:            true,
+		svc.LimitError{}:                    true,
+		svc.PanicError{}:                    false,
+		httpclient.StatusError{Code: 503}:   true,
+		httpclient.StatusError{Code: 400}:   false,
+		svc.CircuitOpenError{Method: "Get"}: false,
+	} {
+		if got := svc.IsRetryable(err); got != want {
+			t.Errorf("IsRetryable(%#v) = %v, want %v", err, got, want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -42,6 +43,11 @@ func TestMain(m *testing.M) {
 	StatusCodeAndNilHeadersE := svc.MakeStatusCodeAndNilHeadersEndpoint(service)
 	StatusCodeAndHeadersE := svc.MakeStatusCodeAndHeadersEndpoint(service)
 	CustomVerbE := svc.MakeCustomVerbEndpoint(service)
+	getWithJSONNamesE := svc.MakeGetWithJSONNamesEndpoint(service)
+	getWithNestedQueryE := svc.MakeGetWithNestedQueryEndpoint(service)
+	getWithWellKnownTypesE := svc.MakeGetWithWellKnownTypesEndpoint(service)
+	getWithBytesAndMapsE := svc.MakeGetWithBytesAndMapsEndpoint(service)
+	postWithValidationE := svc.MakePostWithValidationEndpoint(service)
 
 	endpoints := svc.Endpoints{
 		GetWithQueryEndpoint:               getWithQueryE,
@@ -63,12 +69,45 @@ func TestMain(m *testing.M) {
 		StatusCodeAndNilHeadersEndpoint:    StatusCodeAndNilHeadersE,
 		StatusCodeAndHeadersEndpoint:       StatusCodeAndHeadersE,
 		CustomVerbEndpoint:                 CustomVerbE,
+		GetWithJSONNamesEndpoint:           getWithJSONNamesE,
+		GetWithNestedQueryEndpoint:         getWithNestedQueryE,
+		GetWithWellKnownTypesEndpoint:      getWithWellKnownTypesE,
+		GetWithBytesAndMapsEndpoint:        getWithBytesAndMapsE,
+		PostWithValidationEndpoint:         postWithValidationE,
 	}
 
+	// Wrap the endpoints as NewEndpoints of the generated server does
+	endpoints.WrapAllExcept(svc.Validate)
+	endpoints = handler.WrapEndpoints(endpoints)
+
 	// http test server
 	h := svc.MakeHTTPHandler(endpoints, svc.EncodeHTTPGenericResponse)
 	httpTestServer := httptest.NewServer(h)
 
+	// grpc-web test server, falling through to the http handler
+	grpcWebHandler := svc.MakeGRPCWebHandler(endpoints, h)
+	grpcWebTestServer := httptest.NewServer(grpcWebHandler)
+
+	// body limit test server, also covering grpc-web
+	bodyLimitTestServer := httptest.NewServer(svc.MakeBodyLimitHandler(svc.HTTPLimits{
+		MaxBodyBytes: 64,
+		MethodMaxBodyBytes: map[string]int64{
+			"CtxToCtx": 1024,
+		},
+	}, grpcWebHandler))
+
+	// compression test server
+	compressionTestServer := httptest.NewServer(svc.MakeCompressionHandler(true, h))
+
+	// cors test server
+	corsTestServer := httptest.NewServer(svc.MakeCORSHandler(svc.CORSConfig{
+		AllowedOrigins:   []string{corsOrigin},
+		AllowedHeaders:   []string{"Truss-Auth-Header"},
+		ExposedHeaders:   []string{"Grpc-Status"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}, h))
+
 	// grpc test server
 	ln, err := net.Listen("tcp", "localhost:0")
 	if err != nil {
@@ -82,6 +121,10 @@ func TestMain(m *testing.M) {
 	go s.Serve(ln)
 
 	httpAddr = httpTestServer.URL
+	grpcWebAddr = grpcWebTestServer.URL
+	corsAddr = corsTestServer.URL
+	bodyLimitAddr = bodyLimitTestServer.URL
+	compressionAddr = compressionTestServer.URL
 	grpcAddr = ":" + strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
 
 	// Set up a http server that returns non JSON responses
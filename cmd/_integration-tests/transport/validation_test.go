@@ -0,0 +1,139 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	grpcclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/grpc"
+)
+
+func TestValidationHTTP(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantFields []string
+	}{
+		{
+			name:       "valid",
+			body:       `{"name": "ann", "age": 30, "owner": {"id": 1}, "email": "ann@example.com"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing owner and short name",
+			body:       `{"name": "a"}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"name", "owner"},
+		},
+		{
+			name:       "out of range and pattern",
+			body:       `{"name": "Ann", "age": 150, "owner": {"id": 1}, "status": 7}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"name", "age", "status"},
+		},
+		{
+			name:       "nested and oneof",
+			body:       `{"name": "ann", "owner": {"id": 0}, "co_owners": [{"id": 1}, {"id": -1}], "email": "ann@example.org"}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"owner.id", "co_owners[1].id", "email"},
+		},
+		{
+			name:       "unset oneof is not checked",
+			body:       `{"name": "ann", "owner": {"id": 1}, "phone": "555"}`,
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(httpAddr+"/postwithvalidation", "application/json", bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				return
+			}
+
+			var got struct {
+				Error      string
+				Violations []struct {
+					Field       string
+					Description string
+				}
+			}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("cannot unmarshal error body %s: %v", body, err)
+			}
+			var fields []string
+			for _, v := range got.Violations {
+				fields = append(fields, v.Field)
+				if v.Description == "" {
+					t.Errorf("violation of %q has no description", v.Field)
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("violated fields = %v, want %v; body: %s", fields, tt.wantFields, body)
+			}
+		})
+	}
+}
+
+func TestValidationGRPC(t *testing.T) {
+	conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure(), grpc.WithTimeout(time.Second))
+	if err != nil {
+		t.Fatalf("cannot dial grpc server: %v", err)
+	}
+	defer conn.Close()
+	svcgrpc, err := grpcclient.New(conn)
+	if err != nil {
+		t.Fatalf("failed to create grpcclient: %q", err)
+	}
+
+	valid := &pb.ValidatedMessage{
+		Name:  "ann",
+		Owner: &pb.ValidatedOwner{Id: 1},
+	}
+	got, err := svcgrpc.PostWithValidation(context.Background(), valid)
+	if err != nil {
+		t.Fatalf("valid request returned error: %v", err)
+	}
+	if got.Name != valid.Name {
+		t.Errorf("Name = %q, want %q", got.Name, valid.Name)
+	}
+
+	_, err = svcgrpc.PostWithValidation(context.Background(), &pb.ValidatedMessage{Name: "ann", Age: -1})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument; err: %v", st.Code(), err)
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if want := []string{"age", "owner"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("violated fields = %v, want %v; err: %v", fields, want, err)
+	}
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+
+	pb "github.com/metaverse/truss/cmd/_integration-tests/transport/proto"
+	httpclient "github.com/metaverse/truss/cmd/_integration-tests/transport/transportpermutations-service/svc/client/http"
+)
+
+func TestGetWithWellKnownTypesRequest(t *testing.T) {
+	at := &types.Timestamp{Seconds: 1484443815, Nanos: 10000000}
+	tests := []struct {
+		name    string
+		path    string
+		expects pb.WellKnownTypesMessage
+	}{
+		{
+			name: "scalars",
+			path: "2017-01-15T01:30:15.01Z?timeout=1.5s&update_mask=title,owner.displayName" +
+				"&count=-3&limit=7&ratio=0.25&enabled=true&label=x&data=AAH_",
+			expects: pb.WellKnownTypesMessage{
+				At:         at,
+				Timeout:    &types.Duration{Seconds: 1, Nanos: 500000000},
+				UpdateMask: &types.FieldMask{Paths: []string{"title", "owner.display_name"}},
+				Count:      &types.Int64Value{Value: -3},
+				Limit:      &types.UInt32Value{Value: 7},
+				Ratio:      &types.DoubleValue{Value: 0.25},
+				Enabled:    &types.BoolValue{Value: true},
+				Label:      &types.StringValue{Value: "x"},
+				Data:       &types.BytesValue{Value: []byte{0, 1, 0xff}},
+			},
+		},
+		{
+			name: "repeated, nested and oneof",
+			path: "2017-01-15T03:30:15.01%2B02:00?history=1970-01-01T00:00:01Z&history=1970-01-01T00:00:02Z" +
+				"&window.start=1970-01-01T00:00:03Z&window.length=-2.000001s&deadlineIn=60s",
+			expects: pb.WellKnownTypesMessage{
+				At: at,
+				History: []*types.Timestamp{
+					{Seconds: 1},
+					{Seconds: 2},
+				},
+				Window: &pb.WellKnownTypesWindow{
+					Start:  &types.Timestamp{Seconds: 3},
+					Length: &types.Duration{Seconds: -2, Nanos: -1000},
+				},
+				Deadline: &pb.WellKnownTypesMessage_DeadlineIn{DeadlineIn: &types.Duration{Seconds: 60}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp pb.WellKnownTypesMessage
+			if err := testHTTP(t, &resp, &tt.expects, nil, "GET", "getwithwellknowntypes/%s", tt.path); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestGetWithWellKnownTypesBadRequest(t *testing.T) {
+	for _, path := range []string{
+		"yesterday",
+		"2017-01-15T01:30:15Z?timeout=90",
+		"2017-01-15T01:30:15Z?count=many",
+	} {
+		resp, err := http.Get(httpAddr + "/getwithwellknowntypes/" + path)
+		if err != nil {
+			t.Fatalf("cannot make request: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			t.Errorf("%s: expected an error status, got %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestGetWithWellKnownTypesClient(t *testing.T) {
+	req := pb.WellKnownTypesMessage{
+		At:         &types.Timestamp{Seconds: 1484443815, Nanos: 10000000},
+		Timeout:    &types.Duration{Seconds: -1, Nanos: -500000000},
+		UpdateMask: &types.FieldMask{Paths: []string{"title", "owner.display_name"}},
+		Count:      &types.Int64Value{Value: 0},
+		Limit:      &types.UInt32Value{Value: 7},
+		Ratio:      &types.DoubleValue{Value: 0.25},
+		Enabled:    &types.BoolValue{Value: false},
+		Label:      &types.StringValue{Value: "a b&c"},
+		Data:       &types.BytesValue{Value: []byte{0xfb, 0xff}},
+		History: []*types.Timestamp{
+			{Seconds: 1},
+			{Seconds: 2, Nanos: 1},
+		},
+		Window: &pb.WellKnownTypesWindow{
+			Length: &types.Duration{Seconds: 5},
+		},
+		Deadline: &pb.WellKnownTypesMessage_DeadlineAt{DeadlineAt: &types.Timestamp{Seconds: 9}},
+	}
+
+	svchttp, err := httpclient.New(httpAddr)
+	if err != nil {
+		t.Fatalf("failed to create httpclient: %q", err)
+	}
+	resp, err := svchttp.GetWithWellKnownTypes(context.Background(), &req)
+	if err != nil {
+		t.Fatalf("httpclient returned error: %q", err)
+	}
+	if !reflect.DeepEqual(*resp, req) {
+		t.Fatalf("Expect %v, got %v", req, *resp)
+	}
+}
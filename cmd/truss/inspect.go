@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	flag "github.com/spf13/pflag"
+
+	"github.com/metaverse/truss/truss/inspect"
+)
+
+// inspectDefinition runs the inspect subcommand with args, the command line
+// arguments following "inspect". It prints what truss understood from the
+// passed .proto files instead of generating a service, and returns the exit
+// code for truss.
+func inspectDefinition(args []string) int {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	format := fs.StringP("format", "f", "json", "Output format, either json or yaml")
+	verbose := fs.BoolP("verbose", "v", false, "Verbose output")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "\nUsage: %s inspect [options] <protofile>...\n", binName)
+		fmt.Fprintf(os.Stderr, "\nPrints the service definition and HTTP transport helper truss builds from the .proto files.\n")
+		fmt.Fprintln(os.Stderr, "\nOptions:")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 1
+	}
+
+	log.SetLevel(log.InfoLevel)
+	if *verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	if *format != "json" && *format != "yaml" {
+		fmt.Fprintf(os.Stderr, "%s inspect: unknown format %q\n", binName, *format)
+		fs.Usage()
+		return 1
+	}
+	if len(fs.Args()) == 0 {
+		fmt.Fprintf(os.Stderr, "%s inspect: missing .proto file(s)\n", binName)
+		fs.Usage()
+		return 1
+	}
+
+	defPaths, err := cleanProtofilePath(fs.Args())
+	if err != nil {
+		log.Error(errors.Wrap(err, "cannot parse input arguments"))
+		return 1
+	}
+
+	def, err := inspect.FromPaths(goPath(), defPaths)
+	if err != nil {
+		log.Error(errors.Wrap(err, "cannot parse input definition proto files"))
+		return 1
+	}
+
+	if err := def.Write(os.Stdout, *format); err != nil {
+		log.Error(errors.Wrap(err, "cannot write definition"))
+		return 1
+	}
+	return 0
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/metaverse/truss/truss"
 	"github.com/metaverse/truss/truss/execprotoc"
 	"github.com/metaverse/truss/truss/getstarted"
+	"github.com/metaverse/truss/truss/parsesvcdef"
 	"github.com/metaverse/truss/truss/parsesvcname"
 
 	ggkconf "github.com/metaverse/truss/gengokit"
@@ -26,10 +27,11 @@ import (
 )
 
 var (
-	svcPackageFlag = flag.String("svcout", "", "Go package path where the generated Go service will be written. Trailing slash will create a NAME-service directory")
-	verboseFlag    = flag.BoolP("verbose", "v", false, "Verbose output")
-	helpFlag       = flag.BoolP("help", "h", false, "Print usage")
-	getStartedFlag = flag.BoolP("getstarted", "", false, "Output a 'getstarted.proto' protobuf file in ./")
+	svcPackageFlag     = flag.String("svcout", "", "Go package path where the generated Go service will be written. Trailing slash will create a NAME-service directory")
+	handlersPerRPCFlag = flag.Bool("handlers-per-rpc", false, "Write the handler of each new rpc to a file of its own in the handlers package, rather than to handlers.go")
+	verboseFlag        = flag.BoolP("verbose", "v", false, "Verbose output")
+	helpFlag           = flag.BoolP("help", "h", false, "Print usage")
+	getStartedFlag     = flag.BoolP("getstarted", "", false, "Output a 'getstarted.proto' protobuf file in ./")
 )
 
 var binName = filepath.Base(os.Args[0])
@@ -66,6 +68,7 @@ func init() {
 			fmt.Fprintf(os.Stderr, "%s (%s)\n", binName, strings.TrimSpace(buildinfo))
 		}
 		fmt.Fprintf(os.Stderr, "\nUsage: %s [options] <protofile>...\n", binName)
+		fmt.Fprintf(os.Stderr, "       %s inspect [options] <protofile>...\n", binName)
 		fmt.Fprintf(os.Stderr, "\nGenerates go-kit services using proto3 and gRPC definitions.\n")
 		fmt.Fprintln(os.Stderr, "\nOptions:")
 		flag.PrintDefaults()
@@ -73,6 +76,10 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "inspect" {
+		os.Exit(inspectDefinition(os.Args[2:]))
+	}
+
 	flag.Parse()
 
 	if *helpFlag {
@@ -135,12 +142,11 @@ func parseInput() (*truss.Config, error) {
 	var cfg truss.Config
 
 	// GOPATH
-	cfg.GoPath = filepath.SplitList(os.Getenv("GOPATH"))
-	if len(cfg.GoPath) == 0 {
-		cfg.GoPath = filepath.SplitList(build.Default.GOPATH)
-	}
+	cfg.GoPath = goPath()
 	log.WithField("GOPATH", cfg.GoPath).Debug()
 
+	cfg.HandlersPerRPC = *handlersPerRPCFlag
+
 	// DefPaths
 	var err error
 	rawDefinitionPaths := flag.Args()
@@ -231,6 +237,16 @@ func parseInput() (*truss.Config, error) {
 	return &cfg, nil
 }
 
+// goPath returns the list of GOPATH directories, falling back to the default
+// GOPATH if it is not set.
+func goPath() []string {
+	gopath := filepath.SplitList(os.Getenv("GOPATH"))
+	if len(gopath) == 0 {
+		gopath = filepath.SplitList(build.Default.GOPATH)
+	}
+	return gopath
+}
+
 // parseSVCOut handles the difference between relative paths and go package
 // paths
 func parseSVCOut(svcOut string, GOPATH string) (string, error) {
@@ -252,42 +268,19 @@ func parseServiceDefinition(cfg *truss.Config) (*svcdef.Svcdef, error) {
 		}
 	}
 
-	// Get path names of .pb.go files
-	pbgoPaths := []string{}
-	for _, p := range protoDefPaths {
-		base := filepath.Base(p)
-		barename := strings.TrimSuffix(base, filepath.Ext(p))
-		pbgp := filepath.Join(cfg.PBPath, barename+".pb.go")
-		pbgoPaths = append(pbgoPaths, pbgp)
-	}
-	pbgoFiles, err := openFiles(pbgoPaths)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot open all .pb.go files")
-	}
-
-	pbFiles, err := openFiles(protoDefPaths)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot open all .proto files")
-	}
-
-	// Create the svcdef
-	sd, err := svcdef.New(pbgoFiles, pbFiles)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create service definition; did you pass ALL the protobuf files to truss?")
-	}
-
-	return sd, nil
+	return parsesvcdef.FromPBGoDir(cfg.PBPath, protoDefPaths)
 }
 
 // generateCode returns a map[string]io.Reader that represents a gokit
 // service
 func generateCode(cfg *truss.Config, sd *svcdef.Svcdef) (map[string]io.Reader, error) {
 	conf := ggkconf.Config{
-		PBPackage:     cfg.PBPackage,
-		GoPackage:     cfg.ServicePackage,
-		PreviousFiles: cfg.PrevGen,
-		Version:       version,
-		VersionDate:   date,
+		PBPackage:      cfg.PBPackage,
+		GoPackage:      cfg.ServicePackage,
+		PreviousFiles:  cfg.PrevGen,
+		Version:        version,
+		VersionDate:    date,
+		HandlersPerRPC: cfg.HandlersPerRPC,
 	}
 
 	genGokitFiles, err := gengokit.GenerateGokit(sd, conf)
@@ -298,18 +291,6 @@ func generateCode(cfg *truss.Config, sd *svcdef.Svcdef) (map[string]io.Reader, e
 	return genGokitFiles, nil
 }
 
-func openFiles(paths []string) (map[string]io.Reader, error) {
-	rv := map[string]io.Reader{}
-	for _, p := range paths {
-		reader, err := os.Open(p)
-		if err != nil {
-			return nil, errors.Wrapf(err, "cannot open file %q", p)
-		}
-		rv[p] = reader
-	}
-	return rv, nil
-}
-
 // writeGenFile writes a file at path to the filesystem
 func writeGenFile(file io.Reader, path string) error {
 	err := os.MkdirAll(filepath.Dir(path), 0777)
@@ -0,0 +1,1872 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/metaverse/truss/deftree/validate/validate.proto
+
+package validate
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// FieldRules holds the rules of a single field. Only the rules matching the
+// type of the field may be set.
+type FieldRules struct {
+	Message *MessageRules `protobuf:"bytes,17,opt,name=message" json:"message,omitempty"`
+	// Types that are valid to be assigned to Type:
+	//	*FieldRules_Float
+	//	*FieldRules_Double
+	//	*FieldRules_Int32
+	//	*FieldRules_Int64
+	//	*FieldRules_Uint32
+	//	*FieldRules_Uint64
+	//	*FieldRules_Sint32
+	//	*FieldRules_Sint64
+	//	*FieldRules_Fixed32
+	//	*FieldRules_Fixed64
+	//	*FieldRules_Sfixed32
+	//	*FieldRules_Sfixed64
+	//	*FieldRules_String_
+	//	*FieldRules_Bytes
+	//	*FieldRules_Enum
+	//	*FieldRules_Repeated
+	//	*FieldRules_Map
+	Type                 isFieldRules_Type `protobuf_oneof:"type"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FieldRules) Reset()         { *m = FieldRules{} }
+func (m *FieldRules) String() string { return proto.CompactTextString(m) }
+func (*FieldRules) ProtoMessage()    {}
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{0}
+}
+func (m *FieldRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldRules.Unmarshal(m, b)
+}
+func (m *FieldRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldRules.Marshal(b, m, deterministic)
+}
+func (m *FieldRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRules.Merge(m, src)
+}
+func (m *FieldRules) XXX_Size() int {
+	return xxx_messageInfo_FieldRules.Size(m)
+}
+func (m *FieldRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRules proto.InternalMessageInfo
+
+type isFieldRules_Type interface {
+	isFieldRules_Type()
+}
+
+type FieldRules_Float struct {
+	Float *FloatRules `protobuf:"bytes,1,opt,name=float,oneof"`
+}
+type FieldRules_Double struct {
+	Double *DoubleRules `protobuf:"bytes,2,opt,name=double,oneof"`
+}
+type FieldRules_Int32 struct {
+	Int32 *Int32Rules `protobuf:"bytes,3,opt,name=int32,oneof"`
+}
+type FieldRules_Int64 struct {
+	Int64 *Int64Rules `protobuf:"bytes,4,opt,name=int64,oneof"`
+}
+type FieldRules_Uint32 struct {
+	Uint32 *UInt32Rules `protobuf:"bytes,5,opt,name=uint32,oneof"`
+}
+type FieldRules_Uint64 struct {
+	Uint64 *UInt64Rules `protobuf:"bytes,6,opt,name=uint64,oneof"`
+}
+type FieldRules_Sint32 struct {
+	Sint32 *SInt32Rules `protobuf:"bytes,7,opt,name=sint32,oneof"`
+}
+type FieldRules_Sint64 struct {
+	Sint64 *SInt64Rules `protobuf:"bytes,8,opt,name=sint64,oneof"`
+}
+type FieldRules_Fixed32 struct {
+	Fixed32 *Fixed32Rules `protobuf:"bytes,9,opt,name=fixed32,oneof"`
+}
+type FieldRules_Fixed64 struct {
+	Fixed64 *Fixed64Rules `protobuf:"bytes,10,opt,name=fixed64,oneof"`
+}
+type FieldRules_Sfixed32 struct {
+	Sfixed32 *SFixed32Rules `protobuf:"bytes,11,opt,name=sfixed32,oneof"`
+}
+type FieldRules_Sfixed64 struct {
+	Sfixed64 *SFixed64Rules `protobuf:"bytes,12,opt,name=sfixed64,oneof"`
+}
+type FieldRules_String_ struct {
+	String_ *StringRules `protobuf:"bytes,14,opt,name=string,oneof"`
+}
+type FieldRules_Bytes struct {
+	Bytes *BytesRules `protobuf:"bytes,15,opt,name=bytes,oneof"`
+}
+type FieldRules_Enum struct {
+	Enum *EnumRules `protobuf:"bytes,16,opt,name=enum,oneof"`
+}
+type FieldRules_Repeated struct {
+	Repeated *RepeatedRules `protobuf:"bytes,18,opt,name=repeated,oneof"`
+}
+type FieldRules_Map struct {
+	Map *MapRules `protobuf:"bytes,19,opt,name=map,oneof"`
+}
+
+func (*FieldRules_Float) isFieldRules_Type()    {}
+func (*FieldRules_Double) isFieldRules_Type()   {}
+func (*FieldRules_Int32) isFieldRules_Type()    {}
+func (*FieldRules_Int64) isFieldRules_Type()    {}
+func (*FieldRules_Uint32) isFieldRules_Type()   {}
+func (*FieldRules_Uint64) isFieldRules_Type()   {}
+func (*FieldRules_Sint32) isFieldRules_Type()   {}
+func (*FieldRules_Sint64) isFieldRules_Type()   {}
+func (*FieldRules_Fixed32) isFieldRules_Type()  {}
+func (*FieldRules_Fixed64) isFieldRules_Type()  {}
+func (*FieldRules_Sfixed32) isFieldRules_Type() {}
+func (*FieldRules_Sfixed64) isFieldRules_Type() {}
+func (*FieldRules_String_) isFieldRules_Type()  {}
+func (*FieldRules_Bytes) isFieldRules_Type()    {}
+func (*FieldRules_Enum) isFieldRules_Type()     {}
+func (*FieldRules_Repeated) isFieldRules_Type() {}
+func (*FieldRules_Map) isFieldRules_Type()      {}
+
+func (m *FieldRules) GetType() isFieldRules_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *FieldRules) GetMessage() *MessageRules {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *FieldRules) GetFloat() *FloatRules {
+	if x, ok := m.GetType().(*FieldRules_Float); ok {
+		return x.Float
+	}
+	return nil
+}
+
+func (m *FieldRules) GetDouble() *DoubleRules {
+	if x, ok := m.GetType().(*FieldRules_Double); ok {
+		return x.Double
+	}
+	return nil
+}
+
+func (m *FieldRules) GetInt32() *Int32Rules {
+	if x, ok := m.GetType().(*FieldRules_Int32); ok {
+		return x.Int32
+	}
+	return nil
+}
+
+func (m *FieldRules) GetInt64() *Int64Rules {
+	if x, ok := m.GetType().(*FieldRules_Int64); ok {
+		return x.Int64
+	}
+	return nil
+}
+
+func (m *FieldRules) GetUint32() *UInt32Rules {
+	if x, ok := m.GetType().(*FieldRules_Uint32); ok {
+		return x.Uint32
+	}
+	return nil
+}
+
+func (m *FieldRules) GetUint64() *UInt64Rules {
+	if x, ok := m.GetType().(*FieldRules_Uint64); ok {
+		return x.Uint64
+	}
+	return nil
+}
+
+func (m *FieldRules) GetSint32() *SInt32Rules {
+	if x, ok := m.GetType().(*FieldRules_Sint32); ok {
+		return x.Sint32
+	}
+	return nil
+}
+
+func (m *FieldRules) GetSint64() *SInt64Rules {
+	if x, ok := m.GetType().(*FieldRules_Sint64); ok {
+		return x.Sint64
+	}
+	return nil
+}
+
+func (m *FieldRules) GetFixed32() *Fixed32Rules {
+	if x, ok := m.GetType().(*FieldRules_Fixed32); ok {
+		return x.Fixed32
+	}
+	return nil
+}
+
+func (m *FieldRules) GetFixed64() *Fixed64Rules {
+	if x, ok := m.GetType().(*FieldRules_Fixed64); ok {
+		return x.Fixed64
+	}
+	return nil
+}
+
+func (m *FieldRules) GetSfixed32() *SFixed32Rules {
+	if x, ok := m.GetType().(*FieldRules_Sfixed32); ok {
+		return x.Sfixed32
+	}
+	return nil
+}
+
+func (m *FieldRules) GetSfixed64() *SFixed64Rules {
+	if x, ok := m.GetType().(*FieldRules_Sfixed64); ok {
+		return x.Sfixed64
+	}
+	return nil
+}
+
+func (m *FieldRules) GetString_() *StringRules {
+	if x, ok := m.GetType().(*FieldRules_String_); ok {
+		return x.String_
+	}
+	return nil
+}
+
+func (m *FieldRules) GetBytes() *BytesRules {
+	if x, ok := m.GetType().(*FieldRules_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (m *FieldRules) GetEnum() *EnumRules {
+	if x, ok := m.GetType().(*FieldRules_Enum); ok {
+		return x.Enum
+	}
+	return nil
+}
+
+func (m *FieldRules) GetRepeated() *RepeatedRules {
+	if x, ok := m.GetType().(*FieldRules_Repeated); ok {
+		return x.Repeated
+	}
+	return nil
+}
+
+func (m *FieldRules) GetMap() *MapRules {
+	if x, ok := m.GetType().(*FieldRules_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*FieldRules) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _FieldRules_OneofMarshaler, _FieldRules_OneofUnmarshaler, _FieldRules_OneofSizer, []interface{}{
+		(*FieldRules_Float)(nil),
+		(*FieldRules_Double)(nil),
+		(*FieldRules_Int32)(nil),
+		(*FieldRules_Int64)(nil),
+		(*FieldRules_Uint32)(nil),
+		(*FieldRules_Uint64)(nil),
+		(*FieldRules_Sint32)(nil),
+		(*FieldRules_Sint64)(nil),
+		(*FieldRules_Fixed32)(nil),
+		(*FieldRules_Fixed64)(nil),
+		(*FieldRules_Sfixed32)(nil),
+		(*FieldRules_Sfixed64)(nil),
+		(*FieldRules_String_)(nil),
+		(*FieldRules_Bytes)(nil),
+		(*FieldRules_Enum)(nil),
+		(*FieldRules_Repeated)(nil),
+		(*FieldRules_Map)(nil),
+	}
+}
+
+func _FieldRules_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*FieldRules)
+	// type
+	switch x := m.Type.(type) {
+	case *FieldRules_Float:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Float); err != nil {
+			return err
+		}
+	case *FieldRules_Double:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Double); err != nil {
+			return err
+		}
+	case *FieldRules_Int32:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Int32); err != nil {
+			return err
+		}
+	case *FieldRules_Int64:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Int64); err != nil {
+			return err
+		}
+	case *FieldRules_Uint32:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Uint32); err != nil {
+			return err
+		}
+	case *FieldRules_Uint64:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Uint64); err != nil {
+			return err
+		}
+	case *FieldRules_Sint32:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sint32); err != nil {
+			return err
+		}
+	case *FieldRules_Sint64:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sint64); err != nil {
+			return err
+		}
+	case *FieldRules_Fixed32:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Fixed32); err != nil {
+			return err
+		}
+	case *FieldRules_Fixed64:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Fixed64); err != nil {
+			return err
+		}
+	case *FieldRules_Sfixed32:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sfixed32); err != nil {
+			return err
+		}
+	case *FieldRules_Sfixed64:
+		_ = b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sfixed64); err != nil {
+			return err
+		}
+	case *FieldRules_String_:
+		_ = b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.String_); err != nil {
+			return err
+		}
+	case *FieldRules_Bytes:
+		_ = b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Bytes); err != nil {
+			return err
+		}
+	case *FieldRules_Enum:
+		_ = b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Enum); err != nil {
+			return err
+		}
+	case *FieldRules_Repeated:
+		_ = b.EncodeVarint(18<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Repeated); err != nil {
+			return err
+		}
+	case *FieldRules_Map:
+		_ = b.EncodeVarint(19<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Map); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("FieldRules.Type has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _FieldRules_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*FieldRules)
+	switch tag {
+	case 1: // type.float
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FloatRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Float{msg}
+		return true, err
+	case 2: // type.double
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DoubleRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Double{msg}
+		return true, err
+	case 3: // type.int32
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Int32Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Int32{msg}
+		return true, err
+	case 4: // type.int64
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Int64Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Int64{msg}
+		return true, err
+	case 5: // type.uint32
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UInt32Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Uint32{msg}
+		return true, err
+	case 6: // type.uint64
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UInt64Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Uint64{msg}
+		return true, err
+	case 7: // type.sint32
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SInt32Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Sint32{msg}
+		return true, err
+	case 8: // type.sint64
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SInt64Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Sint64{msg}
+		return true, err
+	case 9: // type.fixed32
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Fixed32Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Fixed32{msg}
+		return true, err
+	case 10: // type.fixed64
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Fixed64Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Fixed64{msg}
+		return true, err
+	case 11: // type.sfixed32
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SFixed32Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Sfixed32{msg}
+		return true, err
+	case 12: // type.sfixed64
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SFixed64Rules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Sfixed64{msg}
+		return true, err
+	case 14: // type.string
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StringRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_String_{msg}
+		return true, err
+	case 15: // type.bytes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BytesRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Bytes{msg}
+		return true, err
+	case 16: // type.enum
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EnumRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Enum{msg}
+		return true, err
+	case 18: // type.repeated
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RepeatedRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Repeated{msg}
+		return true, err
+	case 19: // type.map
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MapRules)
+		err := b.DecodeMessage(msg)
+		m.Type = &FieldRules_Map{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _FieldRules_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*FieldRules)
+	// type
+	switch x := m.Type.(type) {
+	case *FieldRules_Float:
+		s := proto.Size(x.Float)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Double:
+		s := proto.Size(x.Double)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Int32:
+		s := proto.Size(x.Int32)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Int64:
+		s := proto.Size(x.Int64)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Uint32:
+		s := proto.Size(x.Uint32)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Uint64:
+		s := proto.Size(x.Uint64)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Sint32:
+		s := proto.Size(x.Sint32)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Sint64:
+		s := proto.Size(x.Sint64)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Fixed32:
+		s := proto.Size(x.Fixed32)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Fixed64:
+		s := proto.Size(x.Fixed64)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Sfixed32:
+		s := proto.Size(x.Sfixed32)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Sfixed64:
+		s := proto.Size(x.Sfixed64)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_String_:
+		s := proto.Size(x.String_)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Bytes:
+		s := proto.Size(x.Bytes)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Enum:
+		s := proto.Size(x.Enum)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Repeated:
+		s := proto.Size(x.Repeated)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *FieldRules_Map:
+		s := proto.Size(x.Map)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type FloatRules struct {
+	Const                *float32 `protobuf:"fixed32,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *float32 `protobuf:"fixed32,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *float32 `protobuf:"fixed32,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *float32 `protobuf:"fixed32,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *float32 `protobuf:"fixed32,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FloatRules) Reset()         { *m = FloatRules{} }
+func (m *FloatRules) String() string { return proto.CompactTextString(m) }
+func (*FloatRules) ProtoMessage()    {}
+func (*FloatRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{1}
+}
+func (m *FloatRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FloatRules.Unmarshal(m, b)
+}
+func (m *FloatRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FloatRules.Marshal(b, m, deterministic)
+}
+func (m *FloatRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FloatRules.Merge(m, src)
+}
+func (m *FloatRules) XXX_Size() int {
+	return xxx_messageInfo_FloatRules.Size(m)
+}
+func (m *FloatRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_FloatRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FloatRules proto.InternalMessageInfo
+
+func (m *FloatRules) GetConst() float32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *FloatRules) GetLt() float32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *FloatRules) GetLte() float32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *FloatRules) GetGt() float32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *FloatRules) GetGte() float32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type DoubleRules struct {
+	Const                *float64 `protobuf:"fixed64,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *float64 `protobuf:"fixed64,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *float64 `protobuf:"fixed64,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *float64 `protobuf:"fixed64,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *float64 `protobuf:"fixed64,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoubleRules) Reset()         { *m = DoubleRules{} }
+func (m *DoubleRules) String() string { return proto.CompactTextString(m) }
+func (*DoubleRules) ProtoMessage()    {}
+func (*DoubleRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{2}
+}
+func (m *DoubleRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleRules.Unmarshal(m, b)
+}
+func (m *DoubleRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleRules.Marshal(b, m, deterministic)
+}
+func (m *DoubleRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleRules.Merge(m, src)
+}
+func (m *DoubleRules) XXX_Size() int {
+	return xxx_messageInfo_DoubleRules.Size(m)
+}
+func (m *DoubleRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleRules proto.InternalMessageInfo
+
+func (m *DoubleRules) GetConst() float64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *DoubleRules) GetLt() float64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *DoubleRules) GetLte() float64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *DoubleRules) GetGt() float64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *DoubleRules) GetGte() float64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type Int32Rules struct {
+	Const                *int32   `protobuf:"varint,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int32   `protobuf:"varint,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int32   `protobuf:"varint,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int32   `protobuf:"varint,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int32   `protobuf:"varint,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Int32Rules) Reset()         { *m = Int32Rules{} }
+func (m *Int32Rules) String() string { return proto.CompactTextString(m) }
+func (*Int32Rules) ProtoMessage()    {}
+func (*Int32Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{3}
+}
+func (m *Int32Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Int32Rules.Unmarshal(m, b)
+}
+func (m *Int32Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Int32Rules.Marshal(b, m, deterministic)
+}
+func (m *Int32Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Int32Rules.Merge(m, src)
+}
+func (m *Int32Rules) XXX_Size() int {
+	return xxx_messageInfo_Int32Rules.Size(m)
+}
+func (m *Int32Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Int32Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Int32Rules proto.InternalMessageInfo
+
+func (m *Int32Rules) GetConst() int32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *Int32Rules) GetLt() int32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *Int32Rules) GetLte() int32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *Int32Rules) GetGt() int32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *Int32Rules) GetGte() int32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type Int64Rules struct {
+	Const                *int64   `protobuf:"varint,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int64   `protobuf:"varint,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int64   `protobuf:"varint,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int64   `protobuf:"varint,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int64   `protobuf:"varint,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Int64Rules) Reset()         { *m = Int64Rules{} }
+func (m *Int64Rules) String() string { return proto.CompactTextString(m) }
+func (*Int64Rules) ProtoMessage()    {}
+func (*Int64Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{4}
+}
+func (m *Int64Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Int64Rules.Unmarshal(m, b)
+}
+func (m *Int64Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Int64Rules.Marshal(b, m, deterministic)
+}
+func (m *Int64Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Int64Rules.Merge(m, src)
+}
+func (m *Int64Rules) XXX_Size() int {
+	return xxx_messageInfo_Int64Rules.Size(m)
+}
+func (m *Int64Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Int64Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Int64Rules proto.InternalMessageInfo
+
+func (m *Int64Rules) GetConst() int64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *Int64Rules) GetLt() int64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *Int64Rules) GetLte() int64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *Int64Rules) GetGt() int64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *Int64Rules) GetGte() int64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type UInt32Rules struct {
+	Const                *uint32  `protobuf:"varint,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *uint32  `protobuf:"varint,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *uint32  `protobuf:"varint,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *uint32  `protobuf:"varint,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *uint32  `protobuf:"varint,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UInt32Rules) Reset()         { *m = UInt32Rules{} }
+func (m *UInt32Rules) String() string { return proto.CompactTextString(m) }
+func (*UInt32Rules) ProtoMessage()    {}
+func (*UInt32Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{5}
+}
+func (m *UInt32Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UInt32Rules.Unmarshal(m, b)
+}
+func (m *UInt32Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UInt32Rules.Marshal(b, m, deterministic)
+}
+func (m *UInt32Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UInt32Rules.Merge(m, src)
+}
+func (m *UInt32Rules) XXX_Size() int {
+	return xxx_messageInfo_UInt32Rules.Size(m)
+}
+func (m *UInt32Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_UInt32Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UInt32Rules proto.InternalMessageInfo
+
+func (m *UInt32Rules) GetConst() uint32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *UInt32Rules) GetLt() uint32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *UInt32Rules) GetLte() uint32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *UInt32Rules) GetGt() uint32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *UInt32Rules) GetGte() uint32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type UInt64Rules struct {
+	Const                *uint64  `protobuf:"varint,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *uint64  `protobuf:"varint,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *uint64  `protobuf:"varint,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *uint64  `protobuf:"varint,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *uint64  `protobuf:"varint,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UInt64Rules) Reset()         { *m = UInt64Rules{} }
+func (m *UInt64Rules) String() string { return proto.CompactTextString(m) }
+func (*UInt64Rules) ProtoMessage()    {}
+func (*UInt64Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{6}
+}
+func (m *UInt64Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UInt64Rules.Unmarshal(m, b)
+}
+func (m *UInt64Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UInt64Rules.Marshal(b, m, deterministic)
+}
+func (m *UInt64Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UInt64Rules.Merge(m, src)
+}
+func (m *UInt64Rules) XXX_Size() int {
+	return xxx_messageInfo_UInt64Rules.Size(m)
+}
+func (m *UInt64Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_UInt64Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UInt64Rules proto.InternalMessageInfo
+
+func (m *UInt64Rules) GetConst() uint64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *UInt64Rules) GetLt() uint64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *UInt64Rules) GetLte() uint64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *UInt64Rules) GetGt() uint64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *UInt64Rules) GetGte() uint64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type SInt32Rules struct {
+	Const                *int32   `protobuf:"zigzag32,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int32   `protobuf:"zigzag32,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int32   `protobuf:"zigzag32,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int32   `protobuf:"zigzag32,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int32   `protobuf:"zigzag32,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SInt32Rules) Reset()         { *m = SInt32Rules{} }
+func (m *SInt32Rules) String() string { return proto.CompactTextString(m) }
+func (*SInt32Rules) ProtoMessage()    {}
+func (*SInt32Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{7}
+}
+func (m *SInt32Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SInt32Rules.Unmarshal(m, b)
+}
+func (m *SInt32Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SInt32Rules.Marshal(b, m, deterministic)
+}
+func (m *SInt32Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SInt32Rules.Merge(m, src)
+}
+func (m *SInt32Rules) XXX_Size() int {
+	return xxx_messageInfo_SInt32Rules.Size(m)
+}
+func (m *SInt32Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_SInt32Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SInt32Rules proto.InternalMessageInfo
+
+func (m *SInt32Rules) GetConst() int32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *SInt32Rules) GetLt() int32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *SInt32Rules) GetLte() int32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *SInt32Rules) GetGt() int32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *SInt32Rules) GetGte() int32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type SInt64Rules struct {
+	Const                *int64   `protobuf:"zigzag64,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int64   `protobuf:"zigzag64,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int64   `protobuf:"zigzag64,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int64   `protobuf:"zigzag64,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int64   `protobuf:"zigzag64,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SInt64Rules) Reset()         { *m = SInt64Rules{} }
+func (m *SInt64Rules) String() string { return proto.CompactTextString(m) }
+func (*SInt64Rules) ProtoMessage()    {}
+func (*SInt64Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{8}
+}
+func (m *SInt64Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SInt64Rules.Unmarshal(m, b)
+}
+func (m *SInt64Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SInt64Rules.Marshal(b, m, deterministic)
+}
+func (m *SInt64Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SInt64Rules.Merge(m, src)
+}
+func (m *SInt64Rules) XXX_Size() int {
+	return xxx_messageInfo_SInt64Rules.Size(m)
+}
+func (m *SInt64Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_SInt64Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SInt64Rules proto.InternalMessageInfo
+
+func (m *SInt64Rules) GetConst() int64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *SInt64Rules) GetLt() int64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *SInt64Rules) GetLte() int64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *SInt64Rules) GetGt() int64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *SInt64Rules) GetGte() int64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type Fixed32Rules struct {
+	Const                *uint32  `protobuf:"fixed32,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *uint32  `protobuf:"fixed32,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *uint32  `protobuf:"fixed32,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *uint32  `protobuf:"fixed32,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *uint32  `protobuf:"fixed32,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Fixed32Rules) Reset()         { *m = Fixed32Rules{} }
+func (m *Fixed32Rules) String() string { return proto.CompactTextString(m) }
+func (*Fixed32Rules) ProtoMessage()    {}
+func (*Fixed32Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{9}
+}
+func (m *Fixed32Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Fixed32Rules.Unmarshal(m, b)
+}
+func (m *Fixed32Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Fixed32Rules.Marshal(b, m, deterministic)
+}
+func (m *Fixed32Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fixed32Rules.Merge(m, src)
+}
+func (m *Fixed32Rules) XXX_Size() int {
+	return xxx_messageInfo_Fixed32Rules.Size(m)
+}
+func (m *Fixed32Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fixed32Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fixed32Rules proto.InternalMessageInfo
+
+func (m *Fixed32Rules) GetConst() uint32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *Fixed32Rules) GetLt() uint32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *Fixed32Rules) GetLte() uint32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *Fixed32Rules) GetGt() uint32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *Fixed32Rules) GetGte() uint32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type Fixed64Rules struct {
+	Const                *uint64  `protobuf:"fixed64,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *uint64  `protobuf:"fixed64,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *uint64  `protobuf:"fixed64,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *uint64  `protobuf:"fixed64,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *uint64  `protobuf:"fixed64,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Fixed64Rules) Reset()         { *m = Fixed64Rules{} }
+func (m *Fixed64Rules) String() string { return proto.CompactTextString(m) }
+func (*Fixed64Rules) ProtoMessage()    {}
+func (*Fixed64Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{10}
+}
+func (m *Fixed64Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Fixed64Rules.Unmarshal(m, b)
+}
+func (m *Fixed64Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Fixed64Rules.Marshal(b, m, deterministic)
+}
+func (m *Fixed64Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fixed64Rules.Merge(m, src)
+}
+func (m *Fixed64Rules) XXX_Size() int {
+	return xxx_messageInfo_Fixed64Rules.Size(m)
+}
+func (m *Fixed64Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fixed64Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fixed64Rules proto.InternalMessageInfo
+
+func (m *Fixed64Rules) GetConst() uint64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *Fixed64Rules) GetLt() uint64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *Fixed64Rules) GetLte() uint64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *Fixed64Rules) GetGt() uint64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *Fixed64Rules) GetGte() uint64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type SFixed32Rules struct {
+	Const                *int32   `protobuf:"fixed32,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int32   `protobuf:"fixed32,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int32   `protobuf:"fixed32,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int32   `protobuf:"fixed32,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int32   `protobuf:"fixed32,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SFixed32Rules) Reset()         { *m = SFixed32Rules{} }
+func (m *SFixed32Rules) String() string { return proto.CompactTextString(m) }
+func (*SFixed32Rules) ProtoMessage()    {}
+func (*SFixed32Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{11}
+}
+func (m *SFixed32Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SFixed32Rules.Unmarshal(m, b)
+}
+func (m *SFixed32Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SFixed32Rules.Marshal(b, m, deterministic)
+}
+func (m *SFixed32Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SFixed32Rules.Merge(m, src)
+}
+func (m *SFixed32Rules) XXX_Size() int {
+	return xxx_messageInfo_SFixed32Rules.Size(m)
+}
+func (m *SFixed32Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_SFixed32Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SFixed32Rules proto.InternalMessageInfo
+
+func (m *SFixed32Rules) GetConst() int32 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *SFixed32Rules) GetLt() int32 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *SFixed32Rules) GetLte() int32 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *SFixed32Rules) GetGt() int32 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *SFixed32Rules) GetGte() int32 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+type SFixed64Rules struct {
+	Const                *int64   `protobuf:"fixed64,1,opt,name=const" json:"const,omitempty"`
+	Lt                   *int64   `protobuf:"fixed64,2,opt,name=lt" json:"lt,omitempty"`
+	Lte                  *int64   `protobuf:"fixed64,3,opt,name=lte" json:"lte,omitempty"`
+	Gt                   *int64   `protobuf:"fixed64,4,opt,name=gt" json:"gt,omitempty"`
+	Gte                  *int64   `protobuf:"fixed64,5,opt,name=gte" json:"gte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SFixed64Rules) Reset()         { *m = SFixed64Rules{} }
+func (m *SFixed64Rules) String() string { return proto.CompactTextString(m) }
+func (*SFixed64Rules) ProtoMessage()    {}
+func (*SFixed64Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{12}
+}
+func (m *SFixed64Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SFixed64Rules.Unmarshal(m, b)
+}
+func (m *SFixed64Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SFixed64Rules.Marshal(b, m, deterministic)
+}
+func (m *SFixed64Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SFixed64Rules.Merge(m, src)
+}
+func (m *SFixed64Rules) XXX_Size() int {
+	return xxx_messageInfo_SFixed64Rules.Size(m)
+}
+func (m *SFixed64Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_SFixed64Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SFixed64Rules proto.InternalMessageInfo
+
+func (m *SFixed64Rules) GetConst() int64 {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return 0
+}
+
+func (m *SFixed64Rules) GetLt() int64 {
+	if m != nil && m.Lt != nil {
+		return *m.Lt
+	}
+	return 0
+}
+
+func (m *SFixed64Rules) GetLte() int64 {
+	if m != nil && m.Lte != nil {
+		return *m.Lte
+	}
+	return 0
+}
+
+func (m *SFixed64Rules) GetGt() int64 {
+	if m != nil && m.Gt != nil {
+		return *m.Gt
+	}
+	return 0
+}
+
+func (m *SFixed64Rules) GetGte() int64 {
+	if m != nil && m.Gte != nil {
+		return *m.Gte
+	}
+	return 0
+}
+
+// Lengths of strings are counted in characters, not bytes.
+type StringRules struct {
+	Const  *string `protobuf:"bytes,1,opt,name=const" json:"const,omitempty"`
+	MinLen *uint64 `protobuf:"varint,2,opt,name=min_len,json=minLen" json:"min_len,omitempty"`
+	MaxLen *uint64 `protobuf:"varint,3,opt,name=max_len,json=maxLen" json:"max_len,omitempty"`
+	// pattern is a regular expression in the RE2 syntax.
+	Pattern              *string  `protobuf:"bytes,6,opt,name=pattern" json:"pattern,omitempty"`
+	Prefix               *string  `protobuf:"bytes,7,opt,name=prefix" json:"prefix,omitempty"`
+	Suffix               *string  `protobuf:"bytes,8,opt,name=suffix" json:"suffix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StringRules) Reset()         { *m = StringRules{} }
+func (m *StringRules) String() string { return proto.CompactTextString(m) }
+func (*StringRules) ProtoMessage()    {}
+func (*StringRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{13}
+}
+func (m *StringRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringRules.Unmarshal(m, b)
+}
+func (m *StringRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringRules.Marshal(b, m, deterministic)
+}
+func (m *StringRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringRules.Merge(m, src)
+}
+func (m *StringRules) XXX_Size() int {
+	return xxx_messageInfo_StringRules.Size(m)
+}
+func (m *StringRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StringRules proto.InternalMessageInfo
+
+func (m *StringRules) GetConst() string {
+	if m != nil && m.Const != nil {
+		return *m.Const
+	}
+	return ""
+}
+
+func (m *StringRules) GetMinLen() uint64 {
+	if m != nil && m.MinLen != nil {
+		return *m.MinLen
+	}
+	return 0
+}
+
+func (m *StringRules) GetMaxLen() uint64 {
+	if m != nil && m.MaxLen != nil {
+		return *m.MaxLen
+	}
+	return 0
+}
+
+func (m *StringRules) GetPattern() string {
+	if m != nil && m.Pattern != nil {
+		return *m.Pattern
+	}
+	return ""
+}
+
+func (m *StringRules) GetPrefix() string {
+	if m != nil && m.Prefix != nil {
+		return *m.Prefix
+	}
+	return ""
+}
+
+func (m *StringRules) GetSuffix() string {
+	if m != nil && m.Suffix != nil {
+		return *m.Suffix
+	}
+	return ""
+}
+
+type BytesRules struct {
+	MinLen               *uint64  `protobuf:"varint,2,opt,name=min_len,json=minLen" json:"min_len,omitempty"`
+	MaxLen               *uint64  `protobuf:"varint,3,opt,name=max_len,json=maxLen" json:"max_len,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BytesRules) Reset()         { *m = BytesRules{} }
+func (m *BytesRules) String() string { return proto.CompactTextString(m) }
+func (*BytesRules) ProtoMessage()    {}
+func (*BytesRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{14}
+}
+func (m *BytesRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BytesRules.Unmarshal(m, b)
+}
+func (m *BytesRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BytesRules.Marshal(b, m, deterministic)
+}
+func (m *BytesRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BytesRules.Merge(m, src)
+}
+func (m *BytesRules) XXX_Size() int {
+	return xxx_messageInfo_BytesRules.Size(m)
+}
+func (m *BytesRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_BytesRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BytesRules proto.InternalMessageInfo
+
+func (m *BytesRules) GetMinLen() uint64 {
+	if m != nil && m.MinLen != nil {
+		return *m.MinLen
+	}
+	return 0
+}
+
+func (m *BytesRules) GetMaxLen() uint64 {
+	if m != nil && m.MaxLen != nil {
+		return *m.MaxLen
+	}
+	return 0
+}
+
+type EnumRules struct {
+	// defined_only requires the value to be one of the values of the enum.
+	DefinedOnly          *bool    `protobuf:"varint,2,opt,name=defined_only,json=definedOnly" json:"defined_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnumRules) Reset()         { *m = EnumRules{} }
+func (m *EnumRules) String() string { return proto.CompactTextString(m) }
+func (*EnumRules) ProtoMessage()    {}
+func (*EnumRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{15}
+}
+func (m *EnumRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumRules.Unmarshal(m, b)
+}
+func (m *EnumRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumRules.Marshal(b, m, deterministic)
+}
+func (m *EnumRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumRules.Merge(m, src)
+}
+func (m *EnumRules) XXX_Size() int {
+	return xxx_messageInfo_EnumRules.Size(m)
+}
+func (m *EnumRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnumRules proto.InternalMessageInfo
+
+func (m *EnumRules) GetDefinedOnly() bool {
+	if m != nil && m.DefinedOnly != nil {
+		return *m.DefinedOnly
+	}
+	return false
+}
+
+type MessageRules struct {
+	// required requires the field to be set.
+	Required             *bool    `protobuf:"varint,2,opt,name=required" json:"required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageRules) Reset()         { *m = MessageRules{} }
+func (m *MessageRules) String() string { return proto.CompactTextString(m) }
+func (*MessageRules) ProtoMessage()    {}
+func (*MessageRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{16}
+}
+func (m *MessageRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageRules.Unmarshal(m, b)
+}
+func (m *MessageRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageRules.Marshal(b, m, deterministic)
+}
+func (m *MessageRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRules.Merge(m, src)
+}
+func (m *MessageRules) XXX_Size() int {
+	return xxx_messageInfo_MessageRules.Size(m)
+}
+func (m *MessageRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRules proto.InternalMessageInfo
+
+func (m *MessageRules) GetRequired() bool {
+	if m != nil && m.Required != nil {
+		return *m.Required
+	}
+	return false
+}
+
+type RepeatedRules struct {
+	MinItems             *uint64  `protobuf:"varint,1,opt,name=min_items,json=minItems" json:"min_items,omitempty"`
+	MaxItems             *uint64  `protobuf:"varint,2,opt,name=max_items,json=maxItems" json:"max_items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepeatedRules) Reset()         { *m = RepeatedRules{} }
+func (m *RepeatedRules) String() string { return proto.CompactTextString(m) }
+func (*RepeatedRules) ProtoMessage()    {}
+func (*RepeatedRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{17}
+}
+func (m *RepeatedRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepeatedRules.Unmarshal(m, b)
+}
+func (m *RepeatedRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepeatedRules.Marshal(b, m, deterministic)
+}
+func (m *RepeatedRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepeatedRules.Merge(m, src)
+}
+func (m *RepeatedRules) XXX_Size() int {
+	return xxx_messageInfo_RepeatedRules.Size(m)
+}
+func (m *RepeatedRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepeatedRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepeatedRules proto.InternalMessageInfo
+
+func (m *RepeatedRules) GetMinItems() uint64 {
+	if m != nil && m.MinItems != nil {
+		return *m.MinItems
+	}
+	return 0
+}
+
+func (m *RepeatedRules) GetMaxItems() uint64 {
+	if m != nil && m.MaxItems != nil {
+		return *m.MaxItems
+	}
+	return 0
+}
+
+type MapRules struct {
+	MinPairs             *uint64  `protobuf:"varint,1,opt,name=min_pairs,json=minPairs" json:"min_pairs,omitempty"`
+	MaxPairs             *uint64  `protobuf:"varint,2,opt,name=max_pairs,json=maxPairs" json:"max_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MapRules) Reset()         { *m = MapRules{} }
+func (m *MapRules) String() string { return proto.CompactTextString(m) }
+func (*MapRules) ProtoMessage()    {}
+func (*MapRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e66e74ea3098e7, []int{18}
+}
+func (m *MapRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapRules.Unmarshal(m, b)
+}
+func (m *MapRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapRules.Marshal(b, m, deterministic)
+}
+func (m *MapRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapRules.Merge(m, src)
+}
+func (m *MapRules) XXX_Size() int {
+	return xxx_messageInfo_MapRules.Size(m)
+}
+func (m *MapRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapRules proto.InternalMessageInfo
+
+func (m *MapRules) GetMinPairs() uint64 {
+	if m != nil && m.MinPairs != nil {
+		return *m.MinPairs
+	}
+	return 0
+}
+
+func (m *MapRules) GetMaxPairs() uint64 {
+	if m != nil && m.MaxPairs != nil {
+		return *m.MaxPairs
+	}
+	return 0
+}
+
+var E_Rules = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*FieldRules)(nil),
+	Field:         1071,
+	Name:          "validate.rules",
+	Tag:           "bytes,1071,opt,name=rules",
+	Filename:      "github.com/metaverse/truss/deftree/validate/validate.proto",
+}
+
+func init() {
+	proto.RegisterType((*FieldRules)(nil), "validate.FieldRules")
+	proto.RegisterType((*FloatRules)(nil), "validate.FloatRules")
+	proto.RegisterType((*DoubleRules)(nil), "validate.DoubleRules")
+	proto.RegisterType((*Int32Rules)(nil), "validate.Int32Rules")
+	proto.RegisterType((*Int64Rules)(nil), "validate.Int64Rules")
+	proto.RegisterType((*UInt32Rules)(nil), "validate.UInt32Rules")
+	proto.RegisterType((*UInt64Rules)(nil), "validate.UInt64Rules")
+	proto.RegisterType((*SInt32Rules)(nil), "validate.SInt32Rules")
+	proto.RegisterType((*SInt64Rules)(nil), "validate.SInt64Rules")
+	proto.RegisterType((*Fixed32Rules)(nil), "validate.Fixed32Rules")
+	proto.RegisterType((*Fixed64Rules)(nil), "validate.Fixed64Rules")
+	proto.RegisterType((*SFixed32Rules)(nil), "validate.SFixed32Rules")
+	proto.RegisterType((*SFixed64Rules)(nil), "validate.SFixed64Rules")
+	proto.RegisterType((*StringRules)(nil), "validate.StringRules")
+	proto.RegisterType((*BytesRules)(nil), "validate.BytesRules")
+	proto.RegisterType((*EnumRules)(nil), "validate.EnumRules")
+	proto.RegisterType((*MessageRules)(nil), "validate.MessageRules")
+	proto.RegisterType((*RepeatedRules)(nil), "validate.RepeatedRules")
+	proto.RegisterType((*MapRules)(nil), "validate.MapRules")
+	proto.RegisterExtension(E_Rules)
+}
+
+func init() {
+	proto.RegisterFile("github.com/metaverse/truss/deftree/validate/validate.proto", fileDescriptor_a5e66e74ea3098e7)
+}
+
+var fileDescriptor_a5e66e74ea3098e7 = []byte{
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0xc7, 0x89, 0x1d, 0xdb, 0x49, 0x65, 0xb2, 0x49, 0x7a, 0x97, 0x5d, 0x6b, 0x11, 0x12, 0xe4,
+	0x80, 0x00, 0xa1, 0x04, 0xcd, 0x86, 0x1c, 0xf6, 0xc0, 0x61, 0x35, 0x8c, 0x18, 0x04, 0x5a, 0xe4,
+	0xd1, 0x9e, 0x57, 0x9e, 0x71, 0xdb, 0xd3, 0x92, 0xbf, 0xb0, 0xdb, 0xa3, 0xe4, 0xce, 0x7b, 0xf0,
+	0x08, 0xbc, 0x22, 0xea, 0x6e, 0xb7, 0x3f, 0xca, 0x1e, 0x89, 0xbd, 0xb9, 0xaa, 0xfe, 0x55, 0xbf,
+	0xae, 0xfe, 0x70, 0x37, 0xbc, 0x8d, 0x18, 0x7f, 0xa8, 0xee, 0x76, 0xf7, 0x59, 0xb2, 0x4f, 0x28,
+	0xf7, 0x1f, 0x69, 0x51, 0xd2, 0x3d, 0x2f, 0xaa, 0xb2, 0xdc, 0x07, 0x34, 0xe4, 0x05, 0xa5, 0xfb,
+	0x47, 0x3f, 0x66, 0x81, 0xcf, 0xdb, 0x8f, 0x5d, 0x5e, 0x64, 0x3c, 0x23, 0x33, 0x6d, 0xbf, 0xbe,
+	0xfa, 0x1f, 0x55, 0xa2, 0x2c, 0x8b, 0x62, 0xca, 0x1f, 0x58, 0x11, 0xe4, 0x7e, 0xc1, 0xcf, 0xfb,
+	0x80, 0x96, 0xf7, 0x05, 0xcb, 0x79, 0x56, 0xa8, 0x7a, 0xdb, 0xbf, 0x1d, 0x80, 0x6b, 0x46, 0xe3,
+	0xc0, 0xab, 0x62, 0x5a, 0x92, 0x1f, 0xc1, 0x49, 0x68, 0x59, 0xfa, 0x11, 0x75, 0x37, 0x5f, 0x4d,
+	0xbe, 0x5d, 0x5c, 0xbe, 0xdc, 0x35, 0x03, 0xf8, 0x43, 0x05, 0xa4, 0xd0, 0xd3, 0x32, 0xf2, 0x03,
+	0x58, 0x61, 0x9c, 0xf9, 0xdc, 0x9d, 0x48, 0xfd, 0x8b, 0x56, 0x7f, 0x2d, 0xdc, 0x52, 0xfd, 0xeb,
+	0x67, 0x9e, 0x12, 0x91, 0x3d, 0xd8, 0x41, 0x56, 0xdd, 0xc5, 0xd4, 0x35, 0xa4, 0xfc, 0xf3, 0x56,
+	0x7e, 0x25, 0xfd, 0x5a, 0x5f, 0xcb, 0x44, 0x79, 0x96, 0xf2, 0x37, 0x97, 0xae, 0x89, 0xcb, 0xdf,
+	0x08, 0x77, 0x53, 0x5e, 0x8a, 0x6a, 0xf5, 0xf1, 0xe0, 0x4e, 0x47, 0xd4, 0xc7, 0x43, 0x57, 0x7d,
+	0x3c, 0x88, 0xc1, 0x54, 0xaa, 0xb8, 0x85, 0x07, 0xf3, 0xa1, 0x57, 0xbd, 0x96, 0xe9, 0x84, 0xe3,
+	0xc1, 0xb5, 0xc7, 0x12, 0x5a, 0x40, 0x2d, 0x13, 0x09, 0xa5, 0x22, 0x38, 0x38, 0xe1, 0xb6, 0x4f,
+	0x28, 0x1b, 0x42, 0xa9, 0x08, 0xb3, 0xb1, 0x84, 0x0e, 0x41, 0xc9, 0xc8, 0x25, 0x38, 0x21, 0x3b,
+	0xd1, 0xe0, 0xcd, 0xa5, 0x3b, 0xc7, 0x0b, 0x76, 0xad, 0x02, 0x3a, 0x45, 0x0b, 0x9b, 0x9c, 0xe3,
+	0xc1, 0x85, 0xd1, 0x9c, 0x16, 0xa3, 0x85, 0xe4, 0x27, 0x98, 0x95, 0x1a, 0xb4, 0x90, 0x49, 0xaf,
+	0x3a, 0x43, 0x43, 0xa4, 0x46, 0xda, 0xa6, 0x1d, 0x0f, 0xee, 0xc5, 0x78, 0x5a, 0x0b, 0x6b, 0xa4,
+	0x72, 0x1a, 0x78, 0xc1, 0xd2, 0xc8, 0x7d, 0x36, 0x98, 0x06, 0xe9, 0x6f, 0xa7, 0x41, 0x9a, 0x62,
+	0xe1, 0xef, 0xce, 0x9c, 0x96, 0xee, 0x0a, 0x2f, 0xfc, 0x3b, 0xe1, 0x6e, 0x16, 0x5e, 0x8a, 0xc8,
+	0x77, 0x30, 0xa5, 0x69, 0x95, 0xb8, 0x6b, 0x29, 0x7e, 0xde, 0x8a, 0x7f, 0x49, 0xab, 0x44, 0x6b,
+	0xa5, 0x44, 0x34, 0x50, 0xd0, 0x9c, 0xfa, 0x9c, 0x06, 0x2e, 0xc1, 0x0d, 0x78, 0x75, 0xa4, 0x69,
+	0x40, 0x4b, 0xc9, 0x37, 0x60, 0x26, 0x7e, 0xee, 0x3e, 0x97, 0x19, 0xa4, 0x73, 0x86, 0xfc, 0x5c,
+	0x8b, 0x85, 0xe0, 0x9d, 0x0d, 0x53, 0x7e, 0xce, 0xe9, 0x36, 0x04, 0x68, 0x8f, 0x0b, 0x79, 0x01,
+	0xd6, 0x7d, 0x96, 0x96, 0xea, 0x4c, 0x19, 0x9e, 0x32, 0xc8, 0x33, 0x30, 0x62, 0x2e, 0xcf, 0x8d,
+	0xe1, 0x19, 0x31, 0x27, 0x6b, 0x30, 0x63, 0x4e, 0xe5, 0xc1, 0x30, 0x3c, 0xf1, 0x29, 0x14, 0x11,
+	0x97, 0x7b, 0xdf, 0xf0, 0x8c, 0x48, 0x2a, 0x22, 0x4e, 0xe5, 0xee, 0x36, 0x3c, 0xf1, 0xb9, 0x8d,
+	0x60, 0xd1, 0x39, 0x67, 0x7d, 0xd0, 0x64, 0x08, 0x9a, 0x60, 0xd0, 0x04, 0x83, 0x26, 0x18, 0x34,
+	0x51, 0xa0, 0x10, 0xa0, 0xdd, 0xe0, 0x7d, 0x8e, 0x35, 0xe4, 0x58, 0x98, 0x63, 0x61, 0x8e, 0x85,
+	0x39, 0x56, 0x97, 0x73, 0x3c, 0x8c, 0x70, 0xcc, 0x21, 0xc7, 0xc4, 0x1c, 0x13, 0x73, 0x4c, 0xcc,
+	0x31, 0x9b, 0x89, 0xfb, 0xf0, 0x54, 0x43, 0xcb, 0x21, 0x68, 0x89, 0x41, 0x4b, 0x0c, 0x5a, 0x62,
+	0xd0, 0xb2, 0x07, 0x1a, 0xed, 0x68, 0x3a, 0x04, 0x4d, 0x31, 0x68, 0x8a, 0x41, 0x53, 0x0c, 0x9a,
+	0x36, 0xa0, 0xdb, 0xa7, 0x3a, 0xda, 0x0c, 0x41, 0x1b, 0x0c, 0xda, 0x60, 0xd0, 0x06, 0x83, 0x36,
+	0x3d, 0xd0, 0x68, 0x47, 0x64, 0x08, 0x22, 0x18, 0x44, 0x30, 0x88, 0x60, 0x10, 0x51, 0xa0, 0x07,
+	0xb8, 0xe8, 0xfe, 0x88, 0xfa, 0x24, 0x67, 0x48, 0x72, 0x30, 0xc9, 0xc1, 0x24, 0x07, 0x93, 0x9c,
+	0x3e, 0x69, 0xb4, 0x27, 0x7b, 0x48, 0xb2, 0x31, 0xc9, 0xc6, 0x24, 0x1b, 0x93, 0x6c, 0x45, 0x62,
+	0xb0, 0xbc, 0x7d, 0xba, 0xa9, 0xd5, 0x10, 0xb5, 0xc2, 0xa8, 0x15, 0x46, 0xad, 0x30, 0x6a, 0x85,
+	0x50, 0xa3, 0x5d, 0xad, 0x87, 0xa8, 0x35, 0x46, 0xad, 0x31, 0x6a, 0x8d, 0x51, 0x6b, 0x85, 0xfa,
+	0x67, 0x02, 0x8b, 0xce, 0x8f, 0xbc, 0x4f, 0x9a, 0x6b, 0xd2, 0x2b, 0x70, 0x12, 0x96, 0x7e, 0x8c,
+	0x69, 0x5a, 0x6f, 0x75, 0x3b, 0x61, 0xe9, 0xef, 0x34, 0x95, 0x01, 0xff, 0x24, 0x03, 0x66, 0x1d,
+	0xf0, 0x4f, 0x22, 0xe0, 0x82, 0x93, 0xfb, 0x9c, 0xd3, 0x22, 0x95, 0x37, 0xf4, 0xdc, 0xd3, 0x26,
+	0x79, 0x09, 0x76, 0x5e, 0xd0, 0x90, 0x9d, 0xe4, 0x4d, 0x3c, 0xf7, 0x6a, 0x4b, 0xf8, 0xcb, 0x2a,
+	0x14, 0xfe, 0x99, 0xf2, 0x2b, 0x6b, 0xfb, 0x33, 0x40, 0x7b, 0x73, 0x7c, 0xfa, 0x48, 0xb6, 0x3b,
+	0x98, 0x37, 0x97, 0x09, 0xf9, 0x1a, 0x2e, 0x02, 0x1a, 0xb2, 0x94, 0x06, 0x1f, 0xb3, 0x34, 0x3e,
+	0xcb, 0x1a, 0x33, 0x6f, 0x51, 0xfb, 0xde, 0xa7, 0xf1, 0x79, 0xfb, 0x3d, 0x5c, 0x74, 0xdf, 0x57,
+	0xe4, 0xb5, 0xb8, 0x77, 0xfe, 0xaa, 0x58, 0x41, 0x83, 0x5a, 0xde, 0xd8, 0xdb, 0x1b, 0x58, 0xf6,
+	0x6e, 0x1e, 0xf2, 0x05, 0xcc, 0xc5, 0xf0, 0x18, 0xa7, 0x49, 0x59, 0xff, 0x28, 0x66, 0x09, 0x4b,
+	0x6f, 0x84, 0x2d, 0x83, 0xfe, 0xa9, 0x0e, 0x1a, 0x75, 0xd0, 0x3f, 0xc9, 0xe0, 0xf6, 0x0a, 0x66,
+	0xfa, 0x4a, 0xd2, 0x55, 0x72, 0x9f, 0x15, 0xdd, 0x2a, 0x7f, 0x0a, 0x5b, 0x57, 0x51, 0xc1, 0xb6,
+	0x8a, 0x0c, 0xbe, 0xfd, 0x0d, 0xac, 0x42, 0x96, 0xf8, 0x72, 0xa7, 0x5e, 0x9c, 0xea, 0x71, 0x79,
+	0x57, 0x85, 0x3b, 0xf9, 0xb6, 0x7c, 0x9f, 0x73, 0x96, 0xa5, 0xa5, 0xfb, 0xef, 0x6c, 0xf0, 0x46,
+	0x6c, 0x9e, 0x9e, 0x9e, 0x2a, 0xf1, 0xdf, 0x00, 0x0d, 0x73, 0x8a, 0xe2, 0x1d, 0x0b, 0x00, 0x00,
+}
@@ -31,11 +31,21 @@ func GenerateGokit(sd *svcdef.Svcdef, conf gengokit.Config) (map[string]io.Reade
 
 	// Remove the suffix "-service" since it's added back in by templatePathToActual
 	svcname := strings.ToLower(sd.Service.Name)
+
+	handlersData, err := prepareHandlers(data, conf, codeGenFiles)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot prepare handlers")
+	}
+
 	for _, templPath := range templFiles.AssetNames() {
 		// Re-derive the actual path for this file based on the service output
 		// path provided by the truss main.go
 		actualPath := templatePathToActual(templPath, svcname)
-		file, err := generateResponseFile(templPath, data, conf.PreviousFiles[actualPath])
+		d := data
+		if templPath == handlers.ServerHandlerPath {
+			d = handlersData
+		}
+		file, err := generateResponseFile(templPath, d, conf.PreviousFiles[actualPath])
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot render template")
 		}
@@ -46,6 +56,122 @@ func GenerateGokit(sd *svcdef.Svcdef, conf gengokit.Config) (map[string]io.Reade
 	return codeGenFiles, nil
 }
 
+// prepareHandlers sets the style of the handlers in data, as found in their
+// previous files, and returns the data for rendering handlers.go, which only
+// holds the handlers not found in the other files of the handlers package,
+// nor written to files of their own when conf.HandlersPerRPC is set. The
+// files of the handlers package rendered besides the templates, such as
+// orphaned.go, are added to files, and conf.PreviousFiles is updated for
+// rendering the templates.
+func prepareHandlers(data *gengokit.Data, conf gengokit.Config, files map[string]io.Reader) (*gengokit.Data, error) {
+	svcname := strings.ToLower(data.Service.Name)
+
+	// The previous files of the handlers package are read again for
+	// rendering them, so keep their contents
+	handlersPath := templatePathToActual(handlers.ServerHandlerPath, svcname)
+	prev := make(map[string][]byte)
+	for path, r := range conf.PreviousFiles {
+		if r == nil || (path != handlersPath && !handlers.IsPackageFile(path)) {
+			continue
+		}
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read previous file: %q", path)
+		}
+		conf.PreviousFiles[path] = bytes.NewReader(code)
+		prev[path] = code
+	}
+	readers := func(exclude string) map[string]io.Reader {
+		rv := make(map[string]io.Reader)
+		for path, code := range prev {
+			if path != exclude {
+				rv[path] = bytes.NewReader(code)
+			}
+		}
+		return rv
+	}
+
+	style, err := handlers.Style(data.Service, readers(""))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot find the style of the handlers")
+	}
+	data.Handlers = style
+
+	elsewhere, err := handlers.Implemented(data.Service, readers(handlersPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot find handlers outside of handlers.go")
+	}
+	svc := *data.Service
+	svc.Methods = nil
+	for _, m := range data.Service.Methods {
+		if _, ok := elsewhere[m.Name]; !ok {
+			svc.Methods = append(svc.Methods, m)
+		}
+	}
+
+	var prevHandlers io.Reader
+	if code, ok := prev[handlersPath]; ok {
+		prevHandlers = bytes.NewReader(code)
+	}
+	pruned, err := handlers.Prune(&svc, prevHandlers)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot prune previous handlers")
+	}
+	orphaned, err := pruned.Orphaned(conf.PreviousFiles[handlers.OrphanedPath])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot keep funcs removed from handlers")
+	}
+	if orphaned != nil {
+		files[handlers.OrphanedPath] = orphaned
+	}
+
+	// The tests of the handlers follow them, before stubs are added for the
+	// new ones
+	testsPath := templatePathToActual(handlers.TestsPath, svcname)
+	tests, orphanedTests, err := pruned.Tests(conf.PreviousFiles[testsPath], conf.PreviousFiles[handlers.OrphanedTestsPath])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot update tests of handlers")
+	}
+	if tests != nil {
+		conf.PreviousFiles[testsPath] = tests
+	}
+	if orphanedTests != nil {
+		files[handlers.OrphanedTestsPath] = orphanedTests
+	}
+
+	if conf.HandlersPerRPC {
+		own := make(map[string]bool)
+		for _, m := range pruned.Missing {
+			// Handlers whose file is taken by another stay in handlers.go
+			path := handlers.MethodPath(m)
+			if path == "" || conf.PreviousFiles[path] != nil {
+				continue
+			}
+			file, err := handlers.RenderMethod(m, data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot render handler of %q", m.Name)
+			}
+			code, err := ioutil.ReadAll(file)
+			if err != nil {
+				return nil, err
+			}
+			files[path] = bytes.NewReader(formatCode(code))
+			own[m.Name] = true
+		}
+		methods := svc.Methods
+		svc.Methods = nil
+		for _, m := range methods {
+			if !own[m.Name] {
+				svc.Methods = append(svc.Methods, m)
+			}
+		}
+	}
+
+	handlersData := *data
+	handlersData.Service = &svc
+	return &handlersData, nil
+}
+
 // generateResponseFile contains logic to choose how to render a template file
 // based on path and if that file was generated previously. It accepts a
 // template path to render, a templateExecutor to apply to the template, and a
@@ -68,6 +194,15 @@ func generateResponseFile(templFP string, data *gengokit.Data, prevFile io.Reade
 		if genCode, err = h.Render(templFP, data); err != nil {
 			return nil, errors.Wrapf(err, "cannot render template: %s", templFP)
 		}
+	case handlers.TestsPath:
+		t, err := handlers.NewTests(data.Service, prevFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse previous handler tests: %q", actualFP)
+		}
+
+		if genCode, err = t.Render(templFP, data); err != nil {
+			return nil, errors.Wrapf(err, "cannot render template: %s", templFP)
+		}
 	case handlers.HookPath:
 		hook := handlers.NewHook(prevFile)
 		if genCode, err = hook.Render(templFP, data); err != nil {
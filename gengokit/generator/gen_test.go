@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"bytes"
 	"go/format"
 	"io"
 	"io/ioutil"
@@ -103,6 +104,78 @@ func TestApplyTemplateFromPath(t *testing.T) {
 	}
 }
 
+func TestMethodOptionsTemplate(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+
+		package general;
+
+		import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+		import "github.com/metaverse/truss/deftree/googlethirdparty/descriptor.proto";
+
+		extend google.protobuf.MethodOptions {
+			bool public = 50001;
+			string role = 50002;
+		}
+
+		message Empty {}
+
+		service ProtoService {
+			rpc Public (Empty) returns (Empty) {
+				option (public) = true;
+				option (google.api.http) = {
+					get: "/public"
+				};
+			}
+			rpc Admin (Empty) returns (Empty) {
+				option (google.api.http) = {
+					get: "/admin"
+				};
+				option (general.role) = "admin \"root\"";
+			}
+			rpc Plain (Empty) returns (Empty) {
+				option (google.api.http) = {
+					get: "/plain"
+				};
+			}
+		}
+	`
+	te, err := stringToTemplateExector(def, "github.com/metaverse/truss/gengokit/general-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	end, err := applyTemplateFromPath("svc/endpoints.gotemplate", te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endCode, err := ioutil.ReadAll(end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := testFormat(string(endCode))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `var MethodOptions = map[string]map[string]string{
+	"Public": {
+		"general.public": "true",
+	},
+	"Admin": {
+		"general.role": "admin \"root\"",
+	},
+}`
+	if !strings.Contains(code, want) {
+		t.Errorf("endpoints lack the options table:\n%s", code)
+	}
+	for _, f := range []string{"WrapByOption", "WrapByOptionExcept"} {
+		if !strings.Contains(code, "func (e *Endpoints) "+f+"(option, value string, middleware endpoint.Middleware) {") {
+			t.Errorf("endpoints lack %s", f)
+		}
+	}
+}
+
 func svcMethodsNames(methods []*svcdef.ServiceMethod) []string {
 	var mNames []string
 	for _, m := range methods {
@@ -313,3 +386,244 @@ func testFormat(code string) (string, error) {
 
 	return string(formatted), nil
 }
+
+// generateFiles generates the service of the definition def with conf,
+// returning the content of each file by its path.
+func generateFiles(t *testing.T, def string, conf gengokit.Config) map[string][]byte {
+	t.Helper()
+	sd, err := svcdef.NewFromString(def, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := GenerateGokit(sd, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rv := make(map[string][]byte)
+	for path, r := range files {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rv[path] = b
+	}
+	return rv
+}
+
+func TestGenerateHandlersElsewhere(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service ProtoService {
+			rpc ProtoMethod (RequestMessage) returns (ResponseMessage) {}
+			rpc ProtoMethodAgain (RequestMessage) returns (ResponseMessage) {}
+		}
+	`
+	const first = `
+		package handlers
+
+		func (s protoserviceService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+			return &pb.ResponseMessage{Output: in.Input}, nil
+		}
+	`
+
+	generate := func(perRPC bool) map[string][]byte {
+		return generateFiles(t, def, gengokit.Config{
+			GoPackage:      "github.com/metaverse/truss/gengokit",
+			PBPackage:      "github.com/metaverse/truss/gengokit/general-service",
+			HandlersPerRPC: perRPC,
+			PreviousFiles: map[string]io.Reader{
+				"handlers/first.go": strings.NewReader(first),
+			},
+		})
+	}
+
+	code := generate(false)
+	if strings.Contains(string(code["handlers/handlers.go"]), ") ProtoMethod(") {
+		t.Errorf("handlers.go holds a handler written in first.go:\n%s", code["handlers/handlers.go"])
+	}
+	if !strings.Contains(string(code["handlers/handlers.go"]), ") ProtoMethodAgain(") {
+		t.Errorf("handlers.go lacks the stub of ProtoMethodAgain:\n%s", code["handlers/handlers.go"])
+	}
+	if _, ok := code["handlers/first.go"]; ok {
+		t.Error("first.go was regenerated")
+	}
+
+	code = generate(true)
+	if strings.Contains(string(code["handlers/handlers.go"]), "ProtoMethod") ||
+		strings.Contains(string(code["handlers/handlers.go"]), "\"context\"") {
+		t.Errorf("handlers.go holds handlers written elsewhere:\n%s", code["handlers/handlers.go"])
+	}
+	if !strings.Contains(string(code["handlers/protomethodagain.go"]), ") ProtoMethodAgain(") {
+		t.Errorf("protomethodagain.go lacks the stub of ProtoMethodAgain:\n%s", code["handlers/protomethodagain.go"])
+	}
+	if _, ok := code["handlers/protomethod.go"]; ok {
+		t.Error("protomethod.go was generated for a handler written in first.go")
+	}
+}
+
+func TestGenerateStatelessHandlers(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service ProtoService {
+			rpc ProtoMethod (RequestMessage) returns (ResponseMessage) {}
+			rpc ProtoMethodAgain (RequestMessage) returns (ResponseMessage) {}
+		}
+	`
+	// handlers.go of a service generated before NewService took Dependencies
+	const prev = `
+		package handlers
+
+		import (
+			"context"
+
+			pb "github.com/metaverse/truss/gengokit/general-service"
+		)
+
+		// NewService returns a naïve, stateless implementation of Service.
+		func NewService() pb.ProtoServiceServer {
+			return protoserviceService{}
+		}
+
+		type protoserviceService struct{}
+
+		func (s protoserviceService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+			return &pb.ResponseMessage{Output: in.Input}, nil
+		}
+	`
+
+	code := generateFiles(t, def, gengokit.Config{
+		GoPackage: "github.com/metaverse/truss/gengokit",
+		PBPackage: "github.com/metaverse/truss/gengokit/general-service",
+		PreviousFiles: map[string]io.Reader{
+			"handlers/handlers.go": strings.NewReader(prev),
+		},
+	})
+
+	if !strings.Contains(string(code["svc/server/run.go"]), "service := handlers.NewService()\n") {
+		t.Errorf("run.go does not call NewService without Dependencies:\n%s", code["svc/server/run.go"])
+	}
+	if strings.Contains(string(code["handlers/hooks.go"]), "NewDependencies") {
+		t.Errorf("hooks.go has NewDependencies without Dependencies:\n%s", code["handlers/hooks.go"])
+	}
+	if !strings.Contains(string(code["handlers/handlers.go"]), "func (s protoserviceService) ProtoMethodAgain(") {
+		t.Errorf("handlers.go lacks a stub of ProtoMethodAgain with a value receiver:\n%s", code["handlers/handlers.go"])
+	}
+	if !strings.Contains(string(code["handlers/handlers_test.go"]), "NewService().ProtoMethodAgain(") {
+		t.Errorf("handlers_test.go does not call NewService without Dependencies:\n%s", code["handlers/handlers_test.go"])
+	}
+}
+
+func TestGenerateReproducible(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service ProtoService {
+			rpc First (RequestMessage) returns (ResponseMessage) {}
+			rpc Second (RequestMessage) returns (ResponseMessage) {}
+			rpc Third (RequestMessage) returns (ResponseMessage) {}
+			rpc Fourth (RequestMessage) returns (ResponseMessage) {}
+			rpc Fifth (RequestMessage) returns (ResponseMessage) {}
+		}
+	`
+	// Only some handlers, tests and hooks exist, so that stubs of the rest
+	// are added
+	prev := map[string]string{
+		"handlers/handlers.go": `
+			package handlers
+
+			import (
+				"context"
+
+				pb "github.com/metaverse/truss/gengokit/general-service"
+			)
+
+			type Dependencies struct{}
+
+			func NewService(deps Dependencies) pb.ProtoServiceServer {
+				return &protoserviceService{deps: deps}
+			}
+
+			type protoserviceService struct {
+				deps Dependencies
+			}
+
+			func (s *protoserviceService) Third(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+				return &pb.ResponseMessage{Output: in.Input}, nil
+			}
+		`,
+		"handlers/handlers_test.go": `
+			package handlers
+
+			import "testing"
+
+			func TestThird(t *testing.T) {}
+		`,
+		"handlers/hooks.go": `
+			package handlers
+
+			import (
+				"fmt"
+				"os"
+				"os/signal"
+				"syscall"
+			)
+
+			func InterruptHandler(errc chan<- error) {
+				c := make(chan os.Signal, 1)
+				signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+				errc <- fmt.Errorf("%s", <-c)
+			}
+		`,
+	}
+
+	generate := func() map[string][]byte {
+		prevFiles := make(map[string]io.Reader)
+		for path, code := range prev {
+			prevFiles[path] = strings.NewReader(code)
+		}
+		return generateFiles(t, def, gengokit.Config{
+			GoPackage:     "github.com/metaverse/truss/gengokit",
+			PBPackage:     "github.com/metaverse/truss/gengokit/general-service",
+			PreviousFiles: prevFiles,
+		})
+	}
+
+	first := generate()
+	for i := 0; i < 10; i++ {
+		again := generate()
+		if len(again) != len(first) {
+			t.Fatalf("generated %d files, then %d", len(first), len(again))
+		}
+		for path, code := range first {
+			if !bytes.Equal(again[path], code) {
+				t.Fatalf("%s differs when generated again\n%s", path, diff(string(code), string(again[path])))
+			}
+		}
+	}
+}
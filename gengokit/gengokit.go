@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/metaverse/truss/gengokit/httptransport"
+	"github.com/metaverse/truss/gengokit/validation"
 	"github.com/metaverse/truss/svcdef"
 )
 
@@ -23,6 +24,10 @@ type Config struct {
 	Version     string
 	VersionDate string
 
+	// HandlersPerRPC writes the stub of each new handler to a file of its
+	// own in the handlers package, rather than to handlers.go.
+	HandlersPerRPC bool
+
 	PreviousFiles map[string]io.Reader
 }
 
@@ -46,12 +51,28 @@ type Data struct {
 	Service *svcdef.Service
 	// A helper struct for generating http transport functionality.
 	HTTPHelper *httptransport.Helper
-	FuncMap    template.FuncMap
+	// A helper struct for generating the checks of validation rules.
+	Validation *validation.Helper
+	// How the handlers package, which users own, defines the service.
+	Handlers HandlersStyle
+	FuncMap  template.FuncMap
 
 	Version     string
 	VersionDate string
 }
 
+// HandlersStyle is how the handlers package defines the service, which the
+// code calling and extending the handlers follows.
+type HandlersStyle struct {
+	// Dependencies is whether NewService takes the Dependencies of the
+	// handlers, which the NewDependencies hook creates.
+	Dependencies bool
+	// PointerReceivers is whether the handlers have pointer receivers.
+	PointerReceivers bool
+}
+
+// NewData returns the Data for generating the service sd, with the handlers
+// in the style of new services.
 func NewData(sd *svcdef.Svcdef, conf Config) (*Data, error) {
 	return &Data{
 		ImportPath:   conf.GoPackage,
@@ -59,9 +80,14 @@ func NewData(sd *svcdef.Svcdef, conf Config) (*Data, error) {
 		PackageName:  sd.PkgName,
 		Service:      sd.Service,
 		HTTPHelper:   httptransport.NewHelper(sd.Service),
+		Validation:   validation.NewHelper(sd),
 		FuncMap:      FuncMap,
 		Version:      conf.Version,
 		VersionDate:  conf.VersionDate,
+		Handlers: HandlersStyle{
+			Dependencies:     true,
+			PointerReceivers: true,
+		},
 	}, nil
 }
 
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/metaverse/truss/gengokit"
+	"github.com/metaverse/truss/gengokit/handlers/templates"
+	"github.com/metaverse/truss/svcdef"
+)
+
+// reservedFiles are the files of the handlers package which truss renders
+// itself, so they are never the file of a single handler.
+var reservedFiles = map[string]bool{
+	"handlers/handlers.go":    true,
+	"handlers/hooks.go":       true,
+	"handlers/middlewares.go": true,
+	OrphanedPath:              true,
+}
+
+// IsPackageFile returns whether path, relative to the service, is a Go file
+// of the handlers package other than handlers.go, where handlers may also be
+// written. Test files are not.
+func IsPackageFile(p string) bool {
+	return path.Dir(p) == "handlers" &&
+		strings.HasSuffix(p, ".go") &&
+		!strings.HasSuffix(p, "_test.go") &&
+		p != "handlers/handlers.go"
+}
+
+// Implemented returns the methods of svc which have a handler in files, the
+// Go files of the handlers package other than handlers.go keyed by their
+// path, mapped to the path of the file holding the handler.
+func Implemented(svc *svcdef.Service, files map[string]io.Reader) (map[string]string, error) {
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	meths := newMethodMap(svc.Methods)
+	svcName := strings.ToLower(svc.Name)
+	rv := make(map[string]string)
+	for _, p := range paths {
+		f, err := parser.ParseFile(token.NewFileSet(), p, files[p], 0)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse %q", p)
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || !isServiceRecv(fn.Recv, svcName) || !ast.IsExported(fn.Name.Name) {
+				continue
+			}
+			name := fn.Name.Name
+			if meths[name] == nil {
+				log.WithField("Method", name).WithField("File", p).
+					Warn("Handler is not an rpc of the service; remove it or add the rpc")
+				continue
+			}
+			rv[name] = p
+		}
+	}
+	return rv, nil
+}
+
+// Style returns how files, the Go files of the handlers package keyed by their
+// path, define the service svc. Without files, the package is yet to be
+// generated in the style of new services.
+func Style(svc *svcdef.Service, files map[string]io.Reader) (gengokit.HandlersStyle, error) {
+	if len(files) == 0 {
+		return gengokit.HandlersStyle{Dependencies: true, PointerReceivers: true}, nil
+	}
+
+	svcName := strings.ToLower(svc.Name)
+	var style gengokit.HandlersStyle
+	var value bool
+	for p, r := range files {
+		f, err := parser.ParseFile(token.NewFileSet(), p, r, 0)
+		if err != nil {
+			return style, errors.Wrapf(err, "cannot parse %q", p)
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			switch {
+			case fn.Recv == nil && fn.Name.Name == ignoredFunc:
+				deps, err := newServiceTakesDependencies(fn)
+				if err != nil {
+					return style, errors.Wrapf(err, "cannot use %q", p)
+				}
+				style.Dependencies = deps
+			case isServiceRecv(fn.Recv, svcName):
+				if strings.HasPrefix(recvTypeToString(fn.Recv), "*") {
+					style.PointerReceivers = true
+				} else {
+					value = true
+				}
+			}
+		}
+	}
+	// Without any method on the server struct, new handlers follow NewService
+	if !style.PointerReceivers && !value {
+		style.PointerReceivers = style.Dependencies
+	}
+	return style, nil
+}
+
+// newServiceTakesDependencies reports whether fn, the NewService function of
+// the handlers, takes the Dependencies of the handlers rather than nothing,
+// which are the two ways the generated code calls it.
+func newServiceTakesDependencies(fn *ast.FuncDecl) (bool, error) {
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false, nil
+	}
+	if len(params) == 1 && len(params[0].Names) <= 1 && exprString(params[0].Type) == "Dependencies" {
+		return true, nil
+	}
+	var types []string
+	for _, p := range params {
+		for i := 0; i < len(p.Names) || i == 0; i++ {
+			types = append(types, exprString(p.Type))
+		}
+	}
+	return false, errors.Errorf("NewService takes (%s), but must take either nothing or the Dependencies "+
+		"struct of the handlers, which the NewDependencies hook in handlers/hooks.go creates from the config; "+
+		"move what NewService needs into Dependencies", strings.Join(types, ", "))
+}
+
+// MethodPath returns the path of the file holding only the handler of meth,
+// for services writing one handler per file. It returns "" if that path is
+// one of the files truss renders.
+func MethodPath(meth *svcdef.ServiceMethod) string {
+	p := "handlers/" + strings.ToLower(meth.Name) + ".go"
+	if reservedFiles[p] {
+		return ""
+	}
+	return p
+}
+
+// RenderMethod returns a file holding only the stub of the handler of meth.
+func RenderMethod(meth *svcdef.ServiceMethod, data *gengokit.Data) (io.Reader, error) {
+	ex := handlerData{
+		ServiceName:  data.Service.Name,
+		PBImportPath: data.PBImportPath,
+		Pointer:      data.Handlers.PointerReceivers,
+		Methods:      []*svcdef.ServiceMethod{meth},
+	}
+	return gengokit.ApplyTemplate(templates.HandlerFile, "HandlerFile", ex, gengokit.FuncMap)
+}
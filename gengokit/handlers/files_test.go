@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/metaverse/truss/gengokit"
+	"github.com/metaverse/truss/svcdef"
+)
+
+func TestImplemented(t *testing.T) {
+	sd, _ := orphanedData(t)
+
+	files := map[string]io.Reader{
+		"handlers/louder.go": strings.NewReader(`
+			package handlers
+
+			func (s echoService) Louder(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {
+				return &pb.EchoResponse{Out: in.In}, nil
+			}
+
+			func (s echoService) Quieter(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {
+				return &pb.EchoResponse{Out: in.In}, nil
+			}
+
+			func (s echoService) louder(in string) string {
+				return in
+			}
+
+			func Echo() {}
+		`),
+		"handlers/util.go": strings.NewReader(`
+			package handlers
+
+			type other struct{}
+
+			func (o other) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+				return nil, nil
+			}
+		`),
+	}
+
+	impl, err := Implemented(sd.Service, files)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Louder": "handlers/louder.go"}, impl)
+
+	_, err = Implemented(sd.Service, map[string]io.Reader{
+		"handlers/broken.go": strings.NewReader("package handlers\n\nfunc ("),
+	})
+	require.Error(t, err)
+}
+
+func TestIsPackageFile(t *testing.T) {
+	for p, want := range map[string]bool{
+		"handlers/louder.go":        true,
+		"handlers/hooks.go":         true,
+		"handlers/handlers.go":      false,
+		"handlers/louder_test.go":   false,
+		"handlers/louder.txt":       false,
+		"handlers/sub/louder.go":    false,
+		"svc/server/run.go":         false,
+		"handlers/orphaned_test.go": false,
+	} {
+		require.Equal(t, want, IsPackageFile(p), p)
+	}
+}
+
+func TestStyle(t *testing.T) {
+	sd, _ := orphanedData(t)
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  gengokit.HandlersStyle
+	}{
+		{
+			name: "new service",
+			want: gengokit.HandlersStyle{Dependencies: true, PointerReceivers: true},
+		},
+		{
+			name: "stateless",
+			files: map[string]string{
+				"handlers/handlers.go": `
+					package handlers
+
+					func NewService() pb.EchoServer {
+						return echoService{}
+					}
+
+					func (s echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+						return nil, nil
+					}
+				`,
+			},
+		},
+		{
+			name: "dependencies in other files",
+			files: map[string]string{
+				"handlers/handlers.go": `
+					package handlers
+
+					func NewService(deps Dependencies) pb.EchoServer {
+						return &echoService{deps: deps}
+					}
+				`,
+				"handlers/echo.go": `
+					package handlers
+
+					func (s *echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+						return nil, nil
+					}
+				`,
+			},
+			want: gengokit.HandlersStyle{Dependencies: true, PointerReceivers: true},
+		},
+		{
+			name: "dependencies without handlers",
+			files: map[string]string{
+				"handlers/handlers.go": `
+					package handlers
+
+					func NewService(deps Dependencies) pb.EchoServer {
+						return &echoService{deps: deps}
+					}
+				`,
+			},
+			want: gengokit.HandlersStyle{Dependencies: true, PointerReceivers: true},
+		},
+		{
+			name: "pointer receivers without dependencies",
+			files: map[string]string{
+				"handlers/handlers.go": `
+					package handlers
+
+					func NewService() pb.EchoServer {
+						return &echoService{}
+					}
+
+					func (s *echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+						return nil, nil
+					}
+				`,
+			},
+			want: gengokit.HandlersStyle{PointerReceivers: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]io.Reader)
+			for p, code := range tt.files {
+				files[p] = strings.NewReader(code)
+			}
+			got, err := Style(sd.Service, files)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStyleUnsupportedNewService(t *testing.T) {
+	sd, _ := orphanedData(t)
+
+	for _, params := range []string{"db *sql.DB", "deps Dependencies, db *sql.DB", "a, b int"} {
+		code := `
+			package handlers
+
+			func NewService(` + params + `) pb.EchoServer {
+				return &echoService{}
+			}
+		`
+		_, err := Style(sd.Service, map[string]io.Reader{"handlers/handlers.go": strings.NewReader(code)})
+		if err == nil || !strings.Contains(err.Error(), "NewDependencies") {
+			t.Errorf("NewService(%s): err = %v, want an error pointing to NewDependencies", params, err)
+		}
+	}
+}
+
+func TestRenderPointerReceivers(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import (
+			"context"
+
+			pb "github.com/metaverse/truss/gengokit/echo-service"
+		)
+
+		func NewService() pb.EchoServer {
+			return &echoService{}
+		}
+
+		type echoService struct {
+			mu sync.Mutex
+		}
+
+		func (s *echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+			return &pb.EchoResponse{Out: in.In}, nil
+		}
+	`
+	sd, data := orphanedData(t)
+	data.Handlers = gengokit.HandlersStyle{PointerReceivers: true}
+
+	code, err := renderService(sd.Service, prev, data)
+	require.NoError(t, err)
+	require.Contains(t, code, "func (s *echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {\n\treturn &pb.EchoResponse{Out: in.In}, nil\n}")
+	require.Contains(t, code, "func (s *echoService) Louder(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {")
+	require.Equal(t, 1, strings.Count(code, ") Echo("))
+}
+
+func TestMethodPath(t *testing.T) {
+	require.Equal(t, "handlers/louder.go", MethodPath(&svcdef.ServiceMethod{Name: "Louder"}))
+	require.Equal(t, "", MethodPath(&svcdef.ServiceMethod{Name: "Hooks"}))
+	require.Equal(t, "", MethodPath(&svcdef.ServiceMethod{Name: "Handlers"}))
+}
+
+func TestRenderMethod(t *testing.T) {
+	sd, data := orphanedData(t)
+
+	r, err := RenderMethod(sd.Service.Methods[1], data)
+	require.NoError(t, err)
+	code, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	formatted, err := testFormat(string(code))
+	require.NoError(t, err)
+
+	require.Contains(t, formatted, "\"context\"")
+	require.Contains(t, formatted, `pb "github.com/metaverse/truss/gengokit/echo-service"`)
+	require.Contains(t, formatted, "func (s *echoService) Louder(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {")
+	require.NotContains(t, formatted, "NewService")
+	require.NotContains(t, formatted, ") Echo(")
+}
+
+func TestRenderHandlersWithoutMethods(t *testing.T) {
+	sd, data := orphanedData(t)
+
+	svc := *sd.Service
+	svc.Methods = nil
+	d := *data
+	d.Service = &svc
+
+	code, err := renderService(&svc, "", &d)
+	require.NoError(t, err)
+	require.NotContains(t, code, "\"context\"", "unused import")
+	require.Contains(t, code, "func NewService(deps Dependencies) pb.EchoServer {")
+}
+
+func TestPruneMissing(t *testing.T) {
+	sd, _ := orphanedData(t)
+
+	p, err := Prune(sd.Service, nil)
+	require.NoError(t, err)
+	require.Equal(t, sd.Service.Methods, p.Missing)
+
+	p, err = Prune(sd.Service, strings.NewReader(`
+		package handlers
+
+		func (s echoService) Louder(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {
+			return &pb.EchoResponse{Out: in.In}, nil
+		}
+	`))
+	require.NoError(t, err)
+	require.Len(t, p.Missing, 1)
+	require.Equal(t, "Echo", p.Missing[0].Name)
+}
@@ -8,11 +8,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
 
 	"github.com/metaverse/truss/gengokit"
 	helper "github.com/metaverse/truss/gengokit/gentesthelper"
@@ -138,14 +140,21 @@ func TestApplyServerTempl(t *testing.T) {
 			pb "github.com/metaverse/truss/gengokit/general-service"
 		)
 
-		// NewService returns a naïve, stateless implementation of Service.
-		func NewService() pb.ProtoServer {
-			return protoService{}
+		// Dependencies holds what the handlers depend on, such as database pools and
+		// clients. Add fields for them, and create them in NewDependencies in
+		// hooks.go.
+		type Dependencies struct{}
+
+		// NewService returns an implementation of Service using deps.
+		func NewService(deps Dependencies) pb.ProtoServer {
+			return &protoService{deps: deps}
 		}
 
-		type protoService struct{}
+		type protoService struct {
+			deps Dependencies
+		}
 
-		func (s protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+		func (s *protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
 			var resp pb.ResponseMessage
 			return &resp, nil
 		}
@@ -217,6 +226,9 @@ func TestIsValidFunc(t *testing.T) {
 	const valid = `package p;
 	func (s protoService) ProtoMethod(context.Context, pb.RequestMessage) (pb.ResponseMessage, error) {}`
 
+	const validPointer = `package p;
+	func (s *protoService) ProtoMethod(context.Context, pb.RequestMessage) (pb.ResponseMessage, error) {}`
+
 	const invalidRecv = `package p;
 	func (s fooService) ProtoMethod(context.Context, pb.RequestMessage) (pb.ResponseMessage, error) {}`
 
@@ -234,6 +246,10 @@ func TestIsValidFunc(t *testing.T) {
 	if ok := isValidFunc(parseFuncFromString(in, t), m, svcName); !ok {
 		t.Errorf("Func in service definition with proper recv considered invalid: %q", in)
 	}
+	in = validPointer
+	if ok := isValidFunc(parseFuncFromString(in, t), m, svcName); !ok {
+		t.Errorf("Func in service definition with pointer recv considered invalid: %q", in)
+	}
 	in = invalidRecv
 	if ok := isValidFunc(parseFuncFromString(in, t), m, svcName); ok {
 		t.Errorf("Func with invalid recv considered valid: %q", in)
@@ -331,7 +347,7 @@ func TestPruneDecls(t *testing.T) {
 	lenDeclsBefore := len(f.Decls)
 	lenMMapBefore := len(m)
 
-	newDecls := m.pruneDecls(f.Decls, strings.ToLower(sd.Service.Name))
+	newDecls, orphans, renamed := m.prune(f.Decls, strings.ToLower(sd.Service.Name))
 
 	lenDeclsAfter := len(newDecls)
 	lenMMapAfter := len(m)
@@ -343,6 +359,14 @@ func TestPruneDecls(t *testing.T) {
 	if lenMMapBefore-1 != lenMMapAfter {
 		t.Fatalf("Prune did update mMap as expected; got: %d, want: %d", lenMMapBefore-1, lenMMapAfter)
 	}
+
+	if len(orphans) != 1 || orphans[0].Name.Name != "FOOBAR" {
+		t.Fatalf("Prune did not return FOOBAR as the only orphan; got: %v", orphans)
+	}
+
+	if len(renamed) != 0 {
+		t.Fatalf("Prune renamed funcs unexpectedly; got: %v", renamed)
+	}
 }
 
 func TestUpdatePBFieldType(t *testing.T) {
@@ -413,7 +437,7 @@ func TestUpdateMethods(t *testing.T) {
 			rpc ProtoMethodAgainAgain (RequestMessage) returns (ResponseMessage) {
 				// No {} in path and no body, everything is in the query
 				option (google.api.http) = {
-					get: "/route2"
+					get: "/route3"
 				};
 			}
 		}
@@ -549,3 +573,124 @@ func parseFuncFromString(f string, t *testing.T) *ast.FuncDecl {
 	}
 	return fnc
 }
+
+const orderDef = `
+	syntax = "proto3";
+	package echo;
+
+	service Echo {
+	  rpc First (EchoRequest) returns (EchoResponse) {}
+	  rpc Second (EchoRequest) returns (EchoResponse) {}
+	  rpc Third (EchoRequest) returns (EchoResponse) {}
+	  rpc Fourth (EchoRequest) returns (EchoResponse) {}
+	  rpc Fifth (EchoRequest) returns (EchoResponse) {}
+	}
+	message EchoRequest {
+	  string In = 1;
+	}
+	message EchoResponse {
+	  string Out = 1;
+	}
+`
+
+func TestRenderStubsNextToNeighbours(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import (
+			"context"
+
+			pb "github.com/metaverse/truss/gengokit/echo-service"
+		)
+
+		func NewService(deps Dependencies) pb.EchoServer {
+			return &echoService{deps: deps}
+		}
+
+		type echoService struct {
+			deps Dependencies
+		}
+
+		// Second is written by hand
+		func (s *echoService) Second(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+			return &pb.EchoResponse{Out: in.In}, nil
+		}
+
+		func helper() {}
+
+		// Fourth is written by hand
+		func (s *echoService) Fourth(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+			return &pb.EchoResponse{Out: in.In}, nil
+		}
+
+		func otherHelper() {}
+	`
+	sd, err := svcdef.NewFromString(orderDef, gopath)
+	require.NoError(t, err)
+	data, err := gengokit.NewData(sd, gengokit.Config{
+		GoPackage: "github.com/metaverse/truss/gengokit",
+		PBPackage: "github.com/metaverse/truss/gengokit/echo-service",
+	})
+	require.NoError(t, err)
+
+	code, err := renderService(sd.Service, prev, data)
+	require.NoError(t, err)
+
+	var at []int
+	for _, s := range []string{
+		") First(",
+		"// Second is written by hand",
+		") Third(",
+		"func helper() {}",
+		"// Fourth is written by hand",
+		") Fifth(",
+		"func otherHelper() {}",
+	} {
+		i := strings.Index(code, s)
+		require.NotEqual(t, -1, i, s)
+		at = append(at, i)
+	}
+	require.True(t, sort.IntsAreSorted(at), "stubs are not next to their neighbours:\n%s", code)
+
+	// Rendering is reproducible
+	for i := 0; i < 10; i++ {
+		again, err := renderService(sd.Service, prev, data)
+		require.NoError(t, err)
+		require.Equal(t, code, again)
+	}
+}
+
+func TestRenderStubsWithoutNeighbours(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import (
+			pb "github.com/metaverse/truss/gengokit/echo-service"
+		)
+
+		func NewService(deps Dependencies) pb.EchoServer {
+			return &echoService{deps: deps}
+		}
+
+		type echoService struct {
+			deps Dependencies
+		}
+	`
+	sd, err := svcdef.NewFromString(orderDef, gopath)
+	require.NoError(t, err)
+	data, err := gengokit.NewData(sd, gengokit.Config{
+		GoPackage: "github.com/metaverse/truss/gengokit",
+		PBPackage: "github.com/metaverse/truss/gengokit/echo-service",
+	})
+	require.NoError(t, err)
+
+	code, err := renderService(sd.Service, prev, data)
+	require.NoError(t, err)
+
+	last := strings.Index(code, "type echoService struct")
+	for _, m := range []string{"First", "Second", "Third", "Fourth", "Fifth"} {
+		i := strings.Index(code, ") "+m+"(")
+		require.Greater(t, i, last, "%s is out of order:\n%s", m, code)
+		last = i
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"go/printer"
 	"go/token"
 	"io"
+	"io/ioutil"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -75,8 +77,11 @@ type handler struct {
 }
 
 type handlerData struct {
-	ServiceName string
-	Methods     []*svcdef.ServiceMethod
+	ServiceName  string
+	PBImportPath string
+	// Pointer is whether the handlers have pointer receivers
+	Pointer bool
+	Methods []*svcdef.ServiceMethod
 }
 
 // Render returns an io.Reader with the go code of the server handler. That
@@ -98,43 +103,55 @@ func (h *handler) Render(alias string, data *gengokit.Data) (io.Reader, error) {
 	// Lowercase the service name before pruning because the templates all
 	// lowercase the service name when generating code to ensure Identifiers
 	// incorporating the service name remain unexported.
-	h.ast.Decls = h.mMap.pruneDecls(h.ast.Decls, strings.ToLower(data.Service.Name))
+	var orphans []*ast.FuncDecl
+	h.ast.Decls, orphans, _ = h.mMap.prune(h.ast.Decls, strings.ToLower(data.Service.Name))
+	// The comments of the orphans move to orphaned.go along with them
+	h.ast.Comments = removeComments(h.ast.Comments, orphans)
 	log.WithField("Service Methods", len(h.mMap)).Debug("After prune")
 
-	// create a new handlerData, and add all methods not defined in the existing 'handlers/handlers.go' file
-	ex := handlerData{
-		ServiceName: data.Service.Name,
-	}
-
 	// If there are no methods to template then exit early
 	if len(h.mMap) == 0 {
 		return h.buffer()
 	}
 
-	for k, v := range h.mMap {
-		log.WithField("Method", k).
-			Info("Generating handler from rpc definition")
-		ex.Methods = append(ex.Methods, v)
-	}
-
 	// get the code out of the ast
 	code, err := h.buffer()
 	if err != nil {
 		return nil, err
 	}
 
-	// render the server for all methods not already defined
-	newCode, err := applyServerMethsTempl(ex)
-
-	if err != nil {
-		return nil, err
+	// render the server for all methods not already defined, next to the
+	// handlers of their neighbours in the service definition
+	var order []string
+	missing := make(map[string]bool)
+	for _, m := range h.service.Methods {
+		order = append(order, m.Name)
+		if h.mMap[m.Name] != nil {
+			log.WithField("Method", m.Name).
+				Info("Generating handler from rpc definition")
+			missing[m.Name] = true
+		}
+	}
+	svcName := strings.ToLower(data.Service.Name)
+	handlerName := func(f *ast.FuncDecl) string {
+		if !isServiceRecv(f.Recv, svcName) {
+			return ""
+		}
+		return f.Name.Name
+	}
+	stub := func(name string) (io.Reader, error) {
+		return applyServerMethsTempl(handlerData{
+			ServiceName: data.Service.Name,
+			Pointer:     data.Handlers.PointerReceivers,
+			Methods:     []*svcdef.ServiceMethod{h.mMap[name]},
+		})
 	}
 
-	if _, err = code.ReadFrom(newCode); err != nil {
+	newCode, err := insertStubs(code.Bytes(), order, missing, handlerName, stub)
+	if err != nil {
 		return nil, err
 	}
-
-	return code, nil
+	return bytes.NewReader(newCode), nil
 }
 
 func (h *handler) buffer() (*bytes.Buffer, error) {
@@ -148,7 +165,85 @@ func (h *handler) buffer() (*bytes.Buffer, error) {
 	return code, nil
 }
 
-// pruneDecls constructs a new []ast.Decls with the exported funcs in decls
+// insertStubs returns code with the stubs of the missing funcs inserted next
+// to their neighbours, order being the names of all funcs in the order they
+// belong in. Each stub, rendered by stub, follows the func of the closest
+// name preceding it, or else precedes that of the closest name following it,
+// or else ends code; stubs inserted at the same place keep the order. The
+// funcs of code are named by name, which returns "" for the others.
+func insertStubs(code []byte, order []string, missing map[string]bool, name func(*ast.FuncDecl) string, stub func(string) (io.Reader, error)) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse code for inserting stubs")
+	}
+	start := make(map[string]int)
+	end := make(map[string]int)
+	for _, d := range f.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if n := name(fn); n != "" {
+			start[n] = fset.Position(funcStart(fn)).Offset
+			end[n] = fset.Position(fn.End()).Offset
+		}
+	}
+
+	type insertion struct {
+		at   int
+		code []byte
+	}
+	var inserts []insertion
+	prev := -1
+	for i, n := range order {
+		if !missing[n] {
+			if e, ok := end[n]; ok {
+				prev = e
+			}
+			continue
+		}
+		r, err := stub(n)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot render stub of %q", n)
+		}
+		s, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		s = bytes.TrimSpace(s)
+
+		if prev >= 0 {
+			inserts = append(inserts, insertion{prev, append([]byte("\n\n"), s...)})
+			continue
+		}
+		at := -1
+		for _, next := range order[i+1:] {
+			if st, ok := start[next]; ok {
+				at = st
+				break
+			}
+		}
+		if at < 0 {
+			inserts = append(inserts, insertion{len(code), append(append([]byte("\n"), s...), '\n')})
+			continue
+		}
+		inserts = append(inserts, insertion{at, append(s, "\n\n"...)})
+	}
+	sort.SliceStable(inserts, func(i, j int) bool { return inserts[i].at < inserts[j].at })
+
+	var rv bytes.Buffer
+	last := 0
+	for _, ins := range inserts {
+		rv.Write(code[last:ins.at])
+		rv.Write(ins.code)
+		last = ins.at
+	}
+	rv.Write(code[last:])
+	return rv.Bytes(), nil
+}
+
+// prune constructs a new []ast.Decls with the exported funcs in decls
 // who's names are not keys in methodMap and/or does not have the function
 // receiver svcName + "Service" ("Handler func")  removed.
 //
@@ -156,34 +251,137 @@ func (h *handler) buffer() (*bytes.Buffer, error) {
 // deleted from methodMap, resulting in a methodMap only containing keys and
 // values for functions defined in the service but not in the handler ast.
 //
-// In addition pruneDecls will update unremoved "Handler func"s input
+// In addition prune will update unremoved "Handler func"s input
 // paramaters and output results to by the types described in methodMap's
 // serviceMethod for that "Handler func".
-func (m methodMap) pruneDecls(decls []ast.Decl, svcName string) []ast.Decl {
+//
+// A removed "Handler func" which is the only one with the request and
+// response types of a method left in methodMap, while that method is the
+// only one with its types, is taken to be that method renamed. It is renamed
+// in place and kept, and its old name is mapped to the new one in the
+// returned map. The other removed funcs are returned as orphans, in the order
+// of decls, so that they are not lost.
+func (m methodMap) prune(decls []ast.Decl, svcName string) ([]ast.Decl, []*ast.FuncDecl, map[string]string) {
+	invalid := make(map[*ast.FuncDecl]bool)
+	for _, d := range decls {
+		x, ok := d.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		name := x.Name.Name
+		// Special case NewService and ignore unexported
+		if name == ignoredFunc || !ast.IsExported(name) {
+			log.WithField("Func", name).
+				Debug("Ignoring")
+			continue
+		}
+		if ok := isValidFunc(x, m, svcName); ok == true {
+			updateParams(x, m[name])
+			updateResults(x, m[name])
+			delete(m, name)
+			continue
+		}
+		invalid[x] = true
+	}
+
+	renames := m.renames(decls, invalid, svcName)
+
 	var newDecls []ast.Decl
+	var orphans []*ast.FuncDecl
+	renamed := make(map[string]string)
+	for _, d := range decls {
+		x, ok := d.(*ast.FuncDecl)
+		if !ok || !invalid[x] {
+			newDecls = append(newDecls, d)
+			continue
+		}
+		if meth := renames[x]; meth != nil {
+			log.WithField("Func", x.Name.Name).WithField("Method", meth.Name).
+				Warn("Func has the types of a new rpc; renaming it to the rpc")
+			renamed[x.Name.Name] = meth.Name
+			x.Name.Name = meth.Name
+			delete(m, meth.Name)
+			newDecls = append(newDecls, x)
+			continue
+		}
+		log.WithField("Func", x.Name.Name).
+			Warn("Func removed from handlers.go; moving it to orphaned.go")
+		orphans = append(orphans, x)
+	}
+	return newDecls, orphans, renamed
+}
+
+// renames pairs the invalid "Handler func"s of decls with the methods of m
+// having the same request and response types, where each side of the pair
+// has only one match.
+func (m methodMap) renames(decls []ast.Decl, invalid map[*ast.FuncDecl]bool, svcName string) map[*ast.FuncDecl]*svcdef.ServiceMethod {
+	funcMatches := make(map[*ast.FuncDecl][]*svcdef.ServiceMethod)
+	methMatches := make(map[string]int)
 	for _, d := range decls {
-		switch x := d.(type) {
-		case *ast.FuncDecl:
-			name := x.Name.Name
-			// Special case NewService and ignore unexported
-			if name == ignoredFunc || !ast.IsExported(name) {
-				log.WithField("Func", name).
-					Debug("Ignoring")
-				newDecls = append(newDecls, x)
-				continue
+		x, ok := d.(*ast.FuncDecl)
+		if !ok || !invalid[x] || !isServiceRecv(x.Recv, svcName) {
+			continue
+		}
+		if x.Type.Params.NumFields() != 2 || x.Type.Results.NumFields() != 2 {
+			continue
+		}
+		req := pbTypeName(x.Type.Params.List[1].Type)
+		resp := pbTypeName(x.Type.Results.List[0].Type)
+		for _, meth := range m {
+			if meth.RequestType.Name == req && meth.ResponseType.Name == resp {
+				funcMatches[x] = append(funcMatches[x], meth)
+				methMatches[meth.Name]++
 			}
-			if ok := isValidFunc(x, m, svcName); ok == true {
-				updateParams(x, m[name])
-				updateResults(x, m[name])
-				newDecls = append(newDecls, x)
-				delete(m, name)
+		}
+	}
+
+	renamed := make(map[*ast.FuncDecl]*svcdef.ServiceMethod)
+	for f, meths := range funcMatches {
+		if len(meths) == 1 && methMatches[meths[0].Name] == 1 {
+			renamed[f] = meths[0]
+		}
+	}
+	return renamed
+}
+
+// pbTypeName returns the name of the type t in the form X.Sel/*X.Sel, or ""
+// if t is not in that form.
+func pbTypeName(t ast.Expr) string {
+	if ptr, _ := t.(*ast.StarExpr); ptr != nil {
+		t = ptr.X
+	}
+	if sel, _ := t.(*ast.SelectorExpr); sel != nil {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+// removeComments returns comments without the comment groups within funcs,
+// including their doc comments.
+func removeComments(comments []*ast.CommentGroup, funcs []*ast.FuncDecl) []*ast.CommentGroup {
+	var rv []*ast.CommentGroup
+	for _, c := range comments {
+		within := false
+		for _, f := range funcs {
+			if c.Pos() >= funcStart(f) && c.End() <= f.End() {
+				within = true
+				break
 			}
-		default:
-			newDecls = append(newDecls, d)
 		}
+		if !within {
+			rv = append(rv, c)
+		}
+	}
+	return rv
+}
 
+// funcStart returns the position of the start of f, including its doc
+// comment.
+func funcStart(f *ast.FuncDecl) token.Pos {
+	if f.Doc != nil {
+		return f.Doc.Pos()
 	}
-	return newDecls
+	return f.Pos()
 }
 
 // updateParams updates the second param of f to be `X`.{m.RequestType.Name}.
@@ -241,8 +439,9 @@ func updatePBFieldType(t ast.Expr, newType string) {
 // following:
 //
 //     1. The function is private
-//     2. The function is a method of our server struct (e.g. fooStruct) AND
-//        it's also a method defined in the generated .pb.go server interface.
+//     2. The function is a method of our server struct (e.g. fooStruct), by
+//        value or by pointer, AND it's also a method defined in the
+//        generated .pb.go server interface.
 //
 // These criteria are pretty strict, making many things invalid and thus will
 // be removed. Some of the things which are invalid include:
@@ -267,9 +466,8 @@ func isValidFunc(f *ast.FuncDecl, m methodMap, svcName string) bool {
 		return false
 	}
 
-	rName := recvTypeToString(f.Recv)
-	if rName != svcName+"Service" {
-		log.WithField("Func", name).WithField("Receiver", rName).
+	if !isServiceRecv(f.Recv, svcName) {
+		log.WithField("Func", name).WithField("Receiver", recvTypeToString(f.Recv)).
 			Info("Func is exported with improper receiver; removing")
 		return false
 	}
@@ -280,6 +478,12 @@ func isValidFunc(f *ast.FuncDecl, m methodMap, svcName string) bool {
 	return true
 }
 
+// isServiceRecv returns whether recv is the server struct of the service
+// svcName, by value or by pointer.
+func isServiceRecv(recv *ast.FieldList, svcName string) bool {
+	return strings.TrimPrefix(recvTypeToString(recv), "*") == svcName+"Service"
+}
+
 // recvTypeToString accepts an *ast.FuncDecl.Recv recv, and returns the
 // string of the recv type.
 //	func (s Foo) Test() {} -> "Foo"
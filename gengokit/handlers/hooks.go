@@ -36,9 +36,15 @@ type HookRender struct {
 //        "{{.ImportPath}}/svc/server" if it doesn't already.
 //     2. Add the InterruptHandler if it doesn't exist already
 //     3. Add the SetConfig function if it doesn't exist already
+//     4. Add the NewDependencies function if it doesn't exist already and
+//        NewService takes the Dependencies of the handlers
 func (h *HookRender) Render(_ string, data *gengokit.Data) (io.Reader, error) {
 	if h.prev == nil {
-		return data.ApplyTemplate(templates.Hook+templates.HookInterruptHandler+templates.HookSetConfig, "HooksFullTemplate")
+		hooks := templates.Hook + templates.HookInterruptHandler + templates.HookSetConfig
+		if data.Handlers.Dependencies {
+			hooks += templates.HookNewDependencies
+		}
+		return data.ApplyTemplate(hooks, "HooksFullTemplate")
 	}
 	rawprev, err := ioutil.ReadAll(h.prev)
 	if err != nil {
@@ -70,15 +76,20 @@ func (h *HookRender) Render(_ string, data *gengokit.Data) (io.Reader, error) {
 		return nil, err
 	}
 
-	// Both of these functions need to be in hooks.go in order for the service to start.
-	hookFuncs := map[string]string{
-		"InterruptHandler": templates.HookInterruptHandler,
-		"SetConfig":        templates.HookSetConfig,
+	// Both of these functions need to be in hooks.go in order for the service
+	// to start. They are added in this order, so that the output is the same
+	// on every run.
+	hookFuncs := []struct{ name, code string }{
+		{"InterruptHandler", templates.HookInterruptHandler},
+		{"SetConfig", templates.HookSetConfig},
+	}
+	if data.Handlers.Dependencies {
+		hookFuncs = append(hookFuncs, struct{ name, code string }{"NewDependencies", templates.HookNewDependencies})
 	}
 
-	for name, f := range hookFuncs {
-		if _, ok := existingFuncs[name]; !ok {
-			code.ReadFrom(strings.NewReader(f))
+	for _, f := range hookFuncs {
+		if _, ok := existingFuncs[f.name]; !ok {
+			code.ReadFrom(strings.NewReader(f.code))
 		}
 	}
 	return code, nil
@@ -75,6 +75,41 @@ func TestHooksAddingImport(t *testing.T) {
 
 }
 
+func TestHooksNewDependencies(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import (
+			"github.com/metaverse/truss/gengokit/svc"
+		)
+
+		func SetConfig(cfg svc.Config) svc.Config {
+			return cfg
+		}
+	`
+
+	_, data, err := generalService()
+	require.NoError(t, err)
+
+	code, err := renderHooksFile("", data)
+	require.NoError(t, err)
+	require.Contains(t, code, "func NewDependencies(cfg svc.Config) (Dependencies, error) {")
+
+	code, err = renderHooksFile(prev, data)
+	require.NoError(t, err)
+	require.Contains(t, code, "func NewDependencies(cfg svc.Config) (Dependencies, error) {")
+
+	// Services whose NewService takes nothing have no Dependencies
+	data.Handlers = gengokit.HandlersStyle{}
+	code, err = renderHooksFile("", data)
+	require.NoError(t, err)
+	require.NotContains(t, code, "NewDependencies")
+
+	code, err = renderHooksFile(prev, data)
+	require.NoError(t, err)
+	require.NotContains(t, code, "NewDependencies")
+}
+
 // renderHooksFile takes in a previous file as a string and returns the
 // generated handlers/hooks.go file as a string. This helper method exists
 // because the logic for reading the io.Reader to a string is repeated.
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/metaverse/truss/svcdef"
+)
+
+// OrphanedPath is the relative path to the file holding the code removed from
+// handlers.go.
+const OrphanedPath = "handlers/orphaned.go"
+
+// OrphanedTestsPath is the relative path to the file holding the tests of the
+// handlers removed from handlers.go.
+const OrphanedTestsPath = "handlers/orphaned_test.go"
+
+const orphanedHeader = `package handlers
+
+// This file holds the funcs which truss removed from handlers.go, because
+// they were exported but not the handler of an rpc of the service. They are
+// commented out so that the package builds whatever types they refer to. Move
+// back what you need and delete the rest; truss only ever adds to this file.
+`
+
+const orphanedTestsHeader = `package handlers
+
+// This file holds the tests of the handlers which truss moved to orphaned.go,
+// commented out for the same reason. Truss only ever adds to this file.
+`
+
+// Pruned is what regeneration removes from the previous handlers.go, and
+// renames in it.
+type Pruned struct {
+	// Renamed maps the old names of the handlers renamed after their rpc to
+	// their new names.
+	Renamed map[string]string
+	// Missing are the methods of the service without a handler in
+	// handlers.go, even once renamed, in the order of the definition.
+	Missing []*svcdef.ServiceMethod
+
+	fset    *token.FileSet
+	file    *ast.File
+	orphans []*ast.FuncDecl
+}
+
+// Prune returns what regenerating handlers.go for svc removes from and
+// renames in prevHandlers, the previous version of the file, if any.
+func Prune(svc *svcdef.Service, prevHandlers io.Reader) (*Pruned, error) {
+	p := Pruned{Renamed: map[string]string{}}
+	if prevHandlers == nil {
+		p.Missing = svc.Methods
+		return &p, nil
+	}
+
+	p.fset = token.NewFileSet()
+	var err error
+	if p.file, err = parser.ParseFile(p.fset, "", prevHandlers, parser.ParseComments); err != nil {
+		return nil, errors.Wrap(err, "cannot parse previous handlers")
+	}
+	m := newMethodMap(svc.Methods)
+	_, p.orphans, p.Renamed = m.prune(p.file.Decls, strings.ToLower(svc.Name))
+	for _, meth := range svc.Methods {
+		if m[meth.Name] != nil {
+			p.Missing = append(p.Missing, meth)
+		}
+	}
+	return &p, nil
+}
+
+// Orphaned returns the contents of 'handlers/orphaned.go' after the funcs
+// removed from handlers.go are added to prevOrphaned, the previous version of
+// the file. If no funcs are removed, Orphaned returns nil, leaving the file as
+// it was.
+func (p *Pruned) Orphaned(prevOrphaned io.Reader) (io.Reader, error) {
+	if len(p.orphans) == 0 {
+		return nil, nil
+	}
+	return appendCommented(prevOrphaned, orphanedHeader, "handlers.go", p.fset, p.file, p.orphans)
+}
+
+// Tests returns the contents of the handler tests, prevTests, with the tests
+// of renamed handlers renamed along with them, and the contents of
+// 'handlers/orphaned_test.go' after the tests of the handlers removed from
+// handlers.go are moved to prevOrphanedTests. Each is nil if it is unchanged.
+// Only tests named after the handler, such as the generated ones, are
+// followed.
+func (p *Pruned) Tests(prevTests, prevOrphanedTests io.Reader) (tests, orphanedTests io.Reader, err error) {
+	if prevTests == nil || (len(p.Renamed) == 0 && len(p.orphans) == 0) {
+		return nil, nil, nil
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", prevTests, parser.ParseComments)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot parse previous handler tests")
+	}
+
+	existing := make(map[string]bool)
+	for _, d := range f.Decls {
+		if fn, ok := d.(*ast.FuncDecl); ok && fn.Recv == nil {
+			existing[fn.Name.Name] = true
+		}
+	}
+	removed := make(map[string]bool)
+	for _, o := range p.orphans {
+		if o.Recv != nil {
+			removed["Test"+o.Name.Name] = true
+		}
+	}
+
+	var decls []ast.Decl
+	var orphans []*ast.FuncDecl
+	for _, d := range f.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			decls = append(decls, d)
+			continue
+		}
+		if removed[fn.Name.Name] {
+			log.WithField("Func", fn.Name.Name).
+				Warn("Test of removed handler; moving it to orphaned_test.go")
+			orphans = append(orphans, fn)
+			continue
+		}
+		for old, name := range p.Renamed {
+			if fn.Name.Name == "Test"+old && !existing["Test"+name] {
+				log.WithField("Func", fn.Name.Name).WithField("Method", name).
+					Warn("Test of renamed handler; renaming it")
+				renameTest(fn, old, name)
+			}
+		}
+		decls = append(decls, d)
+	}
+	f.Decls = decls
+
+	if len(orphans) > 0 {
+		orphanedTests, err = appendCommented(prevOrphanedTests, orphanedTestsHeader, "handlers_test.go", fset, f, orphans)
+		if err != nil {
+			return nil, nil, err
+		}
+		f.Comments = removeComments(f.Comments, orphans)
+	}
+
+	code := bytes.NewBuffer(nil)
+	if err := printer.Fprint(code, fset, f); err != nil {
+		return nil, nil, err
+	}
+	return code, orphanedTests, nil
+}
+
+// renameTest renames the test fn of the handler old to that of the handler
+// name, along with the calls of old within it.
+func renameTest(fn *ast.FuncDecl, old, name string) {
+	fn.Name.Name = "Test" + name
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == old {
+			sel.Sel.Name = name
+		}
+		return true
+	})
+}
+
+// appendCommented returns prev, or header if prev is empty, followed by each
+// of funcs of the file f commented out, noting they were removed from the
+// file named from.
+func appendCommented(prev io.Reader, header, from string, fset *token.FileSet, f *ast.File, funcs []*ast.FuncDecl) (io.Reader, error) {
+	code := bytes.NewBuffer(nil)
+	if prev != nil {
+		if _, err := code.ReadFrom(prev); err != nil {
+			return nil, errors.Wrap(err, "cannot read previous orphaned funcs")
+		}
+	}
+	if code.Len() == 0 {
+		code.WriteString(header)
+	}
+
+	for _, fn := range funcs {
+		src, err := funcSource(fset, f, fn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot print func %q", fn.Name.Name)
+		}
+		code.WriteString("\n// Removed from " + from + ":\n//\n")
+		s := bufio.NewScanner(bytes.NewReader(src))
+		for s.Scan() {
+			line := s.Text()
+			if line == "" {
+				code.WriteString("//\n")
+				continue
+			}
+			code.WriteString("// " + line + "\n")
+		}
+	}
+	return code, nil
+}
+
+// funcSource returns the source of the func fn of the file f, with its
+// comments.
+func funcSource(fset *token.FileSet, f *ast.File, fn *ast.FuncDecl) ([]byte, error) {
+	var comments []*ast.CommentGroup
+	for _, c := range f.Comments {
+		if c.Pos() >= funcStart(fn) && c.End() <= fn.End() {
+			comments = append(comments, c)
+		}
+	}
+
+	// The config of gofmt, as the commented code is not formatted later
+	conf := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	var buf bytes.Buffer
+	err := conf.Fprint(&buf, fset, &printer.CommentedNode{Node: fn, Comments: comments})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/metaverse/truss/gengokit"
+	"github.com/metaverse/truss/svcdef"
+)
+
+const orphanedDef = `
+	syntax = "proto3";
+	package echo;
+
+	service Echo {
+	  rpc Echo (EchoRequest) returns (EchoResponse) {}
+	  rpc Louder (LouderRequest) returns (EchoResponse) {}
+	}
+	message EchoRequest {
+	  string In = 1;
+	}
+	message LouderRequest {
+	  string In = 1;
+	  int32 Loudness = 2;
+	}
+	message EchoResponse {
+	  string Out = 1;
+	}
+`
+
+const orphanedPrev = `
+	package handlers
+
+	import (
+		"context"
+		"strings"
+
+		pb "github.com/metaverse/truss/gengokit/echo-service"
+	)
+
+	func NewService() pb.EchoServer {
+		return echoService{}
+	}
+
+	type echoService struct{}
+
+	func (s echoService) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+		return &pb.EchoResponse{Out: in.In}, nil
+	}
+
+	// Shout was renamed to Louder in the definition.
+	func (s echoService) Shout(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {
+		return &pb.EchoResponse{Out: strings.Repeat(in.In, int(in.Loudness))}, nil
+	}
+
+	// Whisper is no longer an rpc.
+	func (s echoService) Whisper(ctx context.Context, in *pb.WhisperRequest) (*pb.EchoResponse, error) {
+		// whispering is quiet
+
+		return &pb.EchoResponse{Out: in.In}, nil
+	}
+
+	func Helper() string {
+		return "exported, but not an rpc"
+	}
+`
+
+func TestRenameDetection(t *testing.T) {
+	sd, data := orphanedData(t)
+
+	code, err := renderService(sd.Service, orphanedPrev, data)
+	require.NoError(t, err)
+
+	require.Contains(t, code, "// Shout was renamed to Louder in the definition.\nfunc (s echoService) Louder(ctx context.Context, in *pb.LouderRequest) (*pb.EchoResponse, error) {\n\treturn &pb.EchoResponse{Out: strings.Repeat(in.In, int(in.Loudness))}, nil\n}")
+	require.NotContains(t, code, "Shout(")
+	require.NotContains(t, code, "var resp pb.EchoResponse", "renamed method should not get a new stub")
+	require.NotContains(t, code, "Whisper")
+	require.NotContains(t, code, "whispering is quiet")
+	require.NotContains(t, code, "Helper")
+}
+
+func TestRenameDetectionAmbiguous(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+		package echo;
+
+		service Echo {
+		  rpc Echo (EchoRequest) returns (EchoResponse) {}
+		  rpc Louder (LouderRequest) returns (EchoResponse) {}
+		  rpc Loudest (LouderRequest) returns (EchoResponse) {}
+		}
+		message EchoRequest {
+		  string In = 1;
+		}
+		message LouderRequest {
+		  string In = 1;
+		  int32 Loudness = 2;
+		}
+		message EchoResponse {
+		  string Out = 1;
+		}
+	`
+	sd, err := svcdef.NewFromString(def, gopath)
+	require.NoError(t, err)
+
+	orphaned, err := renderOrphans(sd.Service, orphanedPrev, "")
+	require.NoError(t, err)
+	require.Contains(t, orphaned, "// func (s echoService) Shout(")
+}
+
+func TestOrphans(t *testing.T) {
+	sd, _ := orphanedData(t)
+
+	orphaned, err := renderOrphans(sd.Service, orphanedPrev, "")
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(orphaned, orphanedHeader))
+	require.NotContains(t, orphaned, "Shout")
+	require.Contains(t, orphaned, `
+// Removed from handlers.go:
+//
+// // Whisper is no longer an rpc.
+// func (s echoService) Whisper(ctx context.Context, in *pb.WhisperRequest) (*pb.EchoResponse, error) {
+// 	// whispering is quiet
+//
+// 	return &pb.EchoResponse{Out: in.In}, nil
+// }
+`)
+	require.Contains(t, orphaned, "// func Helper() string {")
+	require.Less(t, strings.Index(orphaned, "Whisper"), strings.Index(orphaned, "Helper"))
+
+	// The file still parses
+	_, err = testFormat(orphaned)
+	require.NoError(t, err)
+
+	// Later orphans are added after the earlier ones
+	const earlier = "package handlers\n\n// func Earlier() {}\n"
+	orphaned, err = renderOrphans(sd.Service, orphanedPrev, earlier)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(orphaned, earlier))
+	require.Contains(t, orphaned, "// func Helper() string {")
+
+	// Nothing is written when nothing is removed
+	p, err := Prune(sd.Service, strings.NewReader("package handlers\n"))
+	require.NoError(t, err)
+	r, err := p.Orphaned(nil)
+	require.NoError(t, err)
+	require.Nil(t, r)
+}
+
+func TestPrunedTests(t *testing.T) {
+	sd, _ := orphanedData(t)
+	const prevTests = `package handlers
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/metaverse/truss/gengokit/echo-service"
+)
+
+func TestShout(t *testing.T) {
+	got, _ := NewService().Shout(context.Background(), &pb.LouderRequest{})
+	t.Log(got)
+}
+
+// TestWhisper checks whispering.
+func TestWhisper(t *testing.T) {
+	// quietly
+	NewService().Whisper(context.Background(), &pb.WhisperRequest{})
+}
+
+func TestOwn(t *testing.T) {}
+`
+	p, err := Prune(sd.Service, strings.NewReader(orphanedPrev))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Shout": "Louder"}, p.Renamed)
+
+	tr, otr, err := p.Tests(strings.NewReader(prevTests), nil)
+	require.NoError(t, err)
+	require.NotNil(t, tr)
+	require.NotNil(t, otr)
+	tests, err := ioutil.ReadAll(tr)
+	require.NoError(t, err)
+	orphanedTests, err := ioutil.ReadAll(otr)
+	require.NoError(t, err)
+
+	require.Contains(t, string(tests), "func TestLouder(t *testing.T) {\n\tgot, _ := NewService().Louder(context.Background(), &pb.LouderRequest{})")
+	require.NotContains(t, string(tests), "Shout")
+	require.NotContains(t, string(tests), "hisper")
+	require.NotContains(t, string(tests), "quietly")
+	require.Contains(t, string(tests), "func TestOwn(t *testing.T)")
+
+	require.True(t, strings.HasPrefix(string(orphanedTests), orphanedTestsHeader))
+	require.Contains(t, string(orphanedTests), `
+// Removed from handlers_test.go:
+//
+// // TestWhisper checks whispering.
+// func TestWhisper(t *testing.T) {
+// 	// quietly
+`)
+
+	// Nothing changes without a previous handlers.go
+	p, err = Prune(sd.Service, nil)
+	require.NoError(t, err)
+	tr, otr, err = p.Tests(strings.NewReader(prevTests), nil)
+	require.NoError(t, err)
+	require.Nil(t, tr)
+	require.Nil(t, otr)
+}
+
+func orphanedData(t *testing.T) (*svcdef.Svcdef, *gengokit.Data) {
+	sd, err := svcdef.NewFromString(orphanedDef, gopath)
+	require.NoError(t, err)
+	data, err := gengokit.NewData(sd, gengokit.Config{
+		GoPackage: "github.com/metaverse/truss/gengokit",
+		PBPackage: "github.com/metaverse/truss/gengokit/echo-service",
+	})
+	require.NoError(t, err)
+	return sd, data
+}
+
+// renderOrphans returns the handlers/orphaned.go generated from the previous
+// handlers.go prevHandlers and orphaned.go prevOrphaned.
+func renderOrphans(svc *svcdef.Service, prevHandlers, prevOrphaned string) (string, error) {
+	var prev io.Reader
+	if prevOrphaned != "" {
+		prev = strings.NewReader(prevOrphaned)
+	}
+	p, err := Prune(svc, strings.NewReader(prevHandlers))
+	if err != nil {
+		return "", err
+	}
+	r, err := p.Orphaned(prev)
+	if err != nil || r == nil {
+		return "", err
+	}
+	code, err := ioutil.ReadAll(r)
+	return string(code), err
+}
@@ -3,7 +3,7 @@ package templates
 const HandlerMethods = `
 {{ with $te := .}}
 		{{range $i := .Methods}}
-		func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .RequestType.Name}}) (*pb.{{GoName .ResponseType.Name}}, error){
+		func (s {{if $te.Pointer}}*{{end}}{{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .RequestType.Name}}) (*pb.{{GoName .ResponseType.Name}}, error){
 			var resp pb.{{GoName .ResponseType.Name}}
 			return &resp, nil
 		}
@@ -15,24 +15,51 @@ const Handlers = `
 package handlers
 
 import (
+	{{- if .Service.Methods}}
 	"context"
-
+	{{end}}
 	pb "{{.PBImportPath -}}"
 )
 
+{{if .Handlers.Dependencies -}}
+// Dependencies holds what the handlers depend on, such as database pools and
+// clients. Add fields for them, and create them in NewDependencies in
+// hooks.go.
+type Dependencies struct{}
+
+// NewService returns an implementation of Service using deps.
+func NewService(deps Dependencies) pb.{{GoName .Service.Name}}Server {
+	return &{{ToLower .Service.Name}}Service{deps: deps}
+}
+
+type {{ToLower .Service.Name}}Service struct {
+	deps Dependencies
+}
+{{- else -}}
 // NewService returns a naïve, stateless implementation of Service.
 func NewService() pb.{{GoName .Service.Name}}Server {
 	return {{ToLower .Service.Name}}Service{}
 }
 
 type {{ToLower .Service.Name}}Service struct{}
+{{- end}}
 
 {{with $te := . }}
 	{{range $i := $te.Service.Methods}}
-		func (s {{ToLower $te.Service.Name}}Service) {{$i.Name}}(ctx context.Context, in *pb.{{GoName $i.RequestType.Name}}) (*pb.{{GoName $i.ResponseType.Name}}, error){
+		func (s {{if $te.Handlers.PointerReceivers}}*{{end}}{{ToLower $te.Service.Name}}Service) {{$i.Name}}(ctx context.Context, in *pb.{{GoName $i.RequestType.Name}}) (*pb.{{GoName $i.ResponseType.Name}}, error){
 			var resp pb.{{GoName $i.ResponseType.Name}}
 			return &resp, nil
 		}
 	{{end}}
 {{- end}}
 `
+
+const HandlerFile = `
+package handlers
+
+import (
+	"context"
+
+	pb "{{.PBImportPath -}}"
+)
+` + HandlerMethods
@@ -26,6 +26,21 @@ func InterruptHandler(errc chan<- error) {
 `
 const HookSetConfig = `
 func SetConfig(cfg svc.Config) svc.Config {
+	// Set cfg.Recover to handle the panics of the handlers otherwise than
+	// by logging them
+
+	// Set cfg.Limits to limit the rate or the concurrency of the calls of
+	// some methods, e.g.
+	// cfg.Limits = map[string]svc.Limit{"Search": {Rate: 10, Burst: 20, MaxInFlight: 4}}
+
 	return cfg
 }
 `
+const HookNewDependencies = `
+func NewDependencies(cfg svc.Config) (Dependencies, error) {
+	// Create the database pools, clients and whatever else the handlers
+	// depend on here
+
+	return Dependencies{}, nil
+}
+`
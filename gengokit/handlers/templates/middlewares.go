@@ -21,6 +21,14 @@ func WrapEndpoints(in svc.Endpoints) svc.Endpoints {
 	// e.g.
 	// in.WrapAllExcept(authMiddleware, "Status", "Ping")
 
+	// Pass a middleware you want applied to the endpoints whose rpc declares
+	// a custom option with a given value in the proto file, or to those
+	// which do not. Options are named by the fully-qualified name of their
+	// extension.
+	// e.g.
+	// in.WrapByOption("auth.role", "admin", adminMiddleware)
+	// in.WrapByOptionExcept("auth.public", "true", authMiddleware)
+
 	// Pass in a svc.LabeledMiddleware you want applied to every endpoint.
 	// These middlewares get passed the endpoints name as their first argument when applied.
 	// This can be used to write generic metric gathering middlewares that can
@@ -34,6 +42,12 @@ func WrapEndpoints(in svc.Endpoints) svc.Endpoints {
 	return in
 }
 
+// WrapService accepts the service, so that middlewares decorating it may be
+// wrapped around it. Such middlewares embed middleware.Base from package
+// svc/middleware and override the methods they handle, or set its Before and
+// After hooks to handle every method.
+// e.g.
+// in = middleware.Base{Next: in, Before: logRequest}
 func WrapService(in pb.{{.Service.Name}}Server) pb.{{.Service.Name}}Server {
 	return in
 }
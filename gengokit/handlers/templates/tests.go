@@ -0,0 +1,42 @@
+package templates
+
+const HandlerTestMethods = `
+{{range $i := .Methods}}
+func Test{{$i.Name}}(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *pb.{{GoName $i.RequestType.Name}}
+		want    *pb.{{GoName $i.ResponseType.Name}}
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := {{$.NewService}}.{{$i.Name}}(context.Background(), tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("{{$i.Name}}() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("{{$i.Name}}() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+{{end}}
+`
+
+const HandlerTests = `
+package handlers
+
+{{if .Methods -}}
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	pb "{{.PBImportPath -}}"
+)
+{{- end}}
+` + HandlerTestMethods
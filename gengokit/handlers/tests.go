@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/metaverse/truss/gengokit"
+	"github.com/metaverse/truss/gengokit/handlers/templates"
+	"github.com/metaverse/truss/svcdef"
+)
+
+// TestsPath is the relative path to the handler tests template file
+const TestsPath = "handlers/handlers_test.gotemplate"
+
+// testsData is the data for rendering handler test stubs
+type testsData struct {
+	PBImportPath string
+	// NewService is the expression creating the service of the handlers
+	NewService string
+	Methods    []*svcdef.ServiceMethod
+}
+
+// NewTests returns a Renderable for 'handlers/handlers_test.go', which holds
+// a test stub for every method of the service. It should be passed the
+// previous version of the file to parse, if any.
+func NewTests(svc *svcdef.Service, prev io.Reader) (gengokit.Renderable, error) {
+	t := Tests{service: svc}
+	if prev == nil {
+		return &t, nil
+	}
+
+	t.fset = token.NewFileSet()
+	var err error
+	if t.ast, err = parser.ParseFile(t.fset, "", prev, parser.ParseComments); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Tests renders the handler tests. Tests already in the previous version of
+// the file are kept as they are; only the stubs of methods without a test are
+// added.
+type Tests struct {
+	fset    *token.FileSet
+	service *svcdef.Service
+	// The AST of the previous 'handlers/handlers_test.go', or nil if there
+	// is none.
+	ast *ast.File
+}
+
+// Render returns an io.Reader with the go code of the handler tests.
+func (t *Tests) Render(path string, data *gengokit.Data) (io.Reader, error) {
+	if path != TestsPath {
+		return nil, errors.Errorf("cannot render unknown file: %q", path)
+	}
+
+	td := testsData{
+		PBImportPath: data.PBImportPath,
+		NewService:   newServiceExpr("", data.Handlers),
+	}
+	if t.ast == nil {
+		td.Methods = t.service.Methods
+		return gengokit.ApplyTemplate(templates.HandlerTests, "HandlerTests", td, gengokit.FuncMap)
+	}
+
+	existing := make(map[string]bool)
+	for _, d := range t.ast.Decls {
+		if f, ok := d.(*ast.FuncDecl); ok && f.Recv == nil {
+			existing[f.Name.Name] = true
+		}
+	}
+	for _, m := range t.service.Methods {
+		if existing["Test"+m.Name] {
+			continue
+		}
+		log.WithField("Method", m.Name).Info("Generating handler test from rpc definition")
+		td.Methods = append(td.Methods, m)
+	}
+
+	if len(td.Methods) > 0 {
+		// The stubs need these, though the existing tests may not
+		imports := []struct{ name, path string }{
+			{"", "context"},
+			{"", "testing"},
+			{"", "github.com/gogo/protobuf/proto"},
+			{"pb", data.PBImportPath},
+		}
+		// Tests in the external test package reach NewService through an
+		// import of the handlers
+		if strings.HasSuffix(t.ast.Name.Name, "_test") {
+			td.NewService = newServiceExpr("handlers.", data.Handlers)
+			imports = append(imports, struct{ name, path string }{"", data.ImportPath + "/handlers"})
+		}
+		for _, imp := range imports {
+			if !hasImport(t.ast, imp.path) {
+				astutil.AddNamedImport(t.fset, t.ast, imp.name, imp.path)
+			}
+		}
+	}
+
+	code := bytes.NewBuffer(nil)
+	if err := printer.Fprint(code, t.fset, t.ast); err != nil {
+		return nil, err
+	}
+	if len(td.Methods) == 0 {
+		return code, nil
+	}
+
+	// The stubs go next to the tests of their neighbours in the service
+	// definition
+	var order []string
+	for _, m := range t.service.Methods {
+		order = append(order, m.Name)
+	}
+	missing := make(map[string]bool)
+	for _, m := range td.Methods {
+		missing[m.Name] = true
+	}
+	meths := newMethodMap(t.service.Methods)
+	testName := func(f *ast.FuncDecl) string {
+		if f.Recv != nil || !strings.HasPrefix(f.Name.Name, "Test") {
+			return ""
+		}
+		return strings.TrimPrefix(f.Name.Name, "Test")
+	}
+	stub := func(name string) (io.Reader, error) {
+		std := td
+		std.Methods = []*svcdef.ServiceMethod{meths[name]}
+		return gengokit.ApplyTemplate(templates.HandlerTestMethods, "HandlerTestMethods", std, gengokit.FuncMap)
+	}
+	newCode, err := insertStubs(code.Bytes(), order, missing, testName, stub)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(newCode), nil
+}
+
+// hasImport returns whether f imports path, under any name.
+func hasImport(f *ast.File, path string) bool {
+	for _, imp := range f.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err == nil && p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// newServiceExpr returns the expression creating the service of the handlers
+// in the style, with empty Dependencies, from a package reaching the
+// handlers package through the qualifier pkg.
+func newServiceExpr(pkg string, style gengokit.HandlersStyle) string {
+	if style.Dependencies {
+		return pkg + ignoredFunc + "(" + pkg + "Dependencies{})"
+	}
+	return pkg + ignoredFunc + "()"
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metaverse/truss/gengokit"
+	"github.com/metaverse/truss/svcdef"
+)
+
+const testsDef = `
+	syntax = "proto3";
+	package echo;
+
+	service Echo {
+	  rpc Echo (EchoRequest) returns (EchoResponse) {}
+	  rpc Louder (LouderRequest) returns (EchoResponse) {}
+	}
+	message EchoRequest {
+	  string In = 1;
+	}
+	message LouderRequest {
+	  string In = 1;
+	  int32 Loudness = 2;
+	}
+	message EchoResponse {
+	  string Out = 1;
+	}
+`
+
+func TestTestsFirstRender(t *testing.T) {
+	code, err := renderTestsFile(testsDef, "")
+	require.NoError(t, err)
+
+	require.Contains(t, code, "func TestEcho(t *testing.T) {")
+	require.Contains(t, code, "func TestLouder(t *testing.T) {")
+	require.Contains(t, code, "in      *pb.LouderRequest")
+	require.Contains(t, code, "got, err := NewService(Dependencies{}).Louder(context.Background(), tt.in)")
+	require.Contains(t, code, `pb "github.com/metaverse/truss/gengokit/echo-service"`)
+	require.Less(t, strings.Index(code, "TestEcho"), strings.Index(code, "TestLouder"), "tests should be in the order of the definition")
+}
+
+func TestTestsKeepExisting(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import "testing"
+
+		// TestEcho is written by hand
+		func TestEcho(t *testing.T) {
+			t.Log("hand written")
+		}
+
+		func TestRemovedMethod(t *testing.T) {}
+	`
+	code, err := renderTestsFile(testsDef, prev)
+	require.NoError(t, err)
+
+	require.Contains(t, code, "// TestEcho is written by hand\nfunc TestEcho(t *testing.T) {\n\tt.Log(\"hand written\")\n}")
+	require.Equal(t, 1, strings.Count(code, "func TestEcho("))
+	require.Contains(t, code, "func TestRemovedMethod(t *testing.T) {}")
+	require.Contains(t, code, "func TestLouder(t *testing.T) {")
+	for _, imp := range []string{`"context"`, `"github.com/gogo/protobuf/proto"`, `pb "github.com/metaverse/truss/gengokit/echo-service"`} {
+		require.Contains(t, code, imp)
+	}
+
+	// Rendering again changes nothing
+	again, err := renderTestsFile(testsDef, code)
+	require.NoError(t, err)
+	require.Equal(t, code, again)
+}
+
+func TestTestsExternalPackage(t *testing.T) {
+	const prev = `
+		package handlers_test
+
+		import "testing"
+
+		func TestEcho(t *testing.T) {}
+	`
+	code, err := renderTestsFile(testsDef, prev)
+	require.NoError(t, err)
+
+	require.Contains(t, code, "got, err := handlers.NewService(handlers.Dependencies{}).Louder(context.Background(), tt.in)")
+	require.Contains(t, code, `"github.com/metaverse/truss/gengokit/handlers"`)
+}
+
+func TestTestsNextToNeighbours(t *testing.T) {
+	const prev = `
+		package handlers
+
+		import "testing"
+
+		func TestSecond(t *testing.T) {}
+
+		func TestFourth(t *testing.T) {}
+	`
+	code, err := renderTestsFile(orderDef, prev)
+	require.NoError(t, err)
+
+	last := -1
+	for _, m := range []string{"First", "Second", "Third", "Fourth", "Fifth"} {
+		i := strings.Index(code, "func Test"+m+"(")
+		require.Greater(t, i, last, "%s is out of order:\n%s", m, code)
+		last = i
+	}
+
+	again, err := renderTestsFile(orderDef, prev)
+	require.NoError(t, err)
+	require.Equal(t, code, again)
+}
+
+func TestNewServiceExpr(t *testing.T) {
+	deps := gengokit.HandlersStyle{Dependencies: true}
+	require.Equal(t, "NewService(Dependencies{})", newServiceExpr("", deps))
+	require.Equal(t, "handlers.NewService(handlers.Dependencies{})", newServiceExpr("handlers.", deps))
+	require.Equal(t, "NewService()", newServiceExpr("", gengokit.HandlersStyle{}))
+}
+
+// renderTestsFile returns the handlers/handlers_test.go generated for the
+// definition def, given the previous version of the file prev.
+func renderTestsFile(def, prev string) (string, error) {
+	sd, err := svcdef.NewFromString(def, gopath)
+	if err != nil {
+		return "", err
+	}
+	data, err := gengokit.NewData(sd, gengokit.Config{
+		GoPackage: "github.com/metaverse/truss/gengokit",
+		PBPackage: "github.com/metaverse/truss/gengokit/echo-service",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var prevFile io.Reader
+	if prev != "" {
+		prevFile = strings.NewReader(prev)
+	}
+	tests, err := NewTests(sd.Service, prevFile)
+	if err != nil {
+		return "", err
+	}
+	next, err := tests.Render(TestsPath, data)
+	if err != nil {
+		return "", err
+	}
+	nextBytes, err := ioutil.ReadAll(next)
+	if err != nil {
+		return "", err
+	}
+
+	nextCode, err := testFormat(string(nextBytes))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot format")
+	}
+	return strings.TrimSpace(nextCode), nil
+}
@@ -1,9 +1,12 @@
 package httptransport
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Contains all the functions which must be used within templates. Stored all
@@ -106,3 +109,220 @@ func encodePathParams(vars map[string]string) map[string]string {
 	}
 	return ret
 }
+
+// parseTimestamp parses the proto3 JSON form of a google.protobuf.Timestamp,
+// an RFC 3339 date such as "2017-01-15T01:30:15.01Z", into seconds and
+// nanoseconds since the Unix epoch.
+func parseTimestamp(s string) (int64, int32, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, 0, err
+	}
+	return t.Unix(), int32(t.Nanosecond()), nil
+}
+
+// formatTimestamp returns the proto3 JSON form of a google.protobuf.Timestamp,
+// in UTC with 0, 3, 6 or 9 fractional digits.
+func formatTimestamp(seconds int64, nanos int32) string {
+	t := time.Unix(seconds, int64(nanos)).UTC()
+	date := t.Format("2006-01-02T15:04:05")
+	if t.Nanosecond() == 0 {
+		return date + "Z"
+	}
+	frac := fmt.Sprintf("%09d", t.Nanosecond())
+	switch {
+	case strings.HasSuffix(frac, "000000"):
+		frac = frac[:3]
+	case strings.HasSuffix(frac, "000"):
+		frac = frac[:6]
+	}
+	return date + "." + frac + "Z"
+}
+
+// parseDuration parses the proto3 JSON form of a google.protobuf.Duration, a
+// number of seconds with up to nine fractional digits and an "s" suffix such
+// as "1.5s", into seconds and nanoseconds.
+func parseDuration(s string) (int64, int32, error) {
+	if !strings.HasSuffix(s, "s") {
+		return 0, 0, fmt.Errorf("duration %q does not end with \"s\"", s)
+	}
+	num := strings.TrimSuffix(s, "s")
+	neg := strings.HasPrefix(num, "-")
+	num = strings.TrimPrefix(num, "-")
+
+	secStr, fracStr := num, ""
+	if i := strings.Index(num, "."); i >= 0 {
+		secStr, fracStr = num[:i], num[i+1:]
+	}
+	if secStr == "" || len(fracStr) > 9 || strings.Trim(secStr+fracStr, "0123456789") != "" {
+		return 0, 0, fmt.Errorf("invalid duration %q", s)
+	}
+	seconds, err := strconv.ParseInt(secStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid duration %q: %v", s, err)
+	}
+	nanos, _ := strconv.ParseInt((fracStr + "000000000")[:9], 10, 32)
+	if neg {
+		seconds, nanos = -seconds, -nanos
+	}
+	return seconds, int32(nanos), nil
+}
+
+// formatDuration returns the proto3 JSON form of a google.protobuf.Duration,
+// with 0, 3, 6 or 9 fractional digits.
+func formatDuration(seconds int64, nanos int32) string {
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign = "-"
+		seconds, nanos = -seconds, -nanos
+	}
+	if nanos == 0 {
+		return fmt.Sprintf("%s%ds", sign, seconds)
+	}
+	frac := fmt.Sprintf("%09d", nanos)
+	switch {
+	case strings.HasSuffix(frac, "000000"):
+		frac = frac[:3]
+	case strings.HasSuffix(frac, "000"):
+		frac = frac[:6]
+	}
+	return fmt.Sprintf("%s%d.%ss", sign, seconds, frac)
+}
+
+// parseFieldMask parses the proto3 JSON form of a google.protobuf.FieldMask,
+// comma separated lowerCamelCase paths such as "user.displayName,photo", into
+// its snake_case paths.
+func parseFieldMask(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	paths := strings.Split(s, ",")
+	for i, path := range paths {
+		if path == "" || strings.Contains(path, "_") {
+			return nil, fmt.Errorf("invalid field mask path %q", path)
+		}
+		var snake []byte
+		for j := 0; j < len(path); j++ {
+			c := path[j]
+			if 'A' <= c && c <= 'Z' {
+				snake = append(snake, '_', c-'A'+'a')
+				continue
+			}
+			snake = append(snake, c)
+		}
+		paths[i] = string(snake)
+	}
+	return paths, nil
+}
+
+// formatFieldMask returns the proto3 JSON form of the paths of a
+// google.protobuf.FieldMask.
+func formatFieldMask(paths []string) string {
+	camel := make([]string, len(paths))
+	for i, path := range paths {
+		var b []byte
+		for j := 0; j < len(path); j++ {
+			c := path[j]
+			if c == '_' && j+1 < len(path) && 'a' <= path[j+1] && path[j+1] <= 'z' {
+				j++
+				b = append(b, path[j]-'a'+'A')
+				continue
+			}
+			b = append(b, c)
+		}
+		camel[i] = string(b)
+	}
+	return strings.Join(camel, ",")
+}
+
+// parseBytes decodes base64 in the standard or URL safe alphabet, with or
+// without padding, as accepted by the proto3 JSON mapping of bytes.
+func parseBytes(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// formatBytes encodes bytes as base64url, which proto3 JSON parsers accept
+// and which needs no escaping in URLs other than of its padding.
+func formatBytes(b []byte) string {
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+// parseQueryMap returns the map<string, string> field set by query
+// parameters of the form name[key]=value, or name=key=value, for any of the
+// names of the field. It returns nil if no such parameter is set.
+func parseQueryMap(query map[string][]string, names ...string) (map[string]string, error) {
+	var rv map[string]string
+	set := func(key, value string) {
+		if rv == nil {
+			rv = make(map[string]string)
+		}
+		rv[key] = value
+	}
+	for param, values := range query {
+		for _, name := range names {
+			switch {
+			case param == name:
+				for _, kv := range values {
+					i := strings.Index(kv, "=")
+					if i < 0 {
+						return nil, fmt.Errorf("%s value %q is not of the form key=value", name, kv)
+					}
+					set(kv[:i], kv[i+1:])
+				}
+			case strings.HasPrefix(param, name+"[") && strings.HasSuffix(param, "]"):
+				set(param[len(name)+1:len(param)-1], values[0])
+			}
+		}
+	}
+	return rv, nil
+}
+
+// parseEnum parses an enum value given by its name, as in the values map
+// generated for the enum, or by its number.
+func parseEnum(s string, values map[string]int32) (int32, error) {
+	if v, ok := values[s]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%q is neither the name nor the number of an enum value", s)
+	}
+	return int32(v), nil
+}
+
+// parseEnums parses the values of a repeated enum, given one per query
+// parameter, or all in one parameter as a comma separated list or a JSON
+// array. Each value may be a name or a number, as accepted by parseEnum.
+func parseEnums(strs []string, values map[string]int32) ([]int32, error) {
+	if len(strs) == 1 {
+		s := strings.TrimSpace(strs[0])
+		if strings.HasPrefix(s, "[") {
+			var elems []json.RawMessage
+			if err := json.Unmarshal([]byte(s), &elems); err != nil {
+				return nil, err
+			}
+			strs = make([]string, len(elems))
+			for i, e := range elems {
+				strs[i] = string(e)
+				if unquoted, err := strconv.Unquote(strs[i]); err == nil {
+					strs[i] = unquoted
+				}
+			}
+		} else {
+			strs = strings.Split(s, ",")
+		}
+	}
+	rv := make([]int32, 0, len(strs))
+	for _, s := range strs {
+		v, err := parseEnum(strings.TrimSpace(s), values)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, v)
+	}
+	return rv, nil
+}
@@ -67,3 +67,164 @@ func TestEncodePathParams(t *testing.T) {
 		})
 	}
 }
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		in, out string
+		seconds int64
+		nanos   int32
+	}{
+		{"1970-01-01T00:00:00Z", "1970-01-01T00:00:00Z", 0, 0},
+		{"2017-01-15T01:30:15.01Z", "2017-01-15T01:30:15.010Z", 1484443815, 10000000},
+		{"2017-01-15T03:30:15.01+02:00", "2017-01-15T01:30:15.010Z", 1484443815, 10000000},
+		{"1969-12-31T23:59:59.5Z", "1969-12-31T23:59:59.500Z", -1, 500000000},
+		{"2017-01-15T01:30:15.0000012Z", "2017-01-15T01:30:15.000001200Z", 1484443815, 1200},
+		{"2017-01-15T01:30:15.00012Z", "2017-01-15T01:30:15.000120Z", 1484443815, 120000},
+	}
+	for _, tt := range tests {
+		seconds, nanos, err := parseTimestamp(tt.in)
+		if err != nil || seconds != tt.seconds || nanos != tt.nanos {
+			t.Errorf("parseTimestamp(%q) = %d, %d, %v, want %d, %d", tt.in, seconds, nanos, err, tt.seconds, tt.nanos)
+		}
+		if got := formatTimestamp(tt.seconds, tt.nanos); got != tt.out {
+			t.Errorf("formatTimestamp(%d, %d) = %q, want %q", tt.seconds, tt.nanos, got, tt.out)
+		}
+	}
+	if _, _, err := parseTimestamp("2017-01-15"); err == nil {
+		t.Errorf("parseTimestamp accepted a date without a time")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in, out string
+		seconds int64
+		nanos   int32
+	}{
+		{"0s", "0s", 0, 0},
+		{"1.5s", "1.500s", 1, 500000000},
+		{"-1.000340012s", "-1.000340012s", -1, -340012},
+		{"0.000001s", "0.000001s", 0, 1000},
+		{"-0.5s", "-0.500s", 0, -500000000},
+		{"315576000000s", "315576000000s", 315576000000, 0},
+	}
+	for _, tt := range tests {
+		seconds, nanos, err := parseDuration(tt.in)
+		if err != nil || seconds != tt.seconds || nanos != tt.nanos {
+			t.Errorf("parseDuration(%q) = %d, %d, %v, want %d, %d", tt.in, seconds, nanos, err, tt.seconds, tt.nanos)
+		}
+		if got := formatDuration(tt.seconds, tt.nanos); got != tt.out {
+			t.Errorf("formatDuration(%d, %d) = %q, want %q", tt.seconds, tt.nanos, got, tt.out)
+		}
+	}
+	for _, in := range []string{"1", "1m", "s", ".5s", "1.s0", "1.-5s", "+1s", "1.0000000001s"} {
+		if _, _, err := parseDuration(in); err == nil {
+			t.Errorf("parseDuration(%q) did not fail", in)
+		}
+	}
+}
+
+func TestFieldMask(t *testing.T) {
+	paths, err := parseFieldMask("user.displayName,photo")
+	if want := []string{"user.display_name", "photo"}; err != nil || !reflect.DeepEqual(paths, want) {
+		t.Errorf("parseFieldMask() = %v, %v, want %v", paths, err, want)
+	}
+	if got, want := formatFieldMask(paths), "user.displayName,photo"; got != want {
+		t.Errorf("formatFieldMask() = %q, want %q", got, want)
+	}
+	if paths, err := parseFieldMask(""); err != nil || paths != nil {
+		t.Errorf("parseFieldMask(\"\") = %v, %v, want no paths", paths, err)
+	}
+	for _, in := range []string{"a,,b", "display_name"} {
+		if _, err := parseFieldMask(in); err == nil {
+			t.Errorf("parseFieldMask(%q) did not fail", in)
+		}
+	}
+}
+
+func TestBytes(t *testing.T) {
+	want := []byte{0xfb, 0xff}
+	for _, in := range []string{"+/8=", "+/8", "-_8=", "-_8"} {
+		if got, err := parseBytes(in); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("parseBytes(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+	if _, err := parseBytes("+_8"); err == nil {
+		t.Errorf("parseBytes accepted mixed alphabets")
+	}
+	if got := formatBytes(want); got != "-_8=" {
+		t.Errorf("formatBytes() = %q, want %q", got, "-_8=")
+	}
+}
+
+func TestParseQueryMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		query map[string][]string
+		want  map[string]string
+	}{
+		{
+			name:  "brackets",
+			query: map[string][]string{"labels[env]": {"prod"}, "labels[team]": {"a=b"}, "other": {"x"}},
+			want:  map[string]string{"env": "prod", "team": "a=b"},
+		},
+		{
+			name:  "key=value",
+			query: map[string][]string{"labels": {"env=prod", "team=a=b", "empty="}},
+			want:  map[string]string{"env": "prod", "team": "a=b", "empty": ""},
+		},
+		{
+			name:  "json name",
+			query: map[string][]string{"extraLabels[env]": {"prod"}},
+			want:  map[string]string{"env": "prod"},
+		},
+		{
+			name:  "unset",
+			query: map[string][]string{"labelsx[env]": {"prod"}, "labels[env": {"prod"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQueryMap(tt.query, "labels", "extraLabels")
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseQueryMap() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseQueryMap(map[string][]string{"labels": {"env"}}, "labels"); err == nil {
+		t.Errorf("parseQueryMap accepted a value without a key")
+	}
+}
+
+func TestParseEnums(t *testing.T) {
+	values := map[string]int32{"UNKNOWN": 0, "ACTIVE": 1, "NEG": -1}
+	tests := []struct {
+		name string
+		strs []string
+		want []int32
+	}{
+		{"names", []string{"ACTIVE", "NEG"}, []int32{1, -1}},
+		{"numbers", []string{"1", "-1", "7"}, []int32{1, -1, 7}},
+		{"comma separated", []string{"ACTIVE, 0"}, []int32{1, 0}},
+		{"json array", []string{`["ACTIVE", -1, "0"]`}, []int32{1, -1, 0}},
+		{"empty json array", []string{"[]"}, []int32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEnums(tt.strs, values)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseEnums(%q) = %v, %v, want %v", tt.strs, got, err, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range [][]string{{"active"}, {"1.5"}, {"[ACTIVE]"}, {"ACTIVE", ""}} {
+		if got, err := parseEnums(bad, values); err == nil {
+			t.Errorf("parseEnums(%q) = %v, want error", bad, got)
+		}
+	}
+	if got, err := parseEnum("ACTIVE", values); err != nil || got != 1 {
+		t.Errorf("parseEnum(ACTIVE) = %v, %v, want 1", got, err)
+	}
+}
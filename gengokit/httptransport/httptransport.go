@@ -6,7 +6,9 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -25,8 +27,8 @@ import (
 // of a service. Helper must be built from a Svcdef.
 type Helper struct {
 	Methods        []*Method
-	ServerTemplate func(interface{}) (string, error)
-	ClientTemplate func(interface{}) (string, error)
+	ServerTemplate func(interface{}) (string, error) `json:"-"`
+	ClientTemplate func(interface{}) (string, error) `json:"-"`
 }
 
 // NewHelper builds a helper struct from a service declaration. The other
@@ -48,6 +50,37 @@ func NewHelper(svc *svcdef.Service) *Helper {
 	return &rv
 }
 
+// Routes returns every distinct path template bound by the methods of the
+// Helper, in the order they are first bound.
+func (h *Helper) Routes() []*Route {
+	var routes []*Route
+	byPath := make(map[string]*Route)
+	for _, meth := range h.Methods {
+		for _, b := range meth.Bindings {
+			r, ok := byPath[b.PathTemplate]
+			if !ok {
+				r = &Route{PathTemplate: b.PathTemplate}
+				byPath[b.PathTemplate] = r
+				routes = append(routes, r)
+			}
+			verb := strings.ToUpper(b.Verb)
+			if !containsString(r.Verbs, verb) {
+				r.Verbs = append(r.Verbs, verb)
+			}
+		}
+	}
+	return routes
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
 // NewMethod builds a Method struct from a svcdef.ServiceMethod.
 func NewMethod(meth *svcdef.ServiceMethod) *Method {
 	nMeth := Method{
@@ -93,6 +126,7 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 			option := Field{
 				Name:           oneofType.Name,
 				QueryParamName: oneofType.PBFieldName,
+				JSONName:       oneofType.JSONName,
 				CamelName:      gogen.CamelCase(field.Name),
 				LowCamelName:   LowCamelName(oneofType.Name),
 				Repeated:       oneofType.Type.ArrayType,
@@ -111,6 +145,8 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 			} else if oneofType.Type.ArrayType {
 				option.GoType = "[]" + option.GoType
 			}
+			setWellKnownType(&option, oneofType.Type.Name)
+			setParamKind(&option, oneofType.Type)
 
 			option.IsEnum = oneofType.Type.Enum != nil
 			option.ConvertFunc, option.ConvertFuncNeedsErrorCheck = createDecodeConvertFunc(option)
@@ -132,6 +168,7 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 		newField := Field{
 			Name:           field.Name,
 			QueryParamName: field.PBFieldName,
+			JSONName:       field.JSONName,
 			CamelName:      gogen.CamelCase(field.Name),
 			LowCamelName:   LowCamelName(field.Name),
 			Location:       param.Location,
@@ -155,6 +192,9 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 
 		// IsEnum needed for ConvertFunc and TypeConversion logic just below
 		newField.IsEnum = field.Type.Enum != nil
+		newField.IsMessage = field.Type.Message != nil
+		setWellKnownType(&newField, field.Type.Name)
+		setParamKind(&newField, field.Type)
 		newField.ConvertFunc, newField.ConvertFuncNeedsErrorCheck = createDecodeConvertFunc(newField)
 		newField.TypeConversion = createDecodeTypeConversion(newField)
 
@@ -165,8 +205,21 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 			continue
 		}
 
+		// Messages in the query are set through dotted parameters, skip warning
+		if newField.IsMessage && !newField.Repeated && newField.Location == "query" {
+			newField.NestedFields = newNestedFields(&newField, nil, field.Type.Message, meth.Name,
+				map[*svcdef.Message]bool{field.Type.Message: true})
+			continue
+		}
+
+		// Maps of strings in the query are set with name[key]=value, skip
+		// warning
+		if newField.IsStringMap && newField.Location == "query" {
+			continue
+		}
+
 		// Emit warnings for certain cases
-		if !newField.IsBaseType && newField.Location != "body" {
+		if !newField.IsBaseType && newField.WellKnownType == "" && newField.Location != "body" {
 			log.Warnf(
 				"%s.%s is a non-base type specified to be located outside of "+
 					"the body. Non-base types outside the body may result in "+
@@ -186,6 +239,399 @@ func NewBinding(i int, meth *svcdef.ServiceMethod) *Binding {
 	return &nBinding
 }
 
+// queryScalarTypes are the Go types of the scalar fields which may be nested
+// query parameters.
+var queryScalarTypes = map[string]bool{
+	"int32":   true,
+	"int64":   true,
+	"uint32":  true,
+	"uint64":  true,
+	"float32": true,
+	"float64": true,
+	"bool":    true,
+	"string":  true,
+}
+
+// newNestedFields returns the fields of msg, which is the type of parent, that
+// may be set with dotted query parameters. Scalar, enum, bytes, well-known
+// type and map<string, string> fields are returned, and the fields of
+// non-repeated message fields are returned in their place. Oneofs, other
+// maps, repeated messages and messages already in seen, which would recurse
+// forever, are skipped.
+func newNestedFields(parent *Field, parents []*ParentMessage, msg *svcdef.Message, methName string, seen map[*svcdef.Message]bool) []*Field {
+	parents = append(parents[:len(parents):len(parents)], &ParentMessage{
+		CamelName: parent.CamelName,
+		GoType:    parent.GoType,
+	})
+
+	var rv []*Field
+	for _, field := range msg.Fields {
+		t := field.Type
+		if t.Oneof != nil {
+			continue
+		}
+		camelName := parent.CamelName + "." + gogen.CamelCase(field.Name)
+		nested := Field{
+			Name:           field.Name,
+			QueryParamName: parent.QueryParamName + "." + field.PBFieldName,
+			JSONName:       parent.JSONName + "." + field.JSONName,
+			CamelName:      camelName,
+			LowCamelName:   LowCamelName(field.Name),
+			LocalName:      strings.Replace(camelName, ".", "", -1) + gogen.CamelCase(methName),
+			Location:       parent.Location,
+			Repeated:       t.ArrayType,
+			GoType:         t.Name,
+			IsEnum:         t.Enum != nil,
+			IsMessage:      t.Message != nil,
+			Parents:        parents,
+		}
+		if parent.JSONName == "" || field.JSONName == "" {
+			nested.JSONName = ""
+		}
+
+		switch {
+		case nested.IsMessage:
+			if nested.Repeated || seen[t.Message] {
+				continue
+			}
+			nested.GoType = "pb." + nested.GoType
+			seen[t.Message] = true
+			rv = append(rv, newNestedFields(&nested, parents, t.Message, methName, seen)...)
+			delete(seen, t.Message)
+			continue
+		case setWellKnownType(&nested, t.Name), setParamKind(&nested, t):
+		case nested.IsEnum, queryScalarTypes[t.Name]:
+			if nested.IsEnum {
+				nested.GoType = "pb." + nested.GoType
+			} else {
+				nested.IsBaseType = true
+			}
+			if nested.Repeated {
+				nested.GoType = "[]" + nested.GoType
+			}
+		default:
+			continue
+		}
+		nested.ConvertFunc, nested.ConvertFuncNeedsErrorCheck = createDecodeConvertFunc(nested)
+		nested.TypeConversion = createDecodeTypeConversion(nested)
+		nested.ZeroValue = getZeroValue(nested)
+		rv = append(rv, &nested)
+	}
+	return rv
+}
+
+// paramConversion describes how a type which strconv and fmt cannot handle
+// is converted from and to the string of a query or path parameter.
+type paramConversion struct {
+	// parse declares %[1]s, and err unless noError is set, from the string
+	// %[2]s.
+	parse   string
+	noError bool
+	// value builds the field value from the variables declared by parse.
+	value string
+	// format returns the string form of the field value %[1]s, which may be
+	// nil.
+	format string
+	// parseFunc and formatFunc are the embeddable functions called by parse
+	// and format, if any.
+	parseFunc, formatFunc interface{}
+}
+
+// bytesConversion converts bytes fields as base64url.
+var bytesConversion = paramConversion{
+	parse:      "%[1]s, err := parseBytes(%[2]s)",
+	value:      "%[1]s",
+	format:     "formatBytes(%[1]s)",
+	parseFunc:  parseBytes,
+	formatFunc: formatBytes,
+}
+
+// wellKnownTypes are the google.protobuf well-known types, as mapped to
+// github.com/gogo/protobuf/types by truss, which may be query and path
+// parameters, keyed by their Go type. They use their proto3 JSON form.
+var wellKnownTypes = map[string]paramConversion{
+	"types.Timestamp": {
+		parse:      "%[1]sSeconds, %[1]sNanos, err := parseTimestamp(%[2]s)",
+		value:      "&types.Timestamp{Seconds: %[1]sSeconds, Nanos: %[1]sNanos}",
+		format:     "formatTimestamp(%[1]s.GetSeconds(), %[1]s.GetNanos())",
+		parseFunc:  parseTimestamp,
+		formatFunc: formatTimestamp,
+	},
+	"types.Duration": {
+		parse:      "%[1]sSeconds, %[1]sNanos, err := parseDuration(%[2]s)",
+		value:      "&types.Duration{Seconds: %[1]sSeconds, Nanos: %[1]sNanos}",
+		format:     "formatDuration(%[1]s.GetSeconds(), %[1]s.GetNanos())",
+		parseFunc:  parseDuration,
+		formatFunc: formatDuration,
+	},
+	"types.FieldMask": {
+		parse:      "%[1]s, err := parseFieldMask(%[2]s)",
+		value:      "&types.FieldMask{Paths: %[1]s}",
+		format:     "formatFieldMask(%[1]s.GetPaths())",
+		parseFunc:  parseFieldMask,
+		formatFunc: formatFieldMask,
+	},
+	"types.DoubleValue": {
+		parse:  "%[1]s, err := strconv.ParseFloat(%[2]s, 64)",
+		value:  "&types.DoubleValue{Value: %[1]s}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.FloatValue": {
+		parse:  "%[1]s, err := strconv.ParseFloat(%[2]s, 32)",
+		value:  "&types.FloatValue{Value: float32(%[1]s)}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.Int64Value": {
+		parse:  "%[1]s, err := strconv.ParseInt(%[2]s, 10, 64)",
+		value:  "&types.Int64Value{Value: %[1]s}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.UInt64Value": {
+		parse:  "%[1]s, err := strconv.ParseUint(%[2]s, 10, 64)",
+		value:  "&types.UInt64Value{Value: %[1]s}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.Int32Value": {
+		parse:  "%[1]s, err := strconv.ParseInt(%[2]s, 10, 32)",
+		value:  "&types.Int32Value{Value: int32(%[1]s)}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.UInt32Value": {
+		parse:  "%[1]s, err := strconv.ParseUint(%[2]s, 10, 32)",
+		value:  "&types.UInt32Value{Value: uint32(%[1]s)}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.BoolValue": {
+		parse:  "%[1]s, err := strconv.ParseBool(%[2]s)",
+		value:  "&types.BoolValue{Value: %[1]s}",
+		format: "fmt.Sprint(%[1]s.GetValue())",
+	},
+	"types.StringValue": {
+		parse:   "%[1]s := %[2]s",
+		noError: true,
+		value:   "&types.StringValue{Value: %[1]s}",
+		format:  "%[1]s.GetValue()",
+	},
+	"types.BytesValue": {
+		parse:      "%[1]s, err := parseBytes(%[2]s)",
+		value:      "&types.BytesValue{Value: %[1]s}",
+		format:     "formatBytes(%[1]s.GetValue())",
+		parseFunc:  parseBytes,
+		formatFunc: formatBytes,
+	},
+}
+
+// setWellKnownType sets the WellKnownType of f, and the GoType to match, if
+// typeName is one of the supported well-known types. It reports whether it
+// was.
+func setWellKnownType(f *Field, typeName string) bool {
+	if _, ok := wellKnownTypes[typeName]; !ok {
+		return false
+	}
+	f.WellKnownType = typeName
+	f.IsBaseType = false
+	f.GoType = typeName
+	if f.Repeated {
+		f.GoType = "[]*" + typeName
+	}
+	return true
+}
+
+// setParamKind sets the fields of f describing bytes and map<string, string>
+// fields, which svcdef represents as a slice of byte and a Map respectively.
+// It reports whether t was either.
+func setParamKind(f *Field, t *svcdef.FieldType) bool {
+	switch {
+	case t.Name == "byte" || t.Name == "[]byte":
+		f.IsBytes = true
+		f.IsBaseType = true
+		f.Repeated = t.Name == "[]byte"
+		f.GoType = "[]byte"
+		if f.Repeated {
+			f.GoType = "[][]byte"
+		}
+	case t.Map != nil && t.Map.KeyType.Name == "string" && t.Map.ValueType.Name == "string":
+		f.IsStringMap = true
+		f.IsBaseType = false
+		f.GoType = "map[string]string"
+	default:
+		return false
+	}
+	return true
+}
+
+// conversion returns how f is converted from and to a parameter, if it is
+// not a type strconv and fmt can handle.
+func (f *Field) conversion() (paramConversion, bool) {
+	if f.IsBytes {
+		return bytesConversion, true
+	}
+	c, ok := wellKnownTypes[f.WellKnownType]
+	return c, ok
+}
+
+// IsConverted reports whether f is a bytes field or a well-known type, which
+// are converted from and to parameters by functions embedded in the
+// generated code.
+func (f *Field) IsConverted() bool {
+	_, ok := f.conversion()
+	return ok
+}
+
+// FormatParam returns an expression formatting expr, which holds a value of
+// the type of f, as a query or path parameter. It may only be called on
+// fields for which IsConverted is true.
+func (f *Field) FormatParam(expr string) string {
+	c, _ := f.conversion()
+	return fmt.Sprintf(c.format, expr)
+}
+
+// paramFields returns every field of the service which is set from a query
+// or path parameter, including the options of oneofs and nested fields.
+func (h *Helper) paramFields() []*Field {
+	var rv []*Field
+	for _, meth := range h.Methods {
+		for _, b := range meth.Bindings {
+			for _, f := range b.Fields {
+				if f.Location == "body" {
+					continue
+				}
+				rv = append(rv, f)
+				rv = append(rv, f.NestedFields...)
+			}
+			for _, oneof := range b.OneofFields {
+				if oneof.Location != "query" {
+					continue
+				}
+				for i := range oneof.Options {
+					rv = append(rv, &oneof.Options[i])
+				}
+			}
+		}
+	}
+	return rv
+}
+
+// UsesWellKnownTypes reports whether any query or path parameter of the
+// service has a well-known type.
+func (h *Helper) UsesWellKnownTypes() bool {
+	for _, f := range h.paramFields() {
+		if f.WellKnownType != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeParamFuncs returns the source code of the embeddable functions the
+// server uses to parse the query and path parameters of the service.
+func (h *Helper) DecodeParamFuncs() (string, error) {
+	return funcsSourceCode(h.decodeParamFuncs())
+}
+
+// DecodeParamImports returns the import paths of the packages used by the
+// functions of DecodeParamFuncs which the server does not always import.
+func (h *Helper) DecodeParamImports() []string {
+	return funcsImports(h.decodeParamFuncs())
+}
+
+func (h *Helper) decodeParamFuncs() []interface{} {
+	var funcs []interface{}
+	for _, f := range h.paramFields() {
+		if c, ok := f.conversion(); ok && c.parseFunc != nil {
+			funcs = append(funcs, c.parseFunc)
+		}
+		if f.IsStringMap && f.Location == "query" {
+			funcs = append(funcs, parseQueryMap)
+		}
+		if f.IsEnum {
+			funcs = append(funcs, parseEnum)
+			if f.Repeated {
+				funcs = append(funcs, parseEnums)
+			}
+		}
+	}
+	return funcs
+}
+
+// EncodeParamFuncs returns the source code of the embeddable functions the
+// client uses to format the query and path parameters of the service.
+func (h *Helper) EncodeParamFuncs() (string, error) {
+	return funcsSourceCode(h.encodeParamFuncs())
+}
+
+// EncodeParamImports returns the import paths of the packages used by the
+// functions of EncodeParamFuncs which the client does not always import.
+func (h *Helper) EncodeParamImports() []string {
+	return funcsImports(h.encodeParamFuncs())
+}
+
+func (h *Helper) encodeParamFuncs() []interface{} {
+	var funcs []interface{}
+	for _, f := range h.paramFields() {
+		if c, ok := f.conversion(); ok && c.formatFunc != nil {
+			funcs = append(funcs, c.formatFunc)
+		}
+	}
+	return funcs
+}
+
+// paramFuncImports are the packages used by the embeddable functions, other
+// than those the HTTP server and client always import.
+var paramFuncImports = []struct {
+	fn      interface{}
+	imports []string
+}{
+	{parseBytes, []string{"encoding/base64"}},
+	{formatBytes, []string{"encoding/base64"}},
+	{parseTimestamp, []string{"time"}},
+	{formatTimestamp, []string{"time"}},
+}
+
+// funcsImports returns the sorted import paths of the packages used by funcs
+// according to paramFuncImports.
+func funcsImports(funcs []interface{}) []string {
+	used := make(map[uintptr]bool)
+	for _, fn := range funcs {
+		used[reflect.ValueOf(fn).Pointer()] = true
+	}
+	var rv []string
+	seen := make(map[string]bool)
+	for _, fi := range paramFuncImports {
+		if !used[reflect.ValueOf(fi.fn).Pointer()] {
+			continue
+		}
+		for _, imp := range fi.imports {
+			if !seen[imp] {
+				seen[imp] = true
+				rv = append(rv, imp)
+			}
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// funcsSourceCode returns the source code of each distinct function in funcs,
+// in the order they first appear.
+func funcsSourceCode(funcs []interface{}) (string, error) {
+	var code []string
+	seen := make(map[uintptr]bool)
+	for _, fn := range funcs {
+		ptr := reflect.ValueOf(fn).Pointer()
+		if seen[ptr] {
+			continue
+		}
+		seen[ptr] = true
+		source, err := FuncSourceCode(fn)
+		if err != nil {
+			return "", err
+		}
+		code = append(code, source)
+	}
+	return strings.Join(code, "\n\n"), nil
+}
+
 func GenServerTemplate(exec interface{}) (string, error) {
 	code, err := ApplyTemplate("ServerTemplate", templates.ServerTemplate, exec, TemplateFuncs)
 	if err != nil {
@@ -252,10 +698,14 @@ func (b *Binding) PathSections() []string {
 	})
 
 	isEnum := make(map[string]struct{})
+	converted := make(map[string]*Field)
 	for _, v := range b.Fields {
 		if v.IsEnum {
 			isEnum[v.CamelName] = struct{}{}
 		}
+		if v.IsConverted() {
+			converted[v.CamelName] = v
+		}
 	}
 
 	rv := []string{}
@@ -274,6 +724,10 @@ func (b *Binding) PathSections() []string {
 				rv = append(rv, convert)
 				continue
 			}
+			if f, ok := converted[camelName]; ok {
+				rv = append(rv, f.FormatParam("req."+camelName))
+				continue
+			}
 			convert := fmt.Sprintf("fmt.Sprint(req.%v)", camelName)
 			rv = append(rv, convert)
 		} else {
@@ -286,12 +740,84 @@ func (b *Binding) PathSections() []string {
 	return rv
 }
 
+// JSONQueryNames returns the names of the query parameters of the binding
+// which differ from their JSON names, mapped to those JSON names.
+func (b *Binding) JSONQueryNames() map[string]string {
+	names := make(map[string]string)
+	var add func(f Field)
+	add = func(f Field) {
+		if f.JSONName != "" && f.JSONName != f.QueryParamName {
+			names[f.QueryParamName] = f.JSONName
+		}
+		for _, nested := range f.NestedFields {
+			add(*nested)
+		}
+	}
+	for _, f := range b.Fields {
+		if f.Location == "query" {
+			add(*f)
+		}
+	}
+	for _, o := range b.OneofFields {
+		if o.Location == "query" {
+			for _, f := range o.Options {
+				add(f)
+			}
+		}
+	}
+	return names
+}
+
+// GenQueryEncoder returns the generated code for client-side encoding of a
+// nested field into its dotted query parameter. Zero values are not encoded.
+func (f *Field) GenQueryEncoder() (string, error) {
+	encoder := `
+if {{range $i, $p := .Parents}}{{if $i}} && {{end}}req.{{$p.CamelName}} != nil{{end}} {
+{{- if .IsStringMap}}
+	for k, v := range req.{{.CamelName}} {
+		values.Add("{{.QueryParamName}}["+k+"]", v)
+	}
+{{- else if .Repeated}}
+	for _, v := range req.{{.CamelName}} {
+		values.Add("{{.QueryParamName}}", {{template "value" .}})
+	}
+{{- else}}
+	if v := req.{{.CamelName}}; v != {{.ZeroValue}} {
+		values.Add("{{.QueryParamName}}", {{template "value" .}})
+	}
+{{- end}}
+}
+{{- define "value"}}
+	{{- if .IsConverted}}{{.FormatParam "v"}}
+	{{- else if .IsEnum}}fmt.Sprint(int32(v))
+	{{- else}}fmt.Sprint(v)
+	{{- end}}
+{{- end}}
+`
+	code, err := ApplyTemplate("FieldQueryEncoder", encoder, f, TemplateFuncs)
+	if err != nil {
+		return "", err
+	}
+	code = FormatCode(code)
+	return code, nil
+}
+
 // GenQueryUnmarshaler returns the generated code for server-side unmarshaling
 // of a query parameter into it's correct field on the request struct.
 func (f *Field) GenQueryUnmarshaler() (string, error) {
 	queryParamLogic := `
+{{- if and .JSONName (ne .JSONName .QueryParamName)}}
+{{.LocalName}}StrArr, {{.LocalName}}OK := {{.Location}}Params["{{.QueryParamName}}"]
+if !{{.LocalName}}OK {
+	{{.LocalName}}StrArr, {{.LocalName}}OK = {{.Location}}Params["{{.JSONName}}"]
+}
+if {{.LocalName}}OK {
+{{- else}}
 if {{.LocalName}}StrArr, ok := {{.Location}}Params["{{.QueryParamName}}"]; ok {
-{{.LocalName}}Str := {{.LocalName}}StrArr[0]`
+{{- end}}
+{{- if not (and (or .IsConverted .IsEnum) .Repeated)}}
+{{.LocalName}}Str := {{.LocalName}}StrArr[0]
+{{- end}}`
 
 	pathParamLogic := `
 {{.LocalName}}Str := {{.Location}}Params["{{.QueryParamName}}"]`
@@ -301,12 +827,41 @@ if {{.LocalName}}StrArr, ok := {{.Location}}Params["{{.QueryParamName}}"]; ok {
 if err != nil {
 	return nil, errors.Wrap(err, fmt.Sprintf("Error while extracting {{.LocalName}} from {{.Location}}, {{.Location}}Params: %v", {{.Location}}Params))
 }{{end}}
-{{if or .Repeated .IsBaseType .IsEnum}}req.{{.CamelName}} = {{.TypeConversion}}{{end}}
+{{- template "parents" .}}
+{{if or .Repeated .IsBaseType .IsEnum .WellKnownType}}req.{{.CamelName}} = {{.TypeConversion}}{{end}}
+`
+	// Maps may be set by any number of parameters, so are collected by
+	// parseQueryMap rather than looked up by name
+	mapLogic := `
+{{.LocalName}}, err := parseQueryMap(queryParams, "{{.QueryParamName}}"{{if and .JSONName (ne .JSONName .QueryParamName)}}, "{{.JSONName}}"{{end}})
+if err != nil {
+	return nil, errors.Wrap(err, fmt.Sprintf("Error while extracting {{.LocalName}} from query, queryParams: %v", queryParams))
+}
+if {{.LocalName}} != nil {
+{{- template "parents" .}}
+	req.{{.CamelName}} = {{.LocalName}}
+}
 `
-	mergedLogic := queryParamLogic + genericLogic + "}"
+	parentsLogic := `
+{{- define "parents"}}
+{{- range .Parents}}
+if req.{{.CamelName}} == nil {
+	req.{{.CamelName}} = &{{.GoType}}{}
+}
+{{- end}}
+{{- end}}`
+	nestedLogic := `
+{{- range .NestedFields}}
+{{.GenQueryUnmarshaler}}
+{{- end}}`
+
+	mergedLogic := queryParamLogic + genericLogic + "}" + nestedLogic
 	if f.Location == "path" {
 		mergedLogic = pathParamLogic + genericLogic
+	} else if f.IsStringMap {
+		mergedLogic = mapLogic
 	}
+	mergedLogic += parentsLogic
 
 	code, err := ApplyTemplate("FieldEncodeLogic", mergedLogic, f, TemplateFuncs)
 	if err != nil {
@@ -327,6 +882,11 @@ func (f *OneofField) GenQueryUnmarshaler() (string, error) {
 
 		var {{$option.LocalName}}Str string
 		{{$option.LocalName}}StrArr, {{$option.LocalName}}OK := {{$oneof.Location}}Params["{{$option.QueryParamName}}"]
+		{{- if and $option.JSONName (ne $option.JSONName $option.QueryParamName)}}
+		if !{{$option.LocalName}}OK {
+			{{$option.LocalName}}StrArr, {{$option.LocalName}}OK = {{$oneof.Location}}Params["{{$option.JSONName}}"]
+		}
+		{{- end}}
 		if {{$option.LocalName}}OK {
 			{{$option.LocalName}}Str = {{$option.LocalName}}StrArr[0]
 			{{$oneof.Name}}CountSet++
@@ -387,9 +947,12 @@ func createDecodeConvertFunc(f Field) (string, bool) {
 		needsErrorCheck = false
 	}
 
-	if f.IsEnum && !f.Repeated {
-		fType = "%s, err := strconv.ParseInt(%s, 10, 32)"
-		return fmt.Sprintf(fType, f.LocalName, f.LocalName+"Str"), true
+	if f.IsEnum {
+		return createEnumConvertFunc(f), true
+	}
+
+	if c, ok := f.conversion(); ok {
+		return createConversionConvertFunc(f, c)
 	}
 
 	// Use json unmarshalling for any custom/repeated messages
@@ -399,6 +962,11 @@ func createDecodeConvertFunc(f Field) (string, bool) {
 		// pointer as well. So we special case args of a single custom message
 		// type so that the variable LocalName is declared as a pointer.
 		singleCustomTypeUnmarshalTmpl := `
+{{- if .IsMessage}}
+if req.{{.CamelName}} == nil {
+	req.{{.CamelName}} = &{{.GoType}}{}
+}
+{{- end}}
 err = json.Unmarshal([]byte({{.LocalName}}Str), req.{{.CamelName}})`
 
 		errorCheckingTmpl := `
@@ -468,6 +1036,41 @@ if len({{.LocalName}}StrArr) > 1 {
 	return fmt.Sprintf(fType, f.LocalName, f.LocalName+"Str"), needsErrorCheck
 }
 
+// createEnumConvertFunc creates the go string parsing an enum field from the
+// names or numbers of its values, which are looked up in the "{ENUM}_value"
+// map generated for the enum. Repeated fields are parsed from every value of
+// the query parameter.
+func createEnumConvertFunc(f Field) string {
+	enumType := strings.TrimPrefix(f.GoType, "[]")
+	if !f.Repeated {
+		return fmt.Sprintf("%[1]s, err := parseEnum(%[1]sStr, %[2]s_value)", f.LocalName, enumType)
+	}
+	return fmt.Sprintf(`%[1]sValues, err := parseEnums(%[1]sStrArr, %[2]s_value)
+%[1]s := make(%[3]s, len(%[1]sValues))
+for i, v := range %[1]sValues {
+	%[1]s[i] = %[2]s(v)
+}`, f.LocalName, enumType, f.GoType)
+}
+
+// createConversionConvertFunc creates the go string parsing a field with a
+// paramConversion. Repeated fields are parsed from each value of the query
+// parameter.
+func createConversionConvertFunc(f Field, c paramConversion) (string, bool) {
+	if !f.Repeated {
+		return fmt.Sprintf(c.parse, f.LocalName, f.LocalName+"Str"), !c.noError
+	}
+	code := fmt.Sprintf("%[1]s := make(%[2]s, 0, len(%[1]sStrArr))\n", f.LocalName, f.GoType) +
+		fmt.Sprintf("for _, v := range %sStrArr {\n", f.LocalName) +
+		fmt.Sprintf(c.parse, "converted", "v") + "\n"
+	if !c.noError {
+		code += "if err != nil {\n" +
+			fmt.Sprintf("return nil, errors.Wrapf(err, \"couldn't decode %s from %%v\", v)\n", f.LocalName) +
+			"}\n"
+	}
+	code += fmt.Sprintf("%[1]s = append(%[1]s, %[2]s)\n}", f.LocalName, fmt.Sprintf(c.value, "converted"))
+	return code, false
+}
+
 // createDecodeTypeConversion creates a go string that converts a 64 bit type
 // to a 32 bit type as strconv.ParseInt, ParseUInt, and ParseFloat always
 // return the 64 bit type. If the type is not a 64 bit integer type or is
@@ -478,6 +1081,9 @@ func createDecodeTypeConversion(f Field) string {
 		// types.
 		return f.LocalName
 	}
+	if c, ok := f.conversion(); ok {
+		return fmt.Sprintf(c.value, f.LocalName)
+	}
 	fType := ""
 	switch f.GoType {
 	case "uint32", "int32", "float32":
@@ -492,7 +1098,10 @@ func createDecodeTypeConversion(f Field) string {
 }
 
 func getZeroValue(f Field) string {
-	if !f.IsBaseType || f.Repeated {
+	if f.IsEnum && !f.Repeated {
+		return "0"
+	}
+	if !f.IsBaseType || f.Repeated || f.IsBytes {
 		return "nil"
 	}
 	switch f.GoType {
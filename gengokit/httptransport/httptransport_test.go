@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -62,6 +63,7 @@ func TestNewMethod(t *testing.T) {
 			&Field{
 				Name:                       "A",
 				QueryParamName:             "a",
+				JSONName:                   "a",
 				CamelName:                  "A",
 				LowCamelName:               "a",
 				LocalName:                  "ASum",
@@ -75,6 +77,7 @@ func TestNewMethod(t *testing.T) {
 			&Field{
 				Name:                       "B",
 				QueryParamName:             "b",
+				JSONName:                   "b",
 				CamelName:                  "B",
 				LowCamelName:               "b",
 				LocalName:                  "BSum",
@@ -88,6 +91,7 @@ func TestNewMethod(t *testing.T) {
 			&Field{
 				Name:                       "OrigName",
 				QueryParamName:             "orig_name",
+				JSONName:                   "origName",
 				CamelName:                  "OrigName",
 				LowCamelName:               "origName",
 				LocalName:                  "OrigNameSum",
@@ -115,6 +119,11 @@ func TestNewMethod(t *testing.T) {
 		diff := gentesthelper.DiffStrings(spew.Sdump(got), spew.Sdump(want))
 		t.Errorf("got != want; methods differ: %v\n", diff)
 	}
+
+	names := newMeth.Bindings[0].JSONQueryNames()
+	if want := map[string]string{"orig_name": "origName"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("JSONQueryNames() = %v, want %v", names, want)
+	}
 }
 
 func TestFuncSourceCode(t *testing.T) {
@@ -272,3 +281,332 @@ func TestBinding_PathSections(t *testing.T) {
 		})
 	}
 }
+
+func TestHelperRoutes(t *testing.T) {
+	h := Helper{
+		Methods: []*Method{
+			{
+				Name: "Get",
+				Bindings: []*Binding{
+					{PathTemplate: "/items/{id}", Verb: "get"},
+					{PathTemplate: "/v1/items/{id}", Verb: "get"},
+				},
+			},
+			{
+				Name: "Update",
+				Bindings: []*Binding{
+					{PathTemplate: "/items/{id}", Verb: "put"},
+					{PathTemplate: "/items/{id}", Verb: "patch"},
+				},
+			},
+			{
+				Name: "Replace",
+				Bindings: []*Binding{
+					{PathTemplate: "/items/{id}", Verb: "put"},
+				},
+			},
+		},
+	}
+	want := []*Route{
+		{PathTemplate: "/items/{id}", Verbs: []string{"GET", "PUT", "PATCH"}},
+		{PathTemplate: "/v1/items/{id}", Verbs: []string{"GET"}},
+	}
+	got := h.Routes()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Routes() = %s, want %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
+func TestNewMethodNestedQuery(t *testing.T) {
+	defStr := `
+		syntax = "proto3";
+
+		package general;
+
+		import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+
+		enum Status {
+			UNKNOWN = 0;
+			ACTIVE = 1;
+		}
+
+		message Owner {
+			int64 owner_id = 1;
+		}
+
+		message Filter {
+			Status status = 1;
+			Owner owner = 2;
+			repeated string tags = 3;
+			Filter next = 4;
+		}
+
+		message ListRequest {
+			Filter filter = 1;
+		}
+
+		message ListReply {
+			int64 v = 1;
+		}
+
+		service ListSvc {
+			rpc List(ListRequest) returns (ListReply) {
+				option (google.api.http) = {
+					get: "/list"
+				};
+			}
+		}
+	`
+	sd, err := svcdef.NewFromString(defStr, gopath)
+	if err != nil {
+		t.Fatal(err, "Failed to create a service from the definition string")
+	}
+	meth := NewMethod(sd.Service.Methods[0])
+	filter := meth.Bindings[0].Fields[0]
+	if !filter.IsMessage {
+		t.Fatalf("filter field is not marked as a message")
+	}
+
+	var got [][]string
+	for _, f := range filter.NestedFields {
+		got = append(got, []string{f.QueryParamName, f.JSONName, f.CamelName, f.LocalName})
+	}
+	want := [][]string{
+		{"filter.status", "filter.status", "Filter.Status", "FilterStatusList"},
+		{"filter.owner.owner_id", "filter.owner.ownerId", "Filter.Owner.OwnerId", "FilterOwnerOwnerIdList"},
+		{"filter.tags", "filter.tags", "Filter.Tags", "FilterTagsList"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("nested fields = %v, want %v", got, want)
+	}
+
+	ownerID := filter.NestedFields[1]
+	if len(ownerID.Parents) != 2 || ownerID.Parents[1].GoType != "pb.Owner" {
+		t.Fatalf("unexpected parents of %s: %s", ownerID.CamelName, spew.Sdump(ownerID.Parents))
+	}
+
+	names := meth.Bindings[0].JSONQueryNames()
+	if want := map[string]string{"filter.owner.owner_id": "filter.owner.ownerId"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("JSONQueryNames() = %v, want %v", names, want)
+	}
+
+	code, err := ownerID.GenQueryEncoder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCode := `
+if req.Filter != nil && req.Filter.Owner != nil {
+	if v := req.Filter.Owner.OwnerId; v != 0 {
+		values.Add("filter.owner.owner_id", fmt.Sprint(v))
+	}
+}
+`
+	if got, want := strings.TrimSpace(code), strings.TrimSpace(wantCode); got != want {
+		t.Errorf("GenQueryEncoder() differs:\n%s", gentesthelper.DiffStrings(got, want))
+	}
+}
+
+func TestNewMethodWellKnownTypes(t *testing.T) {
+	defStr := `
+		syntax = "proto3";
+
+		package general;
+
+		import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+		import "google/protobuf/timestamp.proto";
+		import "google/protobuf/wrappers.proto";
+
+		message ListRequest {
+			google.protobuf.Timestamp since = 1;
+			google.protobuf.Int32Value page_size = 2;
+			repeated google.protobuf.Timestamp at = 3;
+		}
+
+		message ListReply {
+			int64 v = 1;
+		}
+
+		service ListSvc {
+			rpc List(ListRequest) returns (ListReply) {
+				option (google.api.http) = {
+					get: "/list/{since}"
+				};
+			}
+		}
+	`
+	sd, err := svcdef.NewFromString(defStr, gopath)
+	if err != nil {
+		t.Fatal(err, "Failed to create a service from the definition string")
+	}
+	h := NewHelper(sd.Service)
+	if !h.UsesWellKnownTypes() {
+		t.Fatal("UsesWellKnownTypes() = false, want true")
+	}
+	binding := h.Methods[0].Bindings[0]
+
+	var got [][]string
+	for _, f := range binding.Fields {
+		got = append(got, []string{f.WellKnownType, f.GoType, f.TypeConversion})
+	}
+	want := [][]string{
+		{"types.Timestamp", "types.Timestamp", "&types.Timestamp{Seconds: SinceListSeconds, Nanos: SinceListNanos}"},
+		{"types.Int32Value", "types.Int32Value", "&types.Int32Value{Value: int32(PageSizeList)}"},
+		{"types.Timestamp", "[]*types.Timestamp", "AtList"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("fields = %v, want %v", got, want)
+	}
+
+	sections := binding.PathSections()
+	if want := `formatTimestamp(req.Since.GetSeconds(), req.Since.GetNanos())`; sections[len(sections)-1] != want {
+		t.Errorf("PathSections() = %v, want last section %s", sections, want)
+	}
+
+	funcs, err := h.DecodeParamFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(funcs, "func parseTimestamp(") {
+		t.Errorf("DecodeParamFuncs() does not contain parseTimestamp:\n%s", funcs)
+	}
+	if got, want := h.DecodeParamImports(), []string{"time"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeParamImports() = %v, want %v", got, want)
+	}
+	if got, want := h.EncodeParamImports(), []string{"time"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodeParamImports() = %v, want %v", got, want)
+	}
+}
+
+func TestNewMethodBytesAndMaps(t *testing.T) {
+	defStr := `
+		syntax = "proto3";
+
+		package general;
+
+		import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+
+		message GetRequest {
+			bytes id = 1;
+			repeated bytes chunks = 2;
+			map<string, string> labels = 3;
+		}
+
+		message GetReply {
+			int64 v = 1;
+		}
+
+		service GetSvc {
+			rpc Get(GetRequest) returns (GetReply) {
+				option (google.api.http) = {
+					get: "/get/{id}"
+				};
+			}
+		}
+	`
+	sd, err := svcdef.NewFromString(defStr, gopath)
+	if err != nil {
+		t.Fatal(err, "Failed to create a service from the definition string")
+	}
+	h := NewHelper(sd.Service)
+	fields := h.Methods[0].Bindings[0].Fields
+
+	type kind struct {
+		GoType               string
+		Repeated, IsBytes    bool
+		IsStringMap, Convert bool
+	}
+	var got []kind
+	for _, f := range fields {
+		got = append(got, kind{f.GoType, f.Repeated, f.IsBytes, f.IsStringMap, f.IsConverted()})
+	}
+	want := []kind{
+		{"[]byte", false, true, false, true},
+		{"[][]byte", true, true, false, true},
+		{"map[string]string", false, false, true, false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("fields = %+v, want %+v", got, want)
+	}
+
+	decode, err := h.DecodeParamFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode, err := h.EncodeParamFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(decode, "func parseBytes(") != 1 || !strings.Contains(decode, "func parseQueryMap(") {
+		t.Errorf("DecodeParamFuncs() should contain parseBytes once and parseQueryMap:\n%s", decode)
+	}
+	if !strings.Contains(encode, "func formatBytes(") || strings.Contains(encode, "parseQueryMap") {
+		t.Errorf("EncodeParamFuncs() should contain only formatBytes:\n%s", encode)
+	}
+	if got, want := h.DecodeParamImports(), []string{"encoding/base64"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeParamImports() = %v, want %v", got, want)
+	}
+}
+
+func TestNewMethodEnums(t *testing.T) {
+	defStr := `
+		syntax = "proto3";
+
+		package general;
+
+		import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+
+		enum Status {
+			UNKNOWN = 0;
+			ACTIVE = 1;
+		}
+
+		message GetRequest {
+			Status status = 1;
+			repeated Status statuses = 2;
+		}
+
+		message GetReply {
+			int64 v = 1;
+		}
+
+		service GetSvc {
+			rpc Get(GetRequest) returns (GetReply) {
+				option (google.api.http) = {
+					get: "/get"
+				};
+			}
+		}
+	`
+	sd, err := svcdef.NewFromString(defStr, gopath)
+	if err != nil {
+		t.Fatal(err, "Failed to create a service from the definition string")
+	}
+	h := NewHelper(sd.Service)
+	fields := h.Methods[0].Bindings[0].Fields
+
+	if got, want := fields[0].ConvertFunc, "StatusGet, err := parseEnum(StatusGetStr, pb.Status_value)"; got != want {
+		t.Errorf("ConvertFunc = %q, want %q", got, want)
+	}
+	if got, want := fields[0].TypeConversion, "pb.Status(StatusGet)"; got != want {
+		t.Errorf("TypeConversion = %q, want %q", got, want)
+	}
+	if !strings.Contains(fields[1].ConvertFunc, "StatusesGetValues, err := parseEnums(StatusesGetStrArr, pb.Status_value)") {
+		t.Errorf("repeated ConvertFunc does not call parseEnums:\n%s", fields[1].ConvertFunc)
+	}
+
+	unmarshal, err := fields[1].GenQueryUnmarshaler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(unmarshal, "StatusesGetStr :=") {
+		t.Errorf("repeated enum declares an unused string:\n%s", unmarshal)
+	}
+
+	decode, err := h.DecodeParamFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(decode, "func parseEnum(") != 1 || !strings.Contains(decode, "func parseEnums(") {
+		t.Errorf("DecodeParamFuncs() should contain parseEnum once and parseEnums:\n%s", decode)
+	}
+}
@@ -35,7 +35,19 @@ var ClientEncodeTemplate = `
 		_ = tmp
 		{{- range $field := $binding.Fields }}
 			{{- if eq $field.Location "query"}}
-				{{if and $field.Repeated $field.IsBaseType}}
+				{{if and $field.IsConverted $field.Repeated}}
+					for _, v := range req.{{$field.CamelName}} {
+						values.Add("{{$field.QueryParamName}}", {{$field.FormatParam "v"}})
+					}
+				{{else if $field.IsConverted}}
+					if req.{{$field.CamelName}} != nil {
+						values.Add("{{$field.QueryParamName}}", {{$field.FormatParam (print "req." $field.CamelName)}})
+					}
+				{{else if $field.IsStringMap}}
+					for k, v := range req.{{$field.CamelName}} {
+						values.Add("{{$field.QueryParamName}}["+k+"]", v)
+					}
+				{{else if and $field.Repeated $field.IsBaseType}}
 					{{- if (Contains $field.GoType "[]string")}}
 					values["{{$field.QueryParamName}}"] = req.{{$field.CamelName}}
 					{{- else}}
@@ -43,6 +55,10 @@ var ClientEncodeTemplate = `
 						values.Add("{{$field.QueryParamName}}", fmt.Sprint(v))
 					}
 					{{- end}}
+				{{else if and $field.IsMessage (not $field.Repeated)}}
+					{{- range $nested := $field.NestedFields}}
+						{{$nested.GenQueryEncoder}}
+					{{- end}}
 				{{else if or (not $field.IsBaseType) $field.Repeated}}
 					tmp, err = json.Marshal(req.{{$field.CamelName}})
 					if err != nil {
@@ -58,7 +74,11 @@ var ClientEncodeTemplate = `
 		{{- range $oneof := $binding.OneofFields }}
 			{{- if eq $oneof.Location "query"}}
 				{{- range $option := $oneof.Options }}
-					{{if or (not $option.IsBaseType) $option.Repeated}}
+					{{if $option.IsConverted}}
+						if val := req.Get{{$option.Name}}(); val != nil {
+							values.Add("{{$option.QueryParamName}}", {{$option.FormatParam "val"}})
+						}
+					{{else if or (not $option.IsBaseType) $option.Repeated}}
 						if val := req.Get{{$option.Name}}(); val != {{$option.ZeroValue}} {
 							tmp, err = json.Marshal(req.Get{{$option.Name}}())
 							if err != nil {
@@ -112,6 +132,8 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -120,12 +142,16 @@ import (
 	"net/url"
 	"strings"
 	"context"
+	{{- range .HTTPHelper.EncodeParamImports}}
+	"{{.}}"
+	{{- end}}
 
 	{{ if len .HTTPHelper.Methods -}}
 		"github.com/gogo/protobuf/jsonpb"
 	{{- end }}
 
 	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/sd"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/pkg/errors"
 
@@ -157,6 +183,11 @@ func New(instance string, options ...httptransport.ClientOption) (pb.{{.Service.
 	}
 	_ = u
 
+	// Ask for compressed responses first, so that options can override it.
+	options = append([]httptransport.ClientOption{
+		httptransport.ClientBefore(acceptCompressedResponses),
+	}, options...)
+
 	{{if not .HTTPHelper.Methods -}}
 		panic("No HTTP Endpoints, this client will not work, define bindings in your proto definition")
 	{{- end}}
@@ -171,7 +202,15 @@ func New(instance string, options ...httptransport.ClientOption) (pb.{{.Service.
 						copyURL(u, "{{$binding.BasePath}}"),
 						EncodeHTTP{{$binding.Label}}Request,
 						DecodeHTTP{{$method.Name}}Response,
-						options...,
+						{{- with $names := $binding.JSONQueryNames}}
+							append(options[:len(options):len(options)], renameQueryParams(map[string]string{
+							{{- range $from, $to := $names}}
+								"{{$from}}": "{{$to}}",
+							{{- end}}
+							}))...,
+						{{- else}}
+							options...,
+						{{- end}}
 					).Endpoint()
 				}
 			{{- end}}
@@ -189,6 +228,21 @@ func New(instance string, options ...httptransport.ClientOption) (pb.{{.Service.
 	}, nil
 }
 
+// NewBalanced returns a service calling the instances of instancer in turn,
+// round robin, each through a client created as by New with options, and an
+// io.Closer to close once done with the service, which stops following
+// instancer. Use sd.FixedInstancer for a static list of instances, or
+// svc.NewFileInstancer for a list kept in a file.
+func NewBalanced(instancer sd.Instancer, options ...httptransport.ClientOption) (pb.{{.Service.Name}}Server, io.Closer) {
+	{{if not .HTTPHelper.Methods -}}
+		panic("No HTTP Endpoints, this client will not work, define bindings in your proto definition")
+	{{- end}}
+	return svc.Balanced(instancer, func(instance string) (pb.{{.Service.Name}}Server, io.Closer, error) {
+		client, err := New(instance, options...)
+		return client, nil, err
+	})
+}
+
 func copyURL(base *url.URL, path string) *url.URL {
 	next := *base
 	next.Path = path
@@ -210,6 +264,86 @@ func CtxValuesToSend(keys ...string) httptransport.ClientOption {
 	})
 }
 
+// JSONQueryNames configures the http client to name query parameters after
+// the proto3 JSON names of their fields, such as "pageSize", rather than the
+// names in the .proto file, such as "page_size".
+func JSONQueryNames() httptransport.ClientOption {
+	return httptransport.ClientBefore(func(ctx context.Context, r *http.Request) context.Context {
+		return context.WithValue(ctx, jsonQueryNamesKey{}, true)
+	})
+}
+
+type jsonQueryNamesKey struct{}
+
+// renameQueryParams renames the query parameters of requests when the
+// JSONQueryNames option is set. It must come after JSONQueryNames.
+func renameQueryParams(names map[string]string) httptransport.ClientOption {
+	return httptransport.ClientBefore(func(ctx context.Context, r *http.Request) context.Context {
+		if use, _ := ctx.Value(jsonQueryNamesKey{}).(bool); !use {
+			return ctx
+		}
+		values := r.URL.Query()
+		for from, to := range names {
+			if v, ok := values[from]; ok {
+				delete(values, from)
+				values[to] = v
+			}
+		}
+		r.URL.RawQuery = values.Encode()
+		return ctx
+	})
+}
+
+// GzipRequests configures the http client to gzip the bodies of requests.
+func GzipRequests() httptransport.ClientOption {
+	return httptransport.ClientBefore(func(ctx context.Context, r *http.Request) context.Context {
+		if r.Body == nil {
+			return ctx
+		}
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		// The body was encoded into memory, so copying it cannot fail
+		io.Copy(zw, r.Body)
+		zw.Close()
+		r.Body.Close()
+
+		r.Body = ioutil.NopCloser(&buf)
+		r.ContentLength = int64(buf.Len())
+		r.Header.Set("Content-Encoding", "gzip")
+		return ctx
+	})
+}
+
+// acceptCompressedResponses asks the server to compress its responses, which
+// readBody decompresses. Setting the header stops net/http from negotiating
+// gzip on its own.
+func acceptCompressedResponses(ctx context.Context, r *http.Request) context.Context {
+	r.Header.Set("Accept-Encoding", "gzip, deflate")
+	return ctx
+}
+
+// readBody reads the body of r, decompressing it according to its
+// Content-Encoding.
+func readBody(r *http.Response) ([]byte, error) {
+	var body io.Reader = r.Body
+	switch strings.ToLower(r.Header.Get("Content-Encoding")) {
+	case "gzip":
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot decompress gzip http body")
+		}
+		defer zr.Close()
+		body = zr
+	case "deflate":
+		zr, err := zlib.NewReader(r.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot decompress deflate http body")
+		}
+		defer zr.Close()
+		body = zr
+	}
+	return ioutil.ReadAll(body)
+}
 
 // HTTP Client Decode
 {{range $method := .HTTPHelper.Methods}}
@@ -220,7 +354,7 @@ func CtxValuesToSend(keys ...string) httptransport.ClientOption {
 	// body. Primarily useful in a client.
 	func DecodeHTTP{{$method.Name}}Response(_ context.Context, r *http.Response) (interface{}, error) {
 		defer r.Body.Close()
-		buf, err := ioutil.ReadAll(r.Body)
+		buf, err := readBody(r)
 		if err == io.EOF {
 			return nil, errors.New("response http body empty")
 		}
@@ -229,7 +363,7 @@ func CtxValuesToSend(keys ...string) httptransport.ClientOption {
 		}
 
 		if r.StatusCode != http.StatusOK {
-			return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+			return nil, StatusError{Code: r.StatusCode, Err: errorDecoder(buf)}
 		}
 
 		var resp pb.{{GoName $method.ResponseType}}
@@ -248,6 +382,27 @@ func CtxValuesToSend(keys ...string) httptransport.ClientOption {
 	{{end}}
 {{end}}
 
+// StatusError is returned by the client for responses with a status code
+// other than 200 OK. Err is the error sent in the body of the response.
+type StatusError struct {
+	Code int
+	Err  error
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("status code: '%d': %v", e.Code, e.Err)
+}
+
+// StatusCode returns the status code of the response.
+func (e StatusError) StatusCode() int {
+	return e.Code
+}
+
+// Cause returns Err, for github.com/pkg/errors.Cause.
+func (e StatusError) Cause() error {
+	return e.Err
+}
+
 func errorDecoder(buf []byte) error {
 	var w errorWrapper
 	if err := json.Unmarshal(buf, &w); err != nil {
@@ -264,4 +419,6 @@ func errorDecoder(buf []byte) error {
 type errorWrapper struct {
 	Error string ` + "`" + `json:"error"` + "`" + `
 }
+
+{{.HTTPHelper.EncodeParamFuncs}}
 `
@@ -12,6 +12,12 @@ var ServerDecodeTemplate = `
 		var req pb.{{GoName $binding.Parent.RequestType}}
 		buf, err := ioutil.ReadAll(r.Body)
 		if err != nil {
+			if isRequestTooLarge(err) {
+				return nil, httpError{errors.Wrapf(err, "cannot read body of http request"),
+					http.StatusRequestEntityTooLarge,
+					nil,
+				}
+			}
 			return nil, errors.Wrapf(err, "cannot read body of http request")
 		}
 		if len(buf) > 0 {
@@ -77,9 +83,15 @@ import (
 	"strconv"
 	"strings"
 	"io"
+	{{- range .HTTPHelper.DecodeParamImports}}
+	"{{.}}"
+	{{- end}}
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	{{- if .HTTPHelper.UsesWellKnownTypes}}
+	"github.com/gogo/protobuf/types"
+	{{- end}}
 
 	"context"
 
@@ -133,6 +145,208 @@ func MakeHTTPHandler(endpoints Endpoints, responseEncoder httptransport.EncodeRe
 	return m
 }
 
+// MakeCORSHandler returns a handler which applies the CORS policy in cfg to
+// requests before passing them on to next. Preflight requests to every
+// annotated path, and to the gRPC-Web methods, are answered without reaching
+// next.
+func MakeCORSHandler(cfg CORSConfig, next http.Handler) http.Handler {
+	c := corsPolicy{cfg}
+	m := mux.NewRouter()
+	{{range $route := .HTTPHelper.Routes}}
+		m.Methods("OPTIONS").Path("{{$route.PathTemplate}}").Handler(c.preflight({{range $route.Verbs}}"{{.}}", {{end}}))
+	{{- end}}
+	m.Methods("OPTIONS").MatcherFunc(isGRPCWebPath).Handler(c.preflight("POST"))
+
+	actual := c.actual(next)
+	m.NotFoundHandler = actual
+	m.MethodNotAllowedHandler = actual
+	return m
+}
+
+// MakeBodyLimitHandler returns a handler which limits the size of request
+// bodies to the limit for the method being called before passing requests on
+// to next. Reading past the limit fails, and the request is answered with 413
+// Request Entity Too Large.
+func MakeBodyLimitHandler(limits HTTPLimits, next http.Handler) http.Handler {
+	m := mux.NewRouter()
+	{{range $method := .HTTPHelper.Methods}}
+		{{- range $binding := $method.Bindings}}
+			m.Methods("{{$binding.Verb | ToUpper}}").Path("{{$binding.PathTemplate}}").Handler(limitBody(limits.BodyLimit("{{$method.Name}}"), next))
+		{{- end}}
+	{{- end}}
+	m.Methods("POST").MatcherFunc(isGRPCWebPath).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, method := splitGRPCWebPath(r.URL.Path)
+		limitBody(limits.BodyLimit(method), next).ServeHTTP(w, r)
+	})
+
+	others := limitBody(limits.MaxBodyBytes, next)
+	m.NotFoundHandler = others
+	m.MethodNotAllowedHandler = others
+	return m
+}
+
+// limitBody wraps the body of requests in an http.MaxBytesReader when n is
+// greater than zero.
+func limitBody(n int64, next http.Handler) http.Handler {
+	if n <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := &countingBody{ReadCloser: r.Body}
+		r.Body = &limitedBody{
+			ReadCloser: http.MaxBytesReader(w, body, n),
+			body:       body,
+			limit:      n,
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// countingBody counts the bytes read from a request body.
+type countingBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// limitedBody is the body of a request limited by an http.MaxBytesReader. The
+// reader only fails past the limit after reading more than limit bytes of
+// the body, so its failures are then returned as a requestTooLargeError.
+type limitedBody struct {
+	io.ReadCloser
+	body  *countingBody
+	limit int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && b.body.n > b.limit {
+		err = requestTooLargeError{err}
+	}
+	return n, err
+}
+
+// requestTooLargeError is returned by reading the body of a request past its
+// limit.
+type requestTooLargeError struct {
+	error
+}
+
+// isRequestTooLarge reports whether err was returned by reading the body of a
+// request past its limit.
+func isRequestTooLarge(err error) bool {
+	_, ok := errors.Cause(err).(requestTooLargeError)
+	return ok
+}
+
+// corsSafelistedHeaders may always be sent by a browser, regardless of the
+// configured AllowedHeaders.
+var corsSafelistedHeaders = []string{"Accept", "Accept-Language", "Content-Language", "Content-Type"}
+
+type corsPolicy struct {
+	CORSConfig
+}
+
+// preflight answers preflight requests to a path bound to verbs. Plain
+// OPTIONS requests are answered with only the Allow header.
+func (c corsPolicy) preflight(verbs ...string) http.Handler {
+	allow := strings.Join(verbs, ", ") + ", OPTIONS"
+	methods := verbs
+	if len(c.AllowedMethods) > 0 {
+		methods = c.AllowedMethods
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		w.Header().Add("Vary", "Origin")
+		w.Header().Add("Vary", "Access-Control-Request-Method")
+		w.Header().Add("Vary", "Access-Control-Request-Headers")
+
+		origin := r.Header.Get("Origin")
+		method := r.Header.Get("Access-Control-Request-Method")
+		headers := r.Header.Get("Access-Control-Request-Headers")
+		if c.originAllowed(origin) && corsContains(methods, method) && c.headersAllowed(headers) {
+			c.allowOrigin(w, origin)
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+			if headers != "" {
+				w.Header().Set("Access-Control-Allow-Headers", headers)
+			}
+			if c.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(c.MaxAge.Seconds())))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// actual adds the CORS response headers to requests from allowed origins.
+func (c corsPolicy) actual(next http.Handler) http.Handler {
+	exposed := strings.Join(c.ExposedHeaders, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			w.Header().Add("Vary", "Origin")
+			if c.originAllowed(origin) {
+				c.allowOrigin(w, origin)
+				if exposed != "" {
+					w.Header().Set("Access-Control-Expose-Headers", exposed)
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c corsPolicy) originAllowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	return corsContains(c.AllowedOrigins, "*") || corsContains(c.AllowedOrigins, origin)
+}
+
+// allowOrigin sets the headers allowing origin. Origins listed by name are
+// echoed back, with credentials if AllowCredentials is set. Origins allowed
+// only by "*" get a literal "*", which browsers never send credentials to, so
+// that a wildcard cannot give every website credentialed access.
+func (c corsPolicy) allowOrigin(w http.ResponseWriter, origin string) {
+	if !corsContains(c.AllowedOrigins, origin) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if c.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+// headersAllowed reports whether every header in the comma separated list
+// is allowed.
+func (c corsPolicy) headersAllowed(list string) bool {
+	if list == "" || corsContains(c.AllowedHeaders, "*") {
+		return true
+	}
+	for _, h := range strings.Split(list, ",") {
+		h = strings.TrimSpace(h)
+		if !corsContains(c.AllowedHeaders, h) && !corsContains(corsSafelistedHeaders, h) {
+			return false
+		}
+	}
+	return true
+}
+
+// corsContains reports whether list contains s, ignoring case.
+func corsContains(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
+
 // ErrorEncoder writes the error to the ResponseWriter, by default a content
 // type of application/json, a body of json with key "error" and the value
 // error.Error(), and a status code of 500. If the error implements Headerer,
@@ -218,4 +432,6 @@ func headersToContext(ctx context.Context, r *http.Request) context.Context {
 
 	return ctx
 }
+
+{{.HTTPHelper.DecodeParamFuncs}}
 `
@@ -161,6 +161,12 @@ func DecodeHTTPSumZeroRequest(_ context.Context, r *http.Request) (interface{},
 	var req pb.SumRequest
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
+		if isRequestTooLarge(err) {
+			return nil, httpError{errors.Wrapf(err, "cannot read body of http request"),
+				http.StatusRequestEntityTooLarge,
+				nil,
+			}
+		}
 		return nil, errors.Wrapf(err, "cannot read body of http request")
 	}
 	if len(buf) > 0 {
@@ -211,3 +217,42 @@ func DecodeHTTPSumZeroRequest(_ context.Context, r *http.Request) (interface{},
 		t.Log(gentesthelper.DiffStrings(got, want))
 	}
 }
+
+func TestGenQueryUnmarshalerJSONName(t *testing.T) {
+	field := &Field{
+		Name:                       "PageSize",
+		QueryParamName:             "page_size",
+		JSONName:                   "pageSize",
+		CamelName:                  "PageSize",
+		LowCamelName:               "pageSize",
+		LocalName:                  "PageSizeList",
+		Location:                   "query",
+		GoType:                     "int32",
+		ConvertFunc:                "PageSizeList, err := strconv.ParseInt(PageSizeListStr, 10, 32)",
+		ConvertFuncNeedsErrorCheck: true,
+		TypeConversion:             "int32(PageSizeList)",
+		IsBaseType:                 true,
+	}
+	got, err := field.GenQueryUnmarshaler()
+	if err != nil {
+		t.Fatalf("Failed to generate query unmarshaler: %v", err)
+	}
+	want := `
+PageSizeListStrArr, PageSizeListOK := queryParams["page_size"]
+if !PageSizeListOK {
+	PageSizeListStrArr, PageSizeListOK = queryParams["pageSize"]
+}
+if PageSizeListOK {
+	PageSizeListStr := PageSizeListStrArr[0]
+	PageSizeList, err := strconv.ParseInt(PageSizeListStr, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error while extracting PageSizeList from query, queryParams: %v", queryParams))
+	}
+	req.PageSize = int32(PageSizeList)
+}
+`
+	if got, want := strings.TrimSpace(got), strings.TrimSpace(want); got != want {
+		t.Errorf("Generated code differs from result.\ngot = %s\nwant = %s", got, want)
+		t.Log(gentesthelper.DiffStrings(got, want))
+	}
+}
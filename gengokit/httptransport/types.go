@@ -10,6 +10,14 @@ type Method struct {
 	Bindings     []*Binding
 }
 
+// Route is a single mux path template along with every HTTP verb bound to
+// it by the service's annotations.
+type Route struct {
+	PathTemplate string
+	// Verbs are upper case and in the order they are first bound.
+	Verbs []string
+}
+
 // Binding contains the distillation of information within an
 // svcdef.HTTPBinding that's useful for templating http transport.
 type Binding struct {
@@ -30,7 +38,7 @@ type Binding struct {
 	OneofFields []*OneofField
 	// A pointer back to the parent method of this binding. Used within some
 	// binding methods
-	Parent *Method
+	Parent *Method `json:"-"`
 }
 
 // Field contains the distillation of information within an svcdef.Field that's
@@ -38,6 +46,9 @@ type Binding struct {
 type Field struct {
 	Name           string
 	QueryParamName string
+	// JSONName is the name of this field in the proto3 JSON mapping. The
+	// server accepts it as a query parameter in addition to QueryParamName.
+	JSONName string
 	// The name of this field, but passed through the CamelCase function.
 	// Removes underscores, adds camelcase; "client_id" becomes "ClientId".
 	CamelName string
@@ -73,8 +84,38 @@ type Field struct {
 	// given an identifier of "repeated", meaning it will represented in Go as
 	// a slice of it's type.
 	Repeated bool
+	// IsMessage is true if this field is a protobuf message.
+	IsMessage bool
+	// IsBytes is true if this field is of the protobuf type bytes. Bytes are
+	// base64url encoded in query and path parameters.
+	IsBytes bool
+	// IsStringMap is true if this field is a map<string, string>, which is
+	// set in the query with parameters of the form name[key]=value or
+	// name=key=value.
+	IsStringMap bool
+	// WellKnownType is the Go type of a google.protobuf well-known type, such
+	// as "types.Timestamp", which is converted from and to its proto3 JSON
+	// form in query and path parameters. It is empty for other fields.
+	WellKnownType string
 
 	ZeroValue string
+
+	// NestedFields are the fields of a message typed query parameter which
+	// may be set one at a time with dotted parameter names, such as
+	// "filter.owner.id". Their CamelName is the dotted path to the field from
+	// the request, such as "Filter.Owner.Id".
+	NestedFields []*Field
+	// Parents are the messages containing a nested field, outermost first,
+	// which must be allocated before the field is set.
+	Parents []*ParentMessage
+}
+
+// ParentMessage is a message containing a nested query parameter field.
+type ParentMessage struct {
+	// CamelName is the dotted path to the message from the request, such as
+	// "Filter.Owner".
+	CamelName string
+	GoType    string
 }
 
 // OneofField contains the distillation of information within an []*svcdef.Field
@@ -2,15 +2,26 @@
 // sources:
 // NAME-service/cmd/NAME/main.gotemplate (431B)
 // NAME-service/handlers/handlers.gotemplate (62B)
+// NAME-service/handlers/handlers_test.gotemplate (115B)
 // NAME-service/handlers/hooks.gotemplate (114B)
 // NAME-service/handlers/middlewares.gotemplate (75B)
-// NAME-service/svc/client/grpc/client.gotemplate (3.184kB)
+// NAME-service/svc/balance.gotemplate (8.421kB)
+// NAME-service/svc/client/grpc/client.gotemplate (4.487kB)
 // NAME-service/svc/client/http/client.gotemplate (105B)
-// NAME-service/svc/config.gotemplate (328B)
-// NAME-service/svc/endpoints.gotemplate (4.25kB)
-// NAME-service/svc/server/run.gotemplate (3.27kB)
+// NAME-service/svc/config.gotemplate (2.855kB)
+// NAME-service/svc/endpoints.gotemplate (6.203kB)
+// NAME-service/svc/limit.gotemplate (4.193kB)
+// NAME-service/svc/middleware/middleware.gotemplate (2.166kB)
+// NAME-service/svc/mock/mock.gotemplate (2.815kB)
+// NAME-service/svc/recover.gotemplate (2.238kB)
+// NAME-service/svc/resilience.gotemplate (6.174kB)
+// NAME-service/svc/server/run.gotemplate (6.392kB)
+// NAME-service/svc/testing/testing.gotemplate (2.72kB)
 // NAME-service/svc/transport_grpc.gotemplate (2.962kB)
+// NAME-service/svc/transport_grpcweb.gotemplate (7.293kB)
 // NAME-service/svc/transport_http.gotemplate (106B)
+// NAME-service/svc/transport_http_compression.gotemplate (3.935kB)
+// NAME-service/svc/validate.gotemplate (3.722kB)
 
 package template
 
@@ -119,6 +130,26 @@ func handlersHandlersGotemplate() (*asset, error) {
 	return a, nil
 }
 
+var _handlersHandlers_testGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x73\x00\x8c\xff\x7b\x7b\x2f\x2a\x20\x53\x65\x65\x20\x74\x72\x75\x73\x73\x2f\x67\x65\x6e\x67\x6f\x6b\x69\x74\x2f\x68\x61\x6e\x64\x6c\x65\x72\x73\x2f\x74\x65\x6d\x70\x6c\x61\x74\x65\x73\x2f\x74\x65\x73\x74\x73\x2e\x67\x6f\x20\x66\x6f\x72\x20\x74\x65\x6d\x70\x6c\x61\x74\x65\x20\x63\x6f\x64\x65\x2c\x20\x67\x65\x6e\x67\x6f\x6b\x69\x74\x2f\x68\x61\x6e\x64\x6c\x65\x72\x73\x2f\x74\x65\x73\x74\x73\x2e\x67\x6f\x20\x66\x6f\x72\x20\x63\x6f\x64\x65\x67\x65\x6e\x20\x2a\x2f\x7d\x7d\x0a\x03\x00\x72\xa0\x2d\x8c\x73\x00\x00\x00")
+
+func handlersHandlers_testGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_handlersHandlers_testGotemplate,
+		"handlers/handlers_test.gotemplate",
+	)
+}
+
+func handlersHandlers_testGotemplate() (*asset, error) {
+	bytes, err := handlersHandlers_testGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "handlers/handlers_test.gotemplate", size: 115, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x54, 0xd3, 0x97, 0xb9, 0xf4, 0xe7, 0xdb, 0xcf, 0x9f, 0x3a, 0x48, 0xbf, 0x96, 0xf8, 0x3c, 0x59, 0x74, 0x62, 0x93, 0x35, 0xfa, 0x91, 0x4c, 0x61, 0xa2, 0xdb, 0x73, 0x62, 0xfe, 0x66, 0xb9, 0x7d}}
+	return a, nil
+}
+
 var _handlersHooksGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcb\xb1\x0d\x03\x21\x0c\x46\xe1\x3e\x53\xb8\x46\x51\x3c\x4c\x26\x40\xe1\x8f\x89\x20\xf8\x84\x7d\x15\x62\xf7\x13\x05\xd5\xb5\x4f\xdf\x1b\x83\x03\xbd\x01\xf2\x7e\x9a\xb1\xa0\x89\x96\x9f\x73\x8e\x2d\x55\x74\x63\xc7\xff\xa8\xd1\x61\x9c\x55\xcb\x4b\x94\xbe\xda\x69\x57\xfa\x68\xc2\x93\xee\xdb\xc2\xb6\xf5\x42\x82\x46\x81\xe7\x7c\x5c\x01\x00\x00\xff\xff\x81\x74\x2e\x0e\x72\x00\x00\x00")
 
 func handlersHooksGotemplateBytes() ([]byte, error) {
@@ -159,7 +190,27 @@ func handlersMiddlewaresGotemplate() (*asset, error) {
 	return a, nil
 }
 
-var _svcClientGrpcClientGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x6f\xdb\x38\x10\x3d\x8b\xbf\x62\xd6\x08\x16\x52\xa0\xd0\xf7\x2c\x7c\xa9\xd3\x2d\xba\xd8\xa6\x46\x1a\x74\x0f\x45\x51\x30\xd4\x58\x26\x2c\x93\x2a\x49\x3b\x31\x04\xfd\xf7\xc5\x90\x94\x23\x27\x8e\xdb\x43\x10\x8b\xf3\x38\x1f\xef\x0d\x39\x9c\x4e\x61\x6e\x2a\x84\x1a\x35\x5a\xe1\xb1\x82\x87\x3d\x78\xbb\x75\x8e\xc3\xcd\x67\xb8\xfd\x7c\x0f\xef\x6f\x3e\xde\x73\x36\x9d\xc2\x1d\xda\xad\xd6\x4a\xd7\x11\x00\x8f\xaa\x69\xc0\xec\xd0\x3e\x5a\xe5\x11\xfc\x4a\x39\x58\xaa\x06\x03\xf8\x2b\x5a\xa7\x8c\xbe\x86\xae\xe3\xe9\x77\xdf\x8f\x0c\x70\x23\x3c\x8e\xad\xf4\xdd\xf7\x8c\x20\x0b\x21\xd7\xa2\x46\xa8\x6d\x2b\xa1\xb5\x66\xa7\x2a\x74\x20\xa0\xbe\x5b\xcc\x41\x36\x0a\xb5\x87\xa5\xb1\xe0\x57\x48\x0e\xbe\xa0\xdd\x29\x89\xfc\x56\x6c\xb0\xef\xc1\xa5\x4f\xd6\x8e\xdc\x30\xa6\x36\xad\xb1\x1e\x72\x96\x4d\xa4\xd1\x1e\x9f\xfc\x84\x65\x93\xda\x98\xba\x41\x5e\x9b\x46\xe8\x9a\x1b\x5b\x4f\x09\xfd\xb6\x65\xba\x41\x2f\x2a\xe1\x45\x80\x28\xbf\xda\x3e\x70\x69\x36\xd3\x76\x5d\x4f\xd1\x5a\x63\xdd\x84\x1d\x5b\x6a\x73\xb5\x56\x7e\x4a\x7f\xa8\xab\xd6\x28\x4d\x81\xc9\x97\xb7\x42\xbb\x90\xd4\x1b\xf8\x03\x20\x25\xc5\xb2\xe9\x14\xee\x89\xe6\x54\x32\xcb\x26\x5d\xc7\x3f\x86\xca\x16\xc2\xaf\xe0\xaa\xef\x61\xea\x76\x54\x40\xfb\x00\x64\x5c\xbc\x3b\x36\x4f\x58\x11\x38\xbe\xc5\x47\xb0\xe8\xb7\x56\x3b\x10\x7a\x20\x0d\x1e\x84\x5c\xc7\x26\x38\xa6\x5b\x1a\xad\x51\x7a\x65\x34\x87\x8f\x1e\x94\x23\xf2\xc9\x8f\x45\xd7\x1a\xed\xd4\x83\x6a\x94\xdf\x83\x59\x06\x55\xa4\x68\x1a\xb4\xe0\x0d\x54\x4a\x34\x25\x08\x5d\x41\x23\x3c\x5a\x90\x8d\x71\x58\x46\xd0\xb3\x4f\xb6\xdc\x6a\x49\x39\xe5\xb4\x08\x97\x54\x2f\x9f\x87\xd0\x73\xa3\x75\x09\xa6\x25\x9c\x03\xce\xd3\xf2\xe7\xb0\x50\x40\xde\x3e\xf0\x57\x3d\x40\x5f\x68\x4b\x08\x8a\x14\xd0\xb1\x6c\x27\x2c\x48\x99\xaa\x99\x1b\xbd\x54\x35\x63\x19\x35\xd1\x8f\x12\x96\x70\x3d\x03\x2b\x74\x8d\x87\x38\x1d\xcb\x32\xb4\x96\x0c\xcb\xfc\x4f\x29\x0b\x96\x65\x6a\x49\x0e\xe1\x8f\x19\x68\xd5\x04\x44\x16\x19\xa4\xef\x14\xcc\xf1\xff\xac\x68\x73\xb4\xb6\x84\x89\x14\x5a\x1b\x0f\xa2\x6d\x9b\x7d\xf2\x3c\x21\x47\x3d\xcb\x7a\xc6\x32\x39\x2a\xc4\x51\xa4\x6f\xdf\x8f\xda\xe2\xa8\x52\x0a\x77\xca\xfa\x0e\x97\xc6\x62\x4e\xc9\xa4\xb6\xfe\x2a\x9a\x2d\xba\x7b\xf3\xe1\x6e\x31\xff\x94\xba\x35\x97\x92\xaf\x50\x54\x68\x5d\x51\x94\x14\x3e\xeb\xba\x2b\x78\x54\x7e\x05\x17\x1e\x29\x38\xef\x7b\x96\x8d\x56\xdb\x75\x4d\x64\x92\xe9\xc2\x23\x4f\x67\x32\xf2\x4b\xd1\x08\x19\x39\xbb\x50\x03\x68\x50\xe1\x13\xfa\x95\xa9\x5c\x04\x06\xee\xbb\xee\xde\xfc\x6b\x1e\xd1\xc2\x85\x4a\x22\xbd\x4f\xa7\x01\x86\x63\xc1\x87\x95\xb0\x2b\xf0\x4b\x61\xde\xde\x38\x83\x63\x46\x6e\xf1\x31\x92\x92\xc7\xbd\xc4\x88\x2e\xd3\xef\x49\xd7\x0d\x35\xf5\x3d\xef\xba\x71\xbe\x71\x71\x32\x86\xaa\x97\x8b\xef\xb5\x34\x15\x12\xa9\x23\xeb\x1d\xfe\xdc\xa2\xf3\x03\xe6\x06\x4f\x62\xc2\x09\xc1\x01\x14\x1a\xf6\x83\x09\xe4\x5e\x28\x3e\x98\xef\xf7\xed\x90\x48\xd7\x0f\xd8\xa3\x16\xe1\x9c\xa7\xf5\xe2\x40\x55\x5e\x84\x95\xa4\x08\xea\x2a\xa9\x98\x7e\x0d\x3f\xd8\xd0\xa9\x6e\x27\x0f\x7b\x5d\x47\x80\xb1\x86\x2f\x05\xa4\x0b\x23\xb8\x7b\xc5\xfd\x35\x00\x9c\x13\xb5\x7c\x8e\x9d\xf5\x25\x1d\x10\x16\xef\x76\x22\x07\xa2\x4a\x10\xe9\x62\xe7\x73\x88\x53\xe3\x2c\xb3\x74\x1d\x09\x38\xbe\x2d\x79\xdc\x31\x40\xfe\xa6\xfb\xc5\xaf\x44\xb8\xc9\x76\x68\xbd\x03\x41\x7e\xc3\x1d\x77\xa2\x0e\xb0\x48\x87\xd6\x1b\x10\xb0\x75\x68\xaf\x2a\xb3\x11\x4a\xbf\x01\x8d\x31\x38\x2c\xac\xda\x08\xab\x9a\x3d\xed\x59\x6e\x1b\x50\x1a\x44\xba\x74\xd2\x1d\x77\xb6\x90\xfc\x07\xa4\x43\xcc\xe7\xf1\x7f\x19\x5a\xfc\x2e\x24\xa3\xb4\x47\xbb\x14\x12\xbb\xbe\x80\x7c\xf4\x35\xbe\xe8\x62\xde\xd7\xb3\xe7\x7d\x3c\xbf\xfc\x75\xcb\x15\x87\x0e\x09\x0e\x06\xc5\x0e\xfd\xf3\x42\xb9\x78\x18\x7e\x4b\xb9\x73\xe7\xe6\xa4\x70\x71\x43\x42\xbc\xa5\xdb\xaf\x35\x89\x01\x82\x80\x67\x44\x0e\xa8\xdf\x12\xee\x5c\x1d\xa7\x74\x1b\x32\xf8\x4d\xd5\x7e\x86\x19\x94\xf2\x39\xa1\x58\x30\xbc\x21\xd8\xcf\x57\x72\x31\xbf\x6f\xf1\x68\xda\x81\xf3\x76\x2b\x3d\x05\x4b\x83\x00\xbe\x7d\x77\xde\x2a\x5d\xa7\x93\x39\x9e\x36\x51\x18\xaa\x3b\x7c\x05\x01\x36\xa6\x52\x4b\x85\x2e\xce\xee\xc3\xb3\x80\x26\x69\x88\x76\xb4\x9f\xb6\xe6\x97\xe3\x04\x8a\x58\x2e\x8b\x6c\xce\xfd\xd3\x30\xa7\xbe\xa0\xae\xf2\x35\xee\xc3\x70\x8f\x19\x15\xc7\xce\xba\x43\xad\xc1\xad\x81\x53\x8e\xc3\x40\x36\xc3\x94\x83\x19\x90\x4b\x36\x1e\xd1\x34\xf6\xfa\x14\xff\xdc\xac\x0c\xb9\x0c\xe4\x14\x70\x6a\xea\x8e\xbb\xf3\x45\x76\xd2\x3f\xbd\x6e\x86\x4d\x05\x97\xc3\xcb\x91\x7f\xba\x29\x5e\x22\x42\xf2\x34\x27\x5b\xa1\xc6\xca\x64\xc3\x13\x65\xfd\xfc\x44\x09\xe9\x85\xe9\xa8\x96\xb0\x2b\xc1\x04\x9b\xf4\x4f\x3c\x54\x93\xaf\x0b\x9e\xa7\xdc\xff\x22\x63\x1c\xa4\xd1\xf1\x8c\x1e\x23\xc4\x77\xf8\x2c\x61\x5d\xc2\x2e\x4c\x90\x3e\x3c\x4b\xe2\x23\x27\x42\xc7\xcf\x9c\xcb\x4d\x05\x33\x38\x14\xf0\x8f\x51\x3a\xbf\xdc\x54\xe5\xf3\xd2\x82\xf6\x44\xaf\x9c\xf3\xa2\x18\xdc\x25\x66\xa4\x7f\x8a\xec\xff\x1f\x00\x00\xff\xff\x00\xce\x0e\xa6\x70\x0c\x00\x00")
+var _svcBalanceGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\xdb\x8f\xdb\x36\xb3\x7f\x96\xfe\x8a\xa9\xdb\x13\x48\xad\x96\x9b\x87\x73\x5e\xdc\xf8\xa5\xd9\x0d\x4e\x81\x36\x09\x9a\x3d\xe7\x25\x08\x0a\x4a\x1a\x59\x84\x65\xd2\x25\xe9\xbd\xc0\xf1\xff\xfe\x61\x78\xd3\x65\xbd\x41\xbf\x0f\x7d\x30\x2c\xf1\x32\x1c\xce\xfc\xe6\xaa\xeb\x6b\x78\xab\x5a\x84\x2d\x4a\xd4\xdc\x62\x0b\xf5\x13\x58\x7d\x34\x86\xc1\xcd\x07\x78\xff\xe1\x0e\x6e\x6f\x7e\xbd\x63\xf9\xf5\x35\xfc\x81\xfa\x28\xa5\x90\x5b\xbf\x00\x1e\xc4\x30\x80\xba\x47\xfd\xa0\x85\x45\xb0\xbd\x30\xd0\x89\x01\xdd\xe2\xff\x47\x6d\x84\x92\x6b\x38\x9d\x58\x78\x3e\x9f\x27\x13\x70\xc3\x2d\x4e\x67\xe9\xfd\x7c\xce\xf3\x03\x6f\x76\x7c\x8b\x60\xee\x9b\x9c\xd6\xdf\x45\xb2\xd0\x28\x69\xb9\x90\x06\x7e\xe1\x03\x97\x0d\xb6\x15\x3c\xf4\xa2\xe9\xc1\x1c\x34\xf2\xd6\x80\xed\x11\x1a\x3e\x0c\x06\x54\x07\x1c\x9a\x41\xa0\xb4\x8e\x43\x9a\x22\x62\x42\x1a\x4b\x5b\xdd\x0a\x5a\x6e\x50\xdf\x8b\x06\xa1\x53\x47\xe9\xee\x1e\x07\x5a\x61\x1a\xda\xf9\x54\x01\x97\x2d\xbc\x13\x03\xfe\x1a\x36\xeb\x70\x2e\x11\xec\x84\xf4\x07\xef\x41\x48\xe0\xe1\xfe\xb9\xd8\x1f\x94\xb6\x50\xe4\xd9\xaa\x3e\x76\x42\xad\xe8\xe1\xc9\xa2\xa1\x07\xba\x07\x3e\x5a\x7a\xf4\x33\x42\x5d\x0b\x75\xb4\x62\xa0\x97\x41\x6d\xe9\x4f\x63\x37\x60\xe3\x16\x19\xa5\xfd\xbf\xd5\x42\x6e\x1d\x09\xf3\x24\x1b\xfa\xb7\x62\x8f\xab\x3c\xcf\x56\x5b\x61\xfb\x63\xcd\x1a\xb5\xbf\xde\xaa\xab\x9d\xb0\xd7\xf4\x43\xd9\x1e\x94\x90\xb4\x7b\x27\xec\xa0\xb6\xf0\xc2\xc2\x70\xe8\xe5\x49\xd3\x7e\x6b\xee\x7a\xa8\x89\x83\xa8\xa9\x4f\x5e\x7e\x79\x76\xa8\x61\x75\x3a\xb1\x8f\xbf\xfc\xea\x64\xf1\x91\xdb\x1e\xae\xce\xe7\x55\x5e\x3a\xb5\xbe\x75\xca\x79\xc7\x1b\xab\xf4\x13\x68\xb4\x47\x2d\x83\x06\x83\xda\x3a\xe0\x32\x29\x6c\xa1\xaf\x0a\xcc\xb1\xe9\x81\x1b\x22\xb5\x1a\x54\xc3\x87\x5e\x19\xbb\xfe\x9f\xd7\xff\xfd\x7a\xe5\x35\x46\x9b\x15\x7b\x3b\x28\x83\x1a\x34\x0e\xc8\x0d\x41\x57\x58\x03\x1a\x8d\x3a\x6a\x87\x02\x22\xbd\xc0\x06\x08\x03\x5b\x25\x31\xa2\x6b\xcf\x9f\xa0\x46\x90\x62\x60\xb9\x7d\x3a\xe0\x82\xf5\xee\x28\x9b\x22\xed\xf5\x3a\x2a\xa1\x38\xd4\xec\x74\x62\x41\x1a\xec\x3d\xdf\xe3\xf9\x4c\x6f\xa8\xab\x91\xaf\x0a\x50\x6b\xa5\xbd\x44\x22\xaa\x93\x30\x78\x42\x27\x81\x9a\x98\x27\x09\xc4\xa3\x1c\x84\xe3\x8b\x26\xf4\x91\x08\x2b\xa2\xa4\x1d\x98\xb5\xaa\x85\xac\xc0\xf6\x5a\x1d\xb7\xfd\x44\xb4\x06\x1a\x8d\xd1\xd6\x3b\xaf\x81\x0b\x32\xb3\x8a\x68\x35\x24\x40\x50\x24\x97\x56\x49\x84\x07\x61\xfb\xb9\x26\x82\x0d\x5a\x75\x30\xd0\xa9\x61\x50\x0f\xc4\xea\xc8\x19\x97\x6d\x22\x34\xd5\xb0\x61\x70\xd7\x23\xec\xd1\xf6\xaa\x35\x60\x7a\xae\x71\x32\x4d\xd7\x43\xde\xf4\x89\x52\x3c\x49\x98\x44\xae\xf5\x8c\x8d\x67\x49\x05\x83\x92\x5b\xd4\x30\x08\x63\xcd\x4c\x60\xec\xb9\x84\x69\x37\x11\x9b\xcb\xb5\x13\xda\x58\x47\xc0\x8b\x28\xce\x68\xe8\xf9\x3d\x26\xc7\xc2\xe0\xad\xf3\x35\x1d\x17\x83\x13\x0b\x51\x1a\x6a\x76\xab\xf5\x7b\x75\x1b\x2c\xcf\x10\xd3\x83\xbb\x97\x76\xc8\x92\x2a\xd1\x63\x39\x61\x27\x71\x95\x40\xa4\xc1\xb4\x6c\xe2\x6c\x82\x8a\xe6\xb8\xfb\xbb\x10\x2b\xe1\x94\x67\x35\xac\x37\x50\xfb\x73\xf4\x29\xcf\xb2\x74\xd4\x7a\xbc\x5d\x95\x67\x59\x38\x6a\x0d\x90\x80\x91\x67\x19\xde\x13\x6a\x68\x10\xf6\x7c\x87\x45\xd3\x73\x49\x3c\xde\xd2\x78\x49\xfb\xfe\x3a\x0a\xeb\xe6\x67\x2b\xac\x3e\x36\xf6\x74\x76\x2b\x08\x3c\xdf\x5e\x11\x50\xb1\x8e\x2b\xf6\xfc\xf0\xd9\x9b\xd3\x97\x1f\x03\xef\xad\x17\x01\x11\x3c\x3b\x97\x93\xa4\x64\xc0\xa0\x6c\x17\x8a\xb4\x3d\x3e\x39\x3d\x02\x37\x60\x94\x92\xf4\xcf\x81\x8e\x96\x38\x80\x30\x8e\x86\xc6\x2d\xa9\x5a\x63\x9b\x67\x5e\xf5\xeb\xcd\x25\x1e\xf3\x6c\xab\xa0\x66\x1a\x1b\x14\xf7\x58\xb8\xa5\x65\x3e\x8a\x92\xfd\x11\x08\x15\x35\xf3\x12\x2b\xf3\xec\xcd\x95\x5b\x97\xe7\x99\xc7\x1c\x24\x60\x9c\xf2\xec\x74\xba\x02\xcd\xe5\x16\xe1\x07\x41\x1a\x4a\xba\xfc\xdd\xdb\xc4\xf9\x9c\x67\xd9\xe9\xf4\x83\x08\xca\x8d\x7b\xd7\x50\xb3\xe8\xda\x0b\xc2\x50\x81\x23\xdd\x12\xe2\x14\x8b\x63\x70\x0a\x88\x07\x64\x17\xc8\x81\x93\x3f\x31\x83\xb2\xa5\x33\xcf\x15\xbc\xaa\xf3\xb3\xf3\x4a\x41\xf2\x1a\x76\x88\x07\x33\x46\xd5\x4e\xe9\xb9\x79\x4e\x0c\x86\xcb\x19\x82\x83\xdb\x4c\x94\xbc\x44\x09\x97\x97\x11\x9f\x47\x18\x02\xcc\x31\x9f\x07\x24\x12\x8a\x66\x18\xcc\x1d\x00\xc1\x01\x70\xa6\xb4\xdc\xe1\xee\xe2\x04\xd9\x3e\x0d\x03\x50\x30\x65\x1f\xc8\x15\xe4\xd9\xfe\xe8\x5f\x7f\x3f\x5a\x7c\x74\xf0\x88\x3c\x1a\x88\xfe\x89\xe2\x31\xb6\x13\x9c\x91\xf1\x8f\x92\x11\x32\x3c\x99\xe0\xb1\x1c\x19\x25\x07\x0a\x73\x0e\x3b\x0e\x81\x5b\x34\xa3\x00\x0c\x7c\xfe\xe2\xb1\x9e\x47\x3b\x70\x66\xf0\x92\x05\xcc\x95\x13\x06\x41\x4c\xdd\xeb\x22\x80\xce\x95\x10\x77\x8c\xaa\x88\xa0\x31\x23\x92\x88\x15\x72\x21\x24\xa3\xe4\x4f\xc2\xc9\xf1\x26\x3d\x97\xed\xe0\x4d\x0d\x82\x72\x42\xa4\x8e\x57\xd3\x70\x94\x56\x0c\x6e\x2c\x9c\xae\x89\x53\x47\xbb\xad\xa2\x2f\xa7\x98\xe1\x4c\x25\xc5\xe4\xf0\xea\xa8\xd2\x06\x7f\x54\x1b\xbc\x66\x51\x43\x94\x89\x2e\xa3\x60\xbd\x51\x3a\xf1\xbe\xb9\x4a\xba\x76\x2e\xb0\xc5\x0e\xb5\x3b\x09\x8b\x9a\x11\x2a\xca\x3c\x23\x10\x93\x3b\x34\x48\xb9\x16\x2d\xcb\x1a\x6e\xc2\x4d\xc8\x22\xdf\x5c\x45\x5b\x5e\xe7\x59\x96\xd5\xec\x78\x68\xb9\xc5\xc2\x8d\x95\x34\x24\xba\xc0\xe7\x77\x1b\x4a\x11\x1c\x0d\xf2\x65\xca\x8c\x2e\x22\xcb\x82\x63\x71\x4b\xe8\xfd\x1c\x4f\xa2\x03\x08\xbb\x8e\xbc\x37\xd2\xdc\x4d\x9f\x83\xa0\xfd\x81\x21\x62\xcf\xc2\xe7\x52\xd0\x66\x62\x81\x8e\x3f\x1f\xd3\x1d\x2b\x2e\x64\xda\xde\x85\xf2\xe5\xae\x79\xcc\xc4\xd6\x87\xe5\x71\x9e\x70\xe4\x82\x1c\xb6\x5e\x30\x44\x8b\x6c\xe1\x28\x77\x52\x3d\xc8\x0a\x8c\x72\x24\x07\x6e\x2c\x28\x39\x32\x42\x8b\x76\x78\xb0\x17\x55\x36\x95\xe4\x18\x4c\x48\x7e\xa2\xf3\xe7\x50\x24\x9d\x8a\x35\xa5\xe4\xbf\xa9\xed\x16\x35\xfb\x4d\x6d\x8b\x15\x6a\xbd\xaa\xc6\xf5\x65\x3e\x8a\xf1\x9c\xe7\x59\xe0\x64\xbd\x79\x16\x53\x6a\xa5\x86\x72\xb4\xb6\x0b\x2b\x7e\x9c\x1b\x4b\xc0\xcb\x9f\x55\x92\x0d\x21\xc4\xfb\x6f\x7f\x7e\xf4\x60\xc6\xb1\xeb\x8f\xfe\x1c\x17\x7f\x81\x0d\xd5\x4d\x98\x3b\xcc\x34\x15\xa8\x1d\xed\xaf\x59\xe0\x60\x5c\xf8\x33\x4d\x11\x85\xec\xd9\x14\x6c\xa0\x71\x13\x4a\x5a\x21\x1d\x31\x82\x92\xe8\xe0\xcf\x48\xf0\x5b\xe4\xe6\xbb\xfc\xca\xca\x1b\x85\xcf\x45\x89\x40\xcd\x82\xfb\x4d\x09\x09\x09\x95\x94\x32\x57\xc7\x65\x7d\xc4\x3d\xab\x51\x4c\x15\x44\x2d\x79\xfd\x5c\xe6\x63\x7e\xcb\x57\x73\xd9\x9f\x92\x7b\x5a\xa7\xf0\x66\x3e\x74\x45\x13\xf2\x81\x70\x87\x75\xf8\x77\xf6\x33\x71\xae\x51\xb9\xd1\xc9\x56\xf0\xba\x82\x01\x65\xd8\x6f\xca\xa0\xdb\xe7\x8a\x0d\x0b\x60\x9a\x34\x19\xd8\x00\x3f\x1c\x50\xb6\x49\x42\x66\xbc\x6d\xe9\xce\xa6\x18\xc1\x3e\x39\x8f\x6e\xc6\x55\x65\x9e\x67\x35\xdb\x1f\xd9\x6f\xaa\xd9\x15\x2e\xa3\x90\x38\x43\x41\x9e\xa5\xc7\x0a\x6a\x96\x76\x42\x52\xec\x78\x92\x09\xc4\xfe\x4f\x0e\x9e\xdc\xfc\x12\x15\x34\xe3\x3d\xdc\x41\xee\x12\x5d\xb0\xce\x89\xb8\xbf\x7e\x85\x86\x05\x5f\xbf\x99\x28\x78\xa1\xa7\x00\x81\xf5\x26\xad\xf6\x31\xa1\x28\x7f\xfe\xc7\xb0\x31\xf1\x7c\x51\xcf\x29\x5b\xe7\x32\xe9\x7e\x56\x11\xa5\xc1\x83\x68\x76\x3e\x65\xa7\x27\xe8\xb4\xda\xc7\xe2\xee\x45\x9f\x19\xea\xa6\x8b\x2e\x2a\x12\x2e\x3c\x39\x4a\xb4\xbe\x95\x66\x5d\x18\x22\xdc\xdc\x73\x0d\x12\x1f\x29\x31\xb0\x29\x0d\xa4\xd3\x8a\xc6\x3e\x42\x68\x06\xb0\xb7\xfe\xbf\x02\x8d\x7f\x1d\xd1\xb8\xd5\xa8\x3b\xde\xe0\xe9\x5c\x42\x31\x79\x8b\x45\x23\xd1\x9e\xa3\x89\x1c\x0b\xa1\x7a\x82\x9b\x12\x36\x1b\x78\xed\x96\x2e\xc0\x92\xe2\x0d\xe9\xbb\x7a\x5e\xb8\x44\xe3\x9c\x3b\xa9\x09\xe9\xcf\x74\xa9\xff\x5a\x9e\xf7\xe5\x4b\x9e\x65\x34\xf3\xd3\x4f\xf9\xf2\xc8\xe4\x9b\x81\x04\x5a\x34\x29\x89\x35\x25\xc9\x22\xdd\xbd\x1c\x41\xe0\x00\xf6\xac\xb8\x9c\xaa\x50\x4f\xa2\xdc\x32\x3c\xf2\x61\x58\x56\xf7\xa6\x0a\x78\x8a\x74\x42\x96\x41\x75\x78\x40\x87\x08\x79\x82\x36\x7f\xb3\xc4\xe3\x9d\x45\xfd\xc0\x75\x6b\x2e\xc2\x28\x18\x49\x38\x23\x20\x82\x0c\xc6\x0d\x90\x11\x53\xca\xc3\x6e\x94\xcf\xe5\x5d\xba\x42\x29\xa3\x8b\x88\xae\xb0\xb1\x21\x87\xba\xc1\x58\xac\x8c\x46\xa1\x11\x8c\xa5\x4e\x5c\xc8\x81\x5a\x27\xf6\x78\x5d\xcd\xc6\x3d\xd3\xba\x24\x64\x28\x3e\xf9\x20\xa7\x4c\x89\x08\x65\x45\xf9\x33\x50\x45\x79\xce\xdd\xd4\xcb\x7e\xca\xc1\xc9\xe7\x39\xcf\xd4\x1f\x02\xe8\xc4\x31\x05\x22\xee\xce\x84\xdf\x8b\x8e\x68\x1a\x31\x1c\x2a\xdd\xca\x17\x9d\x51\x33\xf1\x46\xaf\x5e\x91\xdc\xe7\xd4\xdc\x80\x5b\x15\xe9\x91\xdb\x29\x93\x71\xd2\xc4\xdc\x07\x99\x0f\x5d\x92\xf8\xd4\xe5\x98\xe4\x88\x42\x4f\xc0\xeb\xff\x79\x88\x82\x97\x8b\xf5\x72\xcc\xb8\x63\xf6\x13\xdf\xe7\x31\x9d\x4d\xbc\x4f\x0a\xea\x91\xe5\x38\x43\xa6\x13\xef\xf1\xcf\xd4\x9a\xbf\xf3\x1d\x5e\x98\x08\x17\x5b\x96\x8e\x41\x70\x0b\xff\x0f\x83\xda\xfa\xe4\x95\x4c\xe9\xa8\xa9\xf5\xd6\xa5\xf6\x56\x5a\x9c\xda\x7a\xa3\xb9\x92\x16\x1e\x5c\xe2\x1a\x04\xd9\x70\x29\x95\xa5\x8e\x5c\xe8\x62\xb1\x9c\x3c\xec\xf2\xc0\x0d\xf8\x8e\x27\x45\x9e\x2d\xea\x77\x64\x59\xa4\x9b\x62\x87\x4f\xf7\x7c\x30\xc0\x18\x9b\xb9\xd8\x64\x9d\x83\xda\xb2\x8f\x5a\x48\x3b\xc8\xb8\x98\x31\x36\xa2\x83\xa0\x7d\xf6\x2d\xbb\x59\x57\x98\x6a\x94\x45\xe5\xfb\x3c\xdc\x84\x7c\x34\xf5\x8a\x2b\x4a\x98\x89\xd4\xc1\xf5\xac\x24\x8e\x22\x58\xb6\x35\x19\xfc\x32\x70\xb9\x73\xab\xe8\xa4\x36\x3c\x19\xcb\xb5\x25\x6f\xe6\xca\xd0\xd5\xf7\xab\x98\x9f\x8b\xad\x54\x9a\xf2\xf9\x77\x4a\x03\x77\x61\x3f\xb4\xd0\x5a\xe5\xf2\x7e\x1b\x0a\xd1\x0a\x8e\x06\x89\xf1\x77\xe2\x11\xdb\x65\xdd\x3e\xbf\xe4\x58\x31\x1e\xa8\xa3\x1b\x8b\x56\xf2\xd0\x17\x2b\xec\x45\x71\x3d\xa9\xb8\x43\x89\xed\xaa\x06\x37\x98\x8a\xf9\xd0\xa0\x31\xae\x00\x8e\xc5\x5c\x98\xfd\x92\xe8\x7b\xa0\xbd\xc7\x87\x39\x83\xc9\x31\x2e\x18\x0f\xaa\xa0\x06\x3d\x70\x0b\xc4\xfd\xbc\xa3\x48\xdf\x10\x80\x6f\xb9\x90\x54\x4e\x68\xea\xfb\x59\xd4\xf7\x7c\x00\xab\x48\x58\xa2\x49\x85\x3b\x83\x4f\x74\x5f\x61\xe1\xa1\x47\x09\xe4\x37\x83\xe7\x5f\xb2\x53\x4c\xa4\x54\x4d\x28\x8a\x3d\xb2\x9b\xa3\xe6\x56\x28\x59\xc2\x8f\x73\x56\xc9\x0b\x90\x8d\xce\x46\xc9\x75\x11\x31\xea\x8f\x81\x67\x9f\x2a\x57\xab\x0e\xeb\x6f\x76\xd5\x82\x2c\xd7\x63\x79\xf3\x92\x44\x43\x5b\x4d\x30\xa7\x92\x0d\x08\x46\x22\xa1\x18\xb0\x55\x20\xd8\x03\xb7\x4d\x5f\xc4\x2b\x8c\xf6\xf0\x4a\x04\x55\xc4\xfe\x17\x88\xfd\x61\xc0\x3d\xc5\x86\x99\x35\xc4\xe0\x28\x16\xf7\x2d\x21\x75\xce\x9a\x1e\x16\xdc\xb9\x68\x28\xa6\x01\xc9\x57\xf2\x62\x16\x5a\x32\xc1\xe2\x45\x3f\x37\x3d\x15\x10\xf1\x52\x04\xc4\xa6\x87\x37\x57\xe0\xef\x15\x78\x9d\x44\xd2\x7f\x9b\xdb\x49\x44\xfd\x8f\xf9\x6d\x71\x40\x8b\xc5\xc8\x76\x05\x4d\x5f\x06\xe6\x3c\xb8\x2e\xb3\x55\x91\xab\x8f\xb9\x0b\xe9\x87\x9e\x27\xd8\x7e\x99\x6d\xa2\x5a\x04\xf6\x96\x09\x47\x68\x8c\x08\x46\x76\x5c\xc2\x39\x72\xe2\x74\x0e\xe3\xd7\x35\x67\x3d\x73\xeb\xa8\x9c\x6d\x74\x4f\x23\x4f\xb1\x9d\x1a\x1b\xad\xc1\x87\xa3\x7c\x9e\x7a\x8b\x89\x0b\x4a\x5d\xa2\x19\xdf\xe4\x55\x89\xa9\x03\xb6\x2f\x5f\x6d\x0e\xcd\xa5\x75\x9d\xf2\xcc\xf5\x74\xdd\xf0\x7b\x7c\xb8\xa3\x32\x41\x4f\x91\xec\x55\x64\x99\x17\xd1\xcb\x7d\xa1\x37\x57\x5e\x42\xf3\x6e\x4d\x98\xb2\xec\x2d\x8d\x53\xd7\x21\x4b\x0d\xa4\xd1\x86\xe6\xa0\xa0\x04\xe6\x3b\xc3\xf7\xe8\x4c\xb0\x08\xfd\x1a\x0f\xd1\x32\x24\x43\xd1\x0e\xdd\x24\x9d\x48\x6c\x35\xfd\x98\x3b\x8d\xe8\x89\x69\x92\x03\x7a\x5a\x1f\x32\x9b\x6c\x81\xbd\xe8\x3a\x89\xb3\x79\x56\x73\x1f\xfa\x86\x73\x3d\x35\x47\xad\x51\xda\x81\x74\xfe\x37\x70\x46\x64\x8b\x32\x19\x04\xb1\x56\xa7\xe6\x82\xff\xce\xc9\xfe\x40\xde\xd2\xb6\x42\x30\xf2\x64\x65\x7e\xa1\xbf\x10\xdc\x4b\xa4\x73\xba\xd5\x7a\x4d\x64\x5c\x96\xe1\x72\xe8\x4b\xdd\x53\x9f\xa7\xd2\x37\x57\xf6\x1e\x1f\x3e\x51\xb6\x40\x99\x2f\x7d\x7b\xa5\x01\x3a\x98\xde\x63\xc5\x6f\x18\x2d\x09\x39\x37\xc5\x53\xda\x1e\x3e\xb4\xb2\x3b\x2d\xf6\x9f\x0e\xbc\xc1\xc2\xb0\x3b\x7c\xb4\x45\x19\x8b\x2c\x5a\xb8\xd9\xc0\x6a\x05\x5f\xbf\xa6\xe5\xff\xcb\xcd\x47\x8d\x9d\x78\x2c\x88\x50\x05\xab\xef\x57\xe5\xe5\x42\xfa\x9b\x8d\x04\xda\x5c\x4e\xd3\xb8\x24\x81\x28\x64\x33\x7e\xb5\x31\x51\x99\x09\x4b\xa0\x91\xbe\xb7\xd2\x87\x27\xa4\xaf\x4e\xc0\x5d\xae\x50\xfb\xf8\x4f\xea\xa3\xa5\x23\x81\x0a\x94\x76\xed\x3d\xe0\x83\xd8\x45\xbd\x8e\xd0\xe4\x15\xd4\x13\xef\x46\x6d\xb3\x90\xa6\x16\x9c\xdd\xa6\xd4\xba\xa4\xf2\xbf\xa8\x67\x23\x13\x2d\x76\x7c\x30\xe8\x2e\x25\x3a\xe0\xcb\xce\x5e\x58\xe4\xc6\xe9\xa7\x74\xe1\x0a\xd7\x7a\x3a\x30\x15\x49\xf8\x3a\xce\x6e\x10\x0f\xb7\x7f\x1d\xf9\x50\xf0\xe4\x22\x5d\x41\x92\x5e\xca\xfc\x9c\xff\x6b\x00\x4f\x47\x1b\xb0\xe5\x20\x00\x00")
+
+func svcBalanceGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcBalanceGotemplate,
+		"svc/balance.gotemplate",
+	)
+}
+
+func svcBalanceGotemplate() (*asset, error) {
+	bytes, err := svcBalanceGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/balance.gotemplate", size: 8421, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe2, 0xb6, 0xd2, 0xbc, 0x28, 0xc3, 0x6d, 0x6f, 0x63, 0xc4, 0x10, 0x4c, 0xad, 0x1, 0xb6, 0x4f, 0x2c, 0xdb, 0x2b, 0xc6, 0xf5, 0xf6, 0x8b, 0x84, 0xee, 0xde, 0x94, 0x56, 0x3c, 0x5b, 0x1e, 0x8d}}
+	return a, nil
+}
+
+var _svcClientGrpcClientGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x5f\x6f\xdb\x38\x12\x7f\x16\x3f\xc5\x9c\xd0\x3b\xc8\x81\x4a\xbf\x77\x91\x97\x3a\xed\xa2\x87\x6b\x1a\x64\x73\x7b\x0f\x8b\xc5\x82\xa1\xc6\x32\x61\x99\x54\x49\xda\x4e\x20\xe8\xbb\x1f\x86\x22\x65\xd9\x71\xdc\xa0\xfb\x10\x44\xe4\xfc\xe1\xcc\xfc\x86\xbf\xa1\xe7\x73\x58\x98\x0a\xa1\x46\x8d\x56\x78\xac\xe0\xf1\x19\xbc\xdd\x3a\xc7\xe1\xe6\x1b\xdc\x7e\x7b\x80\x4f\x37\x5f\x1e\x38\x9b\xcf\xe1\x1e\xed\x56\x6b\xa5\xeb\x41\x01\xf6\xaa\x69\xc0\xec\xd0\xee\xad\xf2\x08\x7e\xa5\x1c\x2c\x55\x83\x41\xf9\x77\xb4\x4e\x19\xfd\x01\xba\x8e\xc7\xef\xbe\x9f\x08\xe0\x46\x78\x9c\x4a\x69\xdd\xf7\x8c\x54\xee\x84\x5c\x8b\x1a\xa1\xb6\xad\x84\xd6\x9a\x9d\xaa\xd0\x81\x80\xfa\xfe\x6e\x01\xb2\x51\xa8\x3d\x2c\x8d\x05\xbf\x42\x72\xf0\x1b\xda\x9d\x92\xc8\x6f\xc5\x06\xfb\x1e\x5c\x5c\xb2\x76\xe2\x86\x31\xb5\x69\x8d\xf5\x50\xb0\x2c\x97\x46\x7b\x7c\xf2\x39\xcb\x72\x65\x72\xc6\xb2\xbc\x36\xa6\x6e\x90\xd7\xa6\x11\xba\xe6\xc6\xd6\x73\x3a\x3b\x7f\x55\x32\xdf\xa0\x17\x95\xf0\x22\xa8\x28\xbf\xda\x3e\x72\x69\x36\xf3\x76\x5d\xcf\xd1\x5a\x63\x5d\xce\x8e\x25\xb5\x79\xbf\x56\x7e\x4e\x7f\xa8\xab\xd6\x28\xed\xf3\x57\x35\x5c\x95\xb3\x8c\xce\xf1\x56\x68\x17\xe2\x7e\x45\x73\x54\x88\x01\xb3\x6c\x3e\x87\x87\x95\x72\x10\xab\xc2\xb2\xbc\xeb\xf8\x97\x90\xfc\x9d\xf0\x2b\x78\xdf\xf7\x30\x77\x3b\x99\xb3\xac\x7d\x04\x12\xde\x7d\x3c\x16\xe7\x6c\x16\x60\xb8\xc5\x3d\x58\xf4\x5b\xab\x1d\x08\x9d\xea\x0a\x8f\x42\xae\x87\x3e\x39\x46\x44\x1a\xad\x51\x7a\x65\x34\x87\x2f\x1e\x94\x23\x7c\xc8\x8f\x45\xd7\x1a\xed\xd4\xa3\x6a\x94\x7f\x06\xb3\x24\x01\x48\xd1\x34\x68\xc1\x1b\xa8\x94\x68\x4a\x10\xba\x82\x46\x78\xb4\x20\x1b\xe3\xb0\x1c\x94\x0e\x3e\xd9\x72\xab\x25\xdc\xe2\xbe\xa0\x83\xe0\xaa\xb6\xad\xe4\x8b\x70\xf4\xc2\x68\x5d\x82\x69\xe9\x6c\x07\x9c\xc7\xed\x6f\x61\x63\x06\x45\xfb\xc8\x5f\xb4\x09\x95\x07\x6d\x09\x01\xad\x19\x74\x2c\xdb\x09\x0b\x52\xc6\x6c\x16\x46\x2f\x55\xcd\x58\x46\x7d\xf6\x57\x09\x4b\xf8\x70\x0d\x56\xe8\x1a\xc7\x73\x3a\x96\x65\x68\x2d\x09\x96\xc5\xbf\xa4\x9c\xb1\x2c\x53\x4b\x72\x08\xff\xb8\x06\xad\x1a\x72\x9a\x65\x43\x05\x69\x1d\x0f\x73\xfc\x7f\x56\xb4\x05\x5a\x5b\x42\x2e\x85\xd6\xc6\x83\x68\xdb\xe6\x39\x7a\xce\xc9\x51\xcf\xb2\x9e\xb1\x4c\x4e\x12\x71\x74\xd2\x1f\x7f\x1e\xb5\xc5\x51\xa6\x74\xdc\x39\xe9\x47\x5c\x1a\x8b\x05\x05\x13\x3b\xff\x77\xd1\x6c\xd1\x3d\x98\x5f\xef\xef\x16\x5f\x63\x27\x17\x52\xf2\x15\x8a\x0a\xad\x9b\xcd\x4a\x3a\x3e\xeb\xba\xf7\xb0\x57\x7e\x05\xef\x3c\xd2\xe1\xbc\xef\x59\x36\xd9\x6d\xd7\x35\xdd\x39\x12\xbd\xf3\xc8\xe3\xb5\xa5\xad\xa0\x18\x34\x87\x9a\xbd\x53\x49\x29\xa1\xf0\x15\xfd\xca\x54\x6e\x50\x0c\xb5\xef\xba\x07\xf3\x1f\xb3\x47\x0b\xef\x54\x04\xe9\x53\xbc\x29\x90\xae\x0c\x4f\x3b\xc1\x8a\x12\xa6\x7f\x17\x0c\xaf\xe1\xb8\x22\xb7\xb8\x1f\x8a\x12\xca\x31\x54\x44\x97\xf1\x3b\xef\xba\x94\x53\xdf\xf3\xae\x9b\xc6\x3b\xf8\xcd\xa7\xaa\xea\x74\xf3\x93\x96\xa6\x42\x2a\xea\x44\x7a\x8f\xdf\xb7\xe8\x7c\xd2\xb9\xc1\xb3\x3a\xe1\x86\x60\x52\x0a\x0d\xfb\xab\x21\xf7\x94\x53\x12\x3f\x3c\xb7\x29\x90\xae\x4f\xba\x47\x2d\xc2\x39\x8f\xfb\xb3\xb1\x54\x05\xf5\x53\xe8\x28\x2a\x15\xea\x2a\xa2\x18\xbf\xd2\x07\x4b\x9d\xea\x76\x72\xb4\x75\x1d\xcb\xba\x6e\x8a\xe1\x29\x80\x44\x18\xc1\xdd\x98\x4c\xb2\xfd\x00\x00\x70\x01\x9b\xf2\x70\x76\xd6\x97\x74\x41\x58\x9f\x78\xe7\xa3\x68\x84\x96\x58\x1d\xf8\x67\xa4\x1f\xe2\x8d\x30\x83\x56\x08\x4a\x3b\x4f\x7a\x8e\x48\x25\x2d\x2c\x28\x0d\x74\xe7\x4a\x72\x66\xcd\x56\x57\x60\xcd\xa3\xd2\x25\xa0\x90\x2b\xf0\x2b\x6b\xb6\xf5\x0a\xc4\x84\x5d\x02\x07\x61\x35\xf4\x35\x7d\xa7\x2b\x47\xac\x24\x22\x27\x90\x3b\x69\x31\x4c\x49\xe1\x88\x00\x89\x21\x83\x49\xe4\x84\x81\xc5\x84\x06\x65\xf8\x82\x58\x2c\xf0\x5b\xe0\x33\x30\x5a\x22\x54\x46\x63\x38\x84\x7c\x11\xc1\xc5\xac\x4a\xd8\xaf\x94\x5c\x81\xf3\xa6\x75\xb0\x34\x4d\x63\xf6\x94\xe4\x21\x27\x72\x1c\x1c\xb9\x13\x62\x74\x61\xdc\x3e\x1c\xed\xd1\xa9\x42\x8f\xd6\xc4\xc5\xa2\x71\x31\x92\x6a\x08\xe5\xe0\x5a\x1b\x68\x8c\xae\xd1\x92\xa3\x46\x39\xef\x40\x79\x0e\xff\x75\x08\xae\xe2\x9f\xd5\x13\x56\x5f\x46\x65\xe2\x43\x01\xce\x0b\xaf\x64\x50\x9e\x96\xde\x95\x60\x82\x17\xea\xa1\x5b\xdc\x7f\x56\x0d\x9e\x9a\x06\x9b\x35\xb6\x9e\x60\x12\xf1\xc1\x90\xb8\x3d\xe1\x5e\x1c\xa2\x73\x15\x1f\x5d\x94\x47\xd8\x0c\x5c\xc8\x6f\xc6\xad\x9f\x9d\x01\x23\x5a\xd3\x71\x40\x2f\xa3\x15\xca\x75\x28\x77\xf2\xfb\x18\xa8\x14\x84\x7e\x1e\x73\x06\x8d\x58\x05\x50\x36\xe7\x67\xc8\x0f\x46\x48\x1c\x19\xe3\x14\xf9\xe5\xe2\x04\xf9\x3b\x63\xa4\x1c\xf0\x0f\x13\x8b\x00\x7a\x59\xec\x12\x08\x88\x71\x0d\xce\x5b\xa5\xeb\x9f\xa9\xde\xc0\xab\x29\xb3\x11\xa7\xd1\xf5\x11\x92\x9c\xf3\x37\x0c\xcf\xd3\xd4\x97\xc7\xb9\x93\x3f\xf8\xe7\xf7\xbc\x1c\x91\x89\x05\x18\xb3\x8f\xc1\xa4\x27\xc4\xd8\x2d\xaf\x1f\x4f\x6a\x43\x6b\x14\xb3\xd7\xc2\x89\x87\x44\xd1\x58\xe9\x90\x3e\x71\x5a\xd6\xcf\xd8\x0b\x69\xac\xd7\x81\xf3\x68\x20\xc0\x30\x99\x60\x18\x11\xec\x32\xef\x0e\x8f\xe9\x8b\xd3\x24\x5c\x7b\x18\x67\x5f\x78\x21\xf2\xc1\x22\xa9\x7c\xa6\x7b\xe7\x57\x22\xbc\xde\x76\x68\xbd\x03\x41\x7e\xc3\xbb\xee\x0c\x77\x83\x45\xea\x30\xa2\x17\xd8\x3a\xb4\xef\x2b\xb3\x11\x4a\x9f\xa3\xf9\xf4\xec\x43\x0e\x77\x56\x6d\x84\x55\xcd\x33\xd9\x2c\xb7\xcd\x70\xf3\x87\x52\xc5\xbb\x7f\x31\x91\xe2\x2f\x88\x0f\x17\xbe\x18\xfe\x97\x61\xac\xdf\x87\x60\x94\xf6\x68\x97\x42\x62\xd7\xcf\xa0\x98\xac\xa6\xfd\x38\xc4\x1d\x5b\x31\xd8\xf1\xe2\xea\xc7\x63\xf6\x00\x5d\x70\x90\x10\x1b\x67\xe6\x09\x72\x9f\xf4\x9b\x91\xbb\xf4\x56\x38\x0b\xdc\x60\x10\x35\x5e\xc3\xed\xc7\x98\x04\xf3\x30\x1f\x2e\x81\x1c\xb4\xde\x04\xdc\xa5\x3c\xce\xe1\x96\x22\x78\x23\x6a\xdf\xa9\xf7\x53\x3c\x67\x10\x0b\x82\x57\x00\xfb\xfe\x02\x2e\xe6\x9f\x5b\x8c\xf1\x0f\x2f\x7c\xa2\xb8\xad\xf4\xd4\x22\xf1\xf1\x0b\x7f\xfc\x39\xd0\x5e\xbc\x99\xd3\x39\x32\x00\x43\x79\x87\x55\xb8\x38\x1b\x53\xa9\xa5\x4a\x63\x79\xfc\x29\x44\xcc\x1f\x4e\x3b\xb2\x27\xd3\xe2\x6a\x1a\xc0\x6c\x48\x97\x0d\xd7\x60\xe1\x9f\xd2\xdb\xfc\x37\xd4\x55\xb1\xc6\xe7\x30\xcc\x12\x11\x1f\x39\xeb\xc6\x5c\xc9\xb6\x30\x70\xce\x31\x65\x96\x99\xf4\xb2\x87\x6b\x20\x97\x07\xbe\xa2\x02\x65\x3d\xa5\x4a\x3e\xe0\xd2\xef\x03\x32\x1c\x8b\x33\x3b\x79\x57\x0f\x81\x45\x3c\x42\x77\x9e\x44\x27\xfd\xd3\xcb\x66\xd8\x54\x70\x95\x7e\x49\xf3\xaf\x37\xb3\x53\x8d\x10\x3c\xcd\xd4\x56\xa8\x29\x32\x59\x9a\xa9\xeb\xc3\x4c\x0d\xe1\x91\x3e\x11\xf9\xae\x04\x13\x64\xd2\x3f\xf1\x90\x4d\xb1\x9e\xf1\x22\xc6\xfe\x0b\x09\x83\x6a\x36\x38\xbe\xa6\x1f\x60\x54\xef\xb0\x2c\x61\x5d\xc2\x2e\x10\x3e\x8d\x0f\x1a\xa0\xe4\x33\xc8\x8e\xc6\xc3\xd5\xa6\x82\x6b\x18\x13\xf8\xb7\x51\xba\xb8\xda\x54\xe5\x61\xeb\x8e\x6c\x8a\x60\x49\x53\x66\x96\xdc\xc5\xca\x48\xff\xc4\xb2\x9e\xf5\xec\xff\x03\x00\xd7\x2a\xa2\x0f\x87\x11\x00\x00")
 
 func svcClientGrpcClientGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -174,8 +225,8 @@ func svcClientGrpcClientGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/client/grpc/client.gotemplate", size: 3184, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x63, 0x72, 0x1f, 0xe5, 0x3a, 0x45, 0x1, 0x91, 0xd8, 0x5b, 0xa8, 0x47, 0x45, 0x45, 0x98, 0xee, 0x0, 0xf5, 0xc1, 0x3c, 0x43, 0xf0, 0x86, 0x3c, 0xec, 0xbe, 0x2d, 0x84, 0xed, 0x1a, 0x17, 0x6c}}
+	info := bindataFileInfo{name: "svc/client/grpc/client.gotemplate", size: 4487, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc, 0x95, 0x84, 0xc, 0x94, 0x4d, 0x4c, 0x39, 0x47, 0x44, 0xfc, 0x5, 0x8e, 0x78, 0xef, 0x7e, 0x8f, 0xb2, 0x17, 0xd2, 0x14, 0x3d, 0xc5, 0x64, 0xae, 0x54, 0xb9, 0x82, 0x41, 0x43, 0x3e, 0x69}}
 	return a, nil
 }
 
@@ -199,7 +250,7 @@ func svcClientHttpClientGotemplate() (*asset, error) {
 	return a, nil
 }
 
-var _svcConfigGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xd0\xc1\x4a\x03\x41\x0c\x06\xe0\xf3\xe6\x29\x42\x4f\x7a\xb0\xf3\x0c\x52\xb5\x1e\x4b\xe9\x0b\x4c\x67\xb2\xb3\xa1\x36\x33\x26\x99\x82\x88\xef\x2e\xab\xac\x20\x0a\x0d\xe4\xf2\xf3\x25\x81\xb4\x98\x4e\xb1\x10\xda\x25\x01\xf0\xb9\x55\x75\xbc\x81\x61\x72\x6f\xae\x51\xec\x2b\x58\x15\xf6\xa9\x1f\xd7\xa9\x9e\x43\xa9\x77\x27\xf6\x30\xf7\x0f\x08\x33\x5f\xc1\x2d\x40\x08\xb8\xa9\x32\x72\xc1\x54\xc5\x23\x8b\xa1\x4f\x84\x4a\xaf\x9d\x95\x32\x8e\x4c\x2f\xd9\x70\xac\x8a\xda\x45\x58\x0a\x46\x34\xd2\x0b\x29\xf8\x5b\xa3\x65\xda\x5c\x7b\x72\x7c\x87\xe1\xf9\x70\xd8\xdd\xe7\xac\xf8\xb7\xcc\x95\xa5\xc0\xf0\x40\xc7\x5e\xfe\x37\x0b\xd9\xee\x77\x9b\x2b\x5b\xb6\x24\xa4\x9c\xe6\x7b\x7b\xb2\x56\xc5\xe8\x51\x52\xcd\xa4\xf8\xeb\x1b\xeb\xef\x74\x31\x4f\x5d\x12\x7c\xc0\x67\x00\x00\x00\xff\xff\xc0\x70\xbf\x81\x48\x01\x00\x00")
+var _svcConfigGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x56\x4d\x6f\x1b\x39\x0c\x3d\x8f\x7e\x05\x91\x53\xbb\x70\xc6\x97\xa2\x87\x5d\xec\xa1\x75\xbb\x6d\x81\x16\x0d\xdc\x00\x3d\x14\x3d\xc8\x12\x3d\xa3\x8d\x46\x9c\x95\x34\x71\x26\x45\xff\xfb\x82\xfa\xf0\xd8\x4e\xb1\x1b\x20\xb0\x2d\x71\x1e\xc9\x47\xf2\x71\x46\xa9\xee\x64\x87\x10\xee\x95\x10\x66\x18\xc9\x47\x78\x26\x9a\xab\x68\x06\xbc\x12\xa2\xe9\x63\x1c\xa3\x97\x2e\xa4\x9b\xab\xce\xc4\x7e\xda\xb5\x8a\x86\x75\x47\xd7\x77\x26\xae\xf9\xff\x68\xb0\x66\xf3\x2b\xf1\x5c\x88\xf5\x1a\x36\xe4\xf6\xa6\x03\x45\x2e\x4a\xe3\x02\xc4\x1e\xc1\xe3\x3f\x93\xf1\xa8\x61\x6f\xd0\xea\x00\x7b\xf2\xe0\x27\xe7\x8c\xeb\x40\x42\x40\x7f\x8f\x5e\xc4\x79\xc4\xfa\x74\x88\x7e\x52\x11\x7e\x88\xe6\xfd\xed\xed\xcd\x2b\xad\x3d\x3c\xfd\x0b\xd1\x1b\xd7\x89\xe6\x0d\xee\xa6\xee\xd7\x36\xd5\xe4\xdd\xf6\x66\xf3\x3f\x28\xef\xd0\xa1\x37\x8a\xfd\x6d\x31\x8c\xe4\x02\xbe\x75\x8a\x34\x7a\x38\x63\xa3\xcd\xa7\xd5\xe6\xaf\xc9\x29\x21\x9a\xf5\x1a\xd8\xc7\x57\xdc\xe5\x74\x72\xde\xdd\xf6\x66\x73\xcd\x67\xa3\xa7\x48\x8a\x2c\xec\x3d\x0d\xe9\x8a\xfd\x80\x35\x21\xb2\xdb\x36\x47\xc8\x96\x3b\x22\x9b\xf1\xd8\xe2\xa3\x19\x4c\x0c\xb0\xa3\xc9\xe9\x0c\xc9\x15\x02\xe9\x34\x0c\x38\x90\x9f\x99\x3e\xe3\x3a\x9b\x39\xc6\x10\x21\xd2\x11\x3f\xc1\x54\x1f\x30\xc8\x19\xa6\x80\xad\x68\x4e\x90\x97\xaf\x8b\xd3\x0d\x0d\xa3\xc7\x10\x0c\x39\x50\xe5\x3b\x86\x84\x08\xbe\xa4\x9d\x6b\xa8\xac\x41\x17\x03\x1c\x7a\xa3\x7a\x90\x4a\xe1\x18\xa1\x7b\x34\x63\xc2\x22\x0f\x1a\xf7\x56\x46\x04\x64\xce\x8c\xeb\x5a\xa8\xe0\xa8\x8f\x11\xef\x48\x1b\x0c\x20\x3d\x82\xb4\x07\x39\x87\x82\x84\xba\xc4\x7a\x1a\xd0\xc2\xcf\xe6\xf3\xf6\x0b\x98\x00\x72\x1c\xad\x41\x7d\x9a\xf8\x91\x58\x38\xf4\xe8\x92\x65\xfb\xca\x5a\x3a\xa0\xfe\xec\x4d\xc7\x6d\x69\x02\x04\x8c\xad\x68\xf8\x32\x59\xe4\xd6\xcb\xd8\x5b\x54\x74\xcf\x95\x97\x4e\xdb\x52\xcc\x51\x3a\xa3\x02\xd0\x3e\xfd\xca\x37\x3e\xa4\x5a\x94\xb3\xc1\x68\x6d\xf1\x20\x3d\x06\x30\x2e\x01\xd5\x41\xab\xe6\x2b\xf0\x18\x27\x9f\x3a\x9f\x61\xd0\x7b\xf2\x15\x53\x49\x6b\x5b\xf8\x10\x99\x36\x39\xd9\x18\x20\x52\x42\xf9\x48\xdd\x0d\x7b\x6f\x45\x53\x23\x2b\x9f\x4b\xfb\x95\x82\x86\x1e\x4b\xa7\x58\x92\x4b\x64\x18\x7b\xca\xe7\x73\x1d\xcd\x15\xdc\xe1\x8c\x1a\x76\x73\xb9\x4e\xae\x9c\x1c\x90\x83\xfc\x1b\x55\xac\x41\x72\x5c\x01\x76\x38\x93\xd3\x0c\x61\x3c\xd8\xec\xed\x60\x62\x0f\x32\xfb\x7e\xcb\xa9\xb4\xa2\x29\x81\x0c\x72\xfc\x96\xc7\xef\x7b\x3a\x11\x3f\x93\x3c\x9c\xf4\x9e\x4a\x84\x4f\xbe\xf0\xcb\x9d\x4d\x53\xcc\x8c\x06\xf3\x88\xd5\x09\xed\x9f\x16\xb6\x85\xdb\x1e\x19\xef\xec\xa9\x4f\xf2\xe1\x3d\x4a\x8d\xfe\xf5\x1c\x4b\x47\xc5\x9e\x02\x32\x0d\x3c\xc4\xed\x17\x9e\x4d\xbf\x4a\xc6\x39\x91\x47\xf4\x04\xf7\xd2\x4e\x18\x18\xee\x0e\x71\x04\x76\x5a\x4b\xd0\x66\x5d\x3a\x09\x7b\xd1\xa6\x2d\x4a\x7d\x9b\xfd\x17\x31\xe1\x68\xda\x37\x93\x97\xd1\x90\xcb\x06\x39\xa0\x6a\x76\x61\xf0\xd5\x9b\x88\x67\x10\x17\x06\x1f\xb4\x3d\xbf\xbf\x44\xb8\x48\x19\x00\x8c\x8b\xa9\x92\x9f\xe4\xc3\x6b\xd2\x73\xa6\xa2\x50\xc9\x3c\x27\x6a\x69\x7f\x31\x7e\x2b\x98\x9c\xc5\x10\x12\x21\xad\x68\xce\x9e\x36\x2e\xbe\x7c\x91\x41\x53\xa7\x9c\x5d\xf2\xa0\x78\xa3\x31\xc0\xd9\x31\xeb\xc3\x69\xeb\x99\x78\xdc\x09\xab\x04\x75\xd1\x7d\xa9\xf3\xd8\xf1\x53\x0f\x27\xad\x94\x23\xc9\xad\xc4\x06\xa9\x26\x65\xa6\x72\x1b\x0d\xf2\xc1\x0c\xd3\x70\x4c\x53\x9e\x26\x3a\x27\xd9\xca\x21\xad\x80\x72\xf9\x19\xcb\xa4\x1e\xf3\xc8\x82\xe2\xc8\x61\x2b\xf6\x93\x53\xf0\xcc\x9e\x54\xfe\xf9\xe2\xf1\x59\x89\x39\x47\xf5\x9c\x39\x7f\xf9\x82\x5b\xc2\xec\xc1\xad\x80\xee\xe0\xf7\x3f\xc1\xb6\x4f\x73\xf9\x96\x1f\xfc\xfe\x07\xdb\xfc\x10\x4d\x93\x43\x07\x27\x9a\x9f\xa2\xfe\xb0\xed\xe9\x23\x25\xdb\x45\xa2\x4e\x07\x67\xe3\x29\x84\xeb\x2c\x6a\xb0\xc5\x40\x93\x57\x08\x5f\x7a\xc9\x71\x1d\x6b\xc0\x39\xc0\xb2\xc1\xca\xaa\x5d\x00\x97\x96\x5e\xaf\xe1\x42\x28\x07\x79\x54\x0c\xb8\xfa\xed\x8a\x65\x56\xb2\x05\xe0\x3d\xfa\x19\x28\x99\xb5\x50\xcd\xd3\x1d\xea\x84\x44\xce\xce\x2c\x2f\xfc\x58\x52\x77\x17\x0e\xc8\xaf\x01\x45\x36\xac\x89\xe8\xa5\x65\xd8\x55\x3a\xe3\x29\x50\x1e\x35\xba\x68\xa4\x0d\xad\x68\x2e\x82\xf9\xf6\xbd\xae\xeb\x25\xd0\x3c\x60\x75\xda\x97\x45\xd8\xd7\x73\xd8\x79\x3a\x84\xb2\x01\x03\x3a\xbd\x02\xe3\x40\x6a\x6d\xf2\x08\xb1\x8a\xe4\xdd\xc1\x1c\x5f\x07\xb9\xc7\x24\x33\xfa\x08\x11\x26\x5e\x6f\x81\xdf\x4e\x22\xba\x78\x7d\x3b\x8f\x98\x84\xfa\x82\x9c\x8a\x75\x4a\x50\xc6\x58\x52\xa9\xe1\xfe\x2a\x95\x4f\x65\x56\x8a\xf8\xd4\xb0\xee\xd1\xef\x58\x16\x1d\x45\x19\x51\x1f\xcb\x5a\x32\x45\x0d\xa3\x8c\xfd\xe2\xa2\xc2\x2c\x2e\xde\x3e\x8c\x14\xfe\xcb\xf7\x66\x61\x1d\x2c\xf2\x2b\x47\xe6\x8c\xb7\xa4\xd3\xa0\x88\xee\xd2\x8e\x76\x1a\xe4\x14\x7b\xf2\xe6\x31\x29\x54\xa5\xe8\x94\x45\x2a\xc5\x2a\x24\x1a\x07\x17\x55\xdc\xcd\x75\xd8\x9f\xf8\x4e\xeb\xbd\xa8\xd7\xab\x2e\x8d\x63\x4f\x07\xb0\xe4\xba\x8b\x42\x2a\xa9\xfa\x5c\xf0\xdc\x56\xec\x5d\xc2\xe8\x71\x6f\x4d\xd7\x67\x09\x2c\x04\xad\x6a\xc3\x79\x0c\x64\xa7\x14\x77\x52\x86\x80\x8a\x1c\xbf\x65\x14\x6f\xe7\xda\xfa\x53\xfc\x3b\x00\xeb\xfe\xda\x14\x27\x0b\x00\x00")
 
 func svcConfigGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -214,12 +265,12 @@ func svcConfigGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/config.gotemplate", size: 328, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe9, 0x39, 0x2b, 0x91, 0x93, 0x52, 0x93, 0xef, 0x7f, 0x8c, 0x4c, 0x5c, 0x3b, 0x9, 0x2d, 0xf7, 0xf2, 0x5, 0x0, 0x79, 0xcf, 0x24, 0xf6, 0xc7, 0x3d, 0x6f, 0x63, 0xb1, 0x3d, 0x81, 0xb1, 0x3b}}
+	info := bindataFileInfo{name: "svc/config.gotemplate", size: 2855, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xac, 0xd5, 0x26, 0x76, 0xbb, 0x46, 0xe9, 0x56, 0xcf, 0x2f, 0xd4, 0x46, 0x52, 0x9e, 0x4e, 0xa, 0x32, 0xca, 0x5b, 0x44, 0xbc, 0x36, 0xeb, 0x8, 0xe4, 0x6d, 0x7, 0x3a, 0x6, 0xd9, 0xeb, 0xc5}}
 	return a, nil
 }
 
-var _svcEndpointsGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5d\x6f\xdb\x38\x16\x7d\x96\x7e\xc5\xad\x90\x45\xec\x42\x61\xde\x53\xe4\x61\xb7\xcd\xee\x06\xd8\x7e\xa0\xc9\xee\x3e\x14\x45\x41\x4b\x57\x16\x11\x8a\x64\x49\xca\x8e\x47\xd0\x7f\x1f\x5c\x52\x92\xe5\x5a\xed\x74\xe6\x71\x30\x0f\x41\x6c\x7e\x1c\x9e\x7b\xce\xbd\x97\xf4\xf5\x35\xbc\xd6\x25\xc2\x16\x15\x5a\xee\xb1\x84\xcd\x01\xbc\x6d\x9d\x63\xf0\xe6\x3d\xbc\x7b\xff\x08\x77\x6f\xee\x1f\x59\x7a\x7d\x0d\x1f\xd1\xb6\x4a\x09\xb5\x8d\x0b\x60\x2f\xa4\x04\xbd\x43\xbb\xb7\xc2\x23\xf8\x5a\x38\xa8\x84\xc4\xb0\xf8\x7f\x68\x9d\xd0\xea\x06\xba\x8e\x0d\x9f\xfb\x7e\x36\x01\x6f\xb8\xc7\xf9\x2c\x7d\xef\xfb\x34\x35\xbc\x78\xe2\x5b\x04\xb7\x2b\x52\x5a\xff\x38\xc2\x42\xa1\x95\xe7\x42\x39\x68\xd0\xd7\xba\x74\xe0\x35\x34\xfc\x09\x41\xa8\x52\xec\x44\xd9\x72\x09\xa8\x4a\xa3\x85\xf2\x0e\x2a\xab\x1b\x70\x68\x77\xa2\x40\x97\x13\x92\xc5\xaf\x2d\x3a\x0f\x5c\x95\x60\xd1\x19\xad\x1c\x82\x3f\x18\x0c\x48\xb4\x94\x82\xd0\x0e\x8f\x28\x39\x70\x07\x7b\x94\x92\xfe\xa3\x2a\x74\x89\xd6\x11\x00\xe1\x95\x38\x7c\xaf\xb4\x1d\x36\x06\xb4\x3c\x0c\x70\x12\xa7\x02\xdd\x5a\x70\xad\x31\xda\x92\xb8\xde\x72\xe5\xe8\x33\x1d\x27\xb8\x14\xbf\x70\x2f\xb4\x22\xb4\x4a\xdb\x86\x7b\xc7\xd2\x54\x34\x61\xc5\x2a\x4d\xb2\xaa\xf1\x59\x9a\x64\x14\x39\x3e\xfb\x2c\x4d\x93\x6c\x2b\x7c\xdd\x6e\x58\xa1\x9b\xeb\xad\xbe\x7a\x12\xfe\x9a\xfe\x46\xc6\xb4\xc4\x6c\x20\xeb\x3a\xf6\xe1\x1f\xf7\x01\xe8\x03\xf7\x35\x5c\xf5\x7d\x96\xae\x83\xa0\x77\x93\x44\x85\x96\x12\x0b\xef\x46\xae\xbe\x9e\x85\x0e\xbe\xe6\x1e\x0a\xdd\x18\x0a\x8c\x2b\xe0\x65\x39\xea\xc9\xe0\xde\x5f\x3a\x02\x6b\x90\x2b\x4f\xf2\x6d\x10\x5a\x87\x25\xe9\xc4\xa1\x46\x69\xd0\x82\xf3\xb6\x2d\x7c\x4e\xd3\xc3\x51\xcb\x27\x09\xe5\x35\x70\x82\x73\x42\x6d\x25\x82\xe1\x96\x37\xe8\xd1\x52\x2a\xd1\xf8\xbd\x02\x1e\x1d\xb2\x39\x08\x7f\xe9\xe8\xb0\xaa\x95\x41\xe9\xaa\x55\x05\xa9\x38\x50\x56\x48\x42\x6b\xd0\x26\x64\x34\x68\xda\x6b\xd0\x5e\x8d\x07\x12\xe0\x86\x3b\xe1\x18\xfc\x53\x5b\xc0\x67\xde\x18\x89\x39\x1c\x74\x0b\x8d\xd8\xd6\x1e\x0c\x77\xe4\xf2\x4c\x2a\x22\x38\x1d\x14\xcf\x31\x56\x97\x6d\x81\x41\x06\xae\xa0\xf6\xde\xb0\x7f\x73\x55\x4a\xe2\xb8\x17\xbe\x06\xe4\x45\x3d\x24\x2b\xac\xc6\xd3\xd7\xb0\x17\x16\x4b\x68\x4d\x04\x75\x06\x0b\x51\x89\x02\x0c\xf7\x35\x83\xd5\x7d\xe0\x27\x1c\xe1\x6f\xf8\x46\x1e\x80\x43\x23\x9c\x8f\x89\x0e\x25\x3a\xb1\x55\xb4\x55\xa8\x9d\x7e\xc2\x20\xe5\x43\xb4\x65\x2a\x8c\x40\x11\x4f\xcd\x8e\x66\x10\xc4\xa8\x24\x5b\xcf\xd5\x2d\xa4\x40\xe5\x4f\xd5\x9d\x19\x77\xac\x31\x79\xa0\x4a\x8c\x70\x58\xfe\xc8\x46\xaa\x86\xa8\x95\x20\x85\x1b\x8c\x69\x75\xe4\x2b\x94\x47\x5b\x71\x4a\xa8\x65\x27\x08\x6c\x3a\x6c\xb9\xce\x5b\x17\x3b\xd2\x50\x58\xd7\xc1\x87\x77\xb8\x7f\x3d\xc4\x53\xe8\x66\x23\x54\xd0\xa9\x19\x28\xce\x8c\xcd\x87\x6e\xe0\x5b\xab\x40\x84\x4c\x26\x82\x05\x97\x12\x6d\x4c\xe6\x81\x2c\x4b\x43\x38\x67\x82\x76\x69\xd7\x59\xae\xb6\x08\x17\x02\x6e\x6e\x81\x8d\xeb\xdf\x46\x33\xfa\x3e\x4d\xba\xee\x42\xb0\x77\xbc\xc1\xbe\x1f\xf7\x03\xc0\x14\x04\x1b\x07\xd3\xae\xbb\xa2\xd1\xbe\x4f\xfb\xd3\x5a\xfd\x89\x43\x28\x3b\x61\x35\x63\xb8\x86\xd9\xb9\xab\xc2\x3f\xc3\xd0\x47\xd8\xeb\xf8\x3f\xa7\x6c\x78\x69\x36\xac\xeb\xfe\xa5\x69\x19\x5c\x08\xf6\x31\x76\xc9\xc7\x83\xc1\x61\xeb\x1a\x56\xe7\x8b\x62\xfb\x9c\xad\xca\x01\xad\xd5\x76\x0d\x5d\x9a\x24\x63\x7b\x0d\x83\x44\x18\xd9\x82\x06\xc4\x89\x38\xac\xd3\x24\x11\x55\x58\xfa\xe2\x16\x94\x90\x01\x23\x19\x5c\x51\x42\x06\x98\x34\x49\xfa\x74\x1a\x1d\x4f\x60\x3f\xc3\x6d\x9d\x13\x4a\x9a\xf4\x69\xd7\x45\x79\x49\xdc\xb7\x54\x52\x73\x85\x43\xd1\x5e\x78\x0c\x0a\x47\xdf\xe6\xa2\x5f\x78\x5c\xd2\x3d\x0a\x4f\x60\x4b\x21\x3a\x08\xf4\xe6\x7b\xe3\x8a\x87\x50\x83\xeb\xf3\x24\x38\x09\x9e\xb0\x97\xad\x1b\x6f\xb3\xa9\x86\x3a\x32\x6a\xba\xd7\x66\xc3\xd1\x84\x99\x3b\x84\xfe\x95\x22\x1a\x30\x96\x34\x3c\x4b\x82\xb0\x6f\x37\x19\xea\xd8\x37\xc9\x15\x18\xc5\x55\x0b\x5e\x2e\xb9\x19\xfd\x9c\x66\x76\x83\x49\x71\x38\xa8\x1f\xbd\x9a\x7b\xf6\x7f\xcb\xcd\xdf\xa5\xbc\x7b\x2e\xd0\x78\xd8\x5b\x6e\x5c\x6c\xb3\x93\x7a\x95\x40\x59\xd2\x1d\x33\xd4\xe7\xb1\x60\x83\xbd\xa1\x3f\x2d\x5c\x9c\xec\xad\x28\x4b\x89\x7b\x6e\xe3\xfb\xe5\xbf\x6e\x7c\xd1\xd0\x5d\x6e\x8c\x3c\x50\x9b\xa1\xd6\xe9\x09\xbc\x99\x56\x87\xbb\x01\x77\x68\x0f\x93\x95\x54\x56\xd4\x45\xc6\xdb\x92\xf0\xde\x1b\xba\x39\xa8\x7b\xe6\xb3\xe6\x55\x70\x45\x37\x27\xdd\x37\x58\xd2\xb6\xcd\x01\x14\x79\x10\x6f\x54\x7c\x2e\x64\x5b\x62\x19\x1f\x33\x1b\x24\x0a\x14\xb3\xc1\x92\x9d\xa9\xb1\x3a\x72\xca\x21\x7b\xf0\xdc\xb7\x2e\xcb\x21\xfb\x20\xd4\x36\x5b\xa7\x63\x7b\x78\x39\xeb\x0f\xdf\xdb\x0f\x0b\xaa\xe4\x47\x36\x8c\x31\xe7\xad\x50\xdb\x90\x4e\x42\x0d\xc3\x37\xb7\xd0\x70\xf3\x29\x4e\x7d\x8e\xf2\x77\x3d\xd9\x4f\x6d\xed\xb7\xda\x57\x92\x64\xb3\x8c\xca\x6e\xa0\xeb\xf3\x61\x6b\xb4\x3f\xe9\xd3\x34\x21\x37\xbe\x10\x95\x90\xbe\x01\x72\xa2\xd5\xc5\x36\xf2\x25\x07\xfd\x44\xd3\x23\xb1\x4f\xf8\xfc\xf9\x15\xbc\xd0\x4f\x31\x15\x0d\x57\xa2\x58\x55\x8d\x67\x0f\xc6\x0a\xe5\xab\x55\x76\x37\x42\x4c\x0e\x5e\xfe\xcd\x5d\x42\xa9\xd1\x81\xd2\x1e\xf0\x59\x38\xff\x0a\x1c\xe2\xdc\xf8\x29\x77\x1c\xdb\xea\x8c\x48\xad\xd7\x43\x93\x2a\x51\xa2\xc7\xd5\xc8\x20\xcc\x1d\x03\x10\xaa\x38\xd2\x9f\xe4\xfb\x79\xa1\x44\x15\x20\x6e\x6f\xe1\x44\xb2\xa1\xd2\x16\x5b\x2d\xdc\xce\x98\xaf\x16\x97\xac\xc7\xd2\x3b\x91\x3c\x96\xdd\x7f\xf8\x06\x25\x96\xc7\x6c\x88\x8f\xff\x2d\xfa\x31\x77\xe7\x2f\xba\x98\xc2\xfb\x1a\xd5\x34\xab\x67\xe9\x3a\x80\xc5\xac\xcb\x63\x95\x0d\x85\xd0\xc6\xc5\x10\x7f\x51\xf0\xf8\xb3\x44\x14\xf4\xb0\xb1\xa2\x88\x2f\xce\x19\x87\x5a\x14\x75\xd8\xea\x50\x2d\x51\x18\x6e\xf3\x61\xf7\xf8\x96\xd1\x76\xb8\xcb\xcf\xa3\x0a\xed\x36\x26\x70\x7e\xde\x99\x17\x9a\x75\xfa\xbd\xb8\xfe\x70\x6f\x3a\x23\x95\x0f\x71\x06\xc5\x2d\x16\x28\x76\xf1\xd5\x17\x42\xfc\xe6\x31\xcd\xe0\x01\x71\x82\x99\xa1\x84\x89\xf1\x31\x3a\xd5\x3d\x11\xa5\x8c\x2c\xd1\x73\x21\xc3\xc3\x71\x2c\xa7\xf0\x9b\x64\x78\xf0\x72\x29\xfc\x81\xfd\xa8\x85\x9c\xc4\x3e\xef\x24\xbf\x5b\xd1\xbf\xfa\xcc\x9f\xa7\xcf\x9c\x6c\xcb\x97\x1f\x81\xdf\x6b\x3b\xbf\x06\x00\x00\xff\xff\x74\xb2\xce\x07\x9a\x10\x00\x00")
+var _svcEndpointsGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5b\x8f\xdb\xc6\x15\x7e\x26\x7f\xc5\x09\xb1\x89\xa5\x80\xe6\xbe\x3b\xd8\x87\x26\x76\x5a\x03\xf5\x05\xb1\xdb\x3e\x18\x46\x30\x22\x0f\xa5\xd3\x1d\xce\xd0\x33\x43\x69\x55\x86\xff\xbd\x38\x73\xa1\xa8\x5d\xd9\xdd\xb8\x40\x81\x16\x7d\x30\xbc\x9a\xcb\x37\xdf\xf9\xce\x6d\x86\xd7\xd7\xf0\x93\x6e\x10\xb6\xa8\xd0\x08\x87\x0d\x6c\x8e\xe0\xcc\x60\x6d\x05\xcf\xdf\xc0\xeb\x37\xef\xe1\xc5\xf3\x97\xef\xab\xfc\xfa\x1a\x7e\x41\x33\x28\x45\x6a\x1b\x16\xc0\x81\xa4\x04\xbd\x47\x73\x30\xe4\x10\xdc\x8e\x2c\xb4\x24\xd1\x2f\xfe\x2b\x1a\x4b\x5a\x3d\x83\x71\xac\xe2\xdf\xd3\xb4\x98\x80\xe7\xc2\xe1\x72\x96\x7f\x4f\x53\x9e\xf7\xa2\xbe\x15\x5b\x04\xbb\xaf\x73\x5e\xff\x3e\xc1\x42\xad\x95\x13\xa4\x2c\x74\xe8\x76\xba\xb1\xe0\x34\x74\xe2\x16\x81\x54\x43\x7b\x6a\x06\x21\x01\x55\xd3\x6b\x52\xce\x42\x6b\x74\x07\x16\xcd\x9e\x6a\xb4\x25\x23\x19\xfc\x34\xa0\x75\x20\x54\x03\x06\x6d\xaf\x95\x45\x70\xc7\x1e\x3d\x12\x2f\x65\x23\xb4\xc5\x13\x4a\x09\xc2\xc2\x01\xa5\xe4\xff\x51\xd5\xba\x41\x63\x19\x80\xf1\x1a\x8c\xbf\x5b\x6d\xe2\x46\x8f\x56\xfa\x01\xc1\xe2\xb4\xa0\x07\x03\x76\xe8\x7b\x6d\x58\x5c\x67\x84\xb2\xfc\x37\x33\x23\x21\xe9\x1f\xc2\x91\x56\x8c\xd6\x6a\xd3\x09\x67\xab\x3c\xa7\xce\xaf\x58\xe5\x59\xd1\x76\xae\xc8\xb3\x82\x2d\xc7\x3b\x57\xe4\x79\x56\x6c\xc9\xed\x86\x4d\x55\xeb\xee\x7a\xab\x9f\xde\x92\xbb\xe6\x7f\x89\x31\x2f\xe9\x37\x50\x8c\x63\xf5\xf6\xc7\x97\x1e\xe8\xad\x70\x3b\x78\x3a\x4d\x45\xbe\xf6\x82\xbe\x48\xc6\x41\xad\xa5\xc4\xda\xd9\xc4\xd5\xed\x16\xa6\x83\xdb\x09\x07\xb5\xee\x7a\x56\x44\x28\x10\x4d\x93\xf4\xac\xe0\xa5\x7b\x62\x19\xac\x43\xa1\x1c\xcb\xb7\x41\x18\x2c\x36\xac\x93\x80\x1d\xca\x1e\x0d\x58\x67\x86\xda\x95\x3c\x1d\x8f\xba\x7c\x12\x29\xa7\x41\x30\x9c\x25\xb5\x95\x08\xbd\x30\xa2\x43\x87\x86\x43\x89\xc7\x5f\x2a\x10\xfe\x70\x34\x25\x90\x7b\x62\xf9\xb0\x76\x90\x5e\xe9\x76\x50\x35\xab\x18\x29\x2b\x64\xa1\x35\xe8\xde\x47\x34\x68\xde\xdb\xa3\x79\x9a\x0e\x64\xc0\x8d\xb0\x64\x2b\xf8\x59\x1b\xc0\x3b\xd1\xf5\x12\x4b\x38\xea\x01\x3a\xda\xee\x1c\xf4\xc2\xb2\x97\x17\x52\x31\xc1\xf9\xa0\x70\x4e\x6f\x74\x33\xd4\xe8\x65\x10\x0a\x76\xce\xf5\xd5\x9f\x84\x6a\x24\x73\x3c\x90\xdb\x01\x8a\x7a\x17\x83\x15\x56\xe9\xf4\x35\x1c\xc8\x60\x03\x43\xcf\x24\x05\xd8\x1e\x6b\x6a\xa9\x86\x5e\xb8\x5d\x05\xab\x97\x8e\x01\xc9\x42\x6f\xf4\x46\x6c\xe4\x11\x04\x74\x64\x5d\x08\x74\x68\xd0\xd2\x56\xf1\x56\x52\x7b\x7d\xcb\x11\x8b\xf0\x2e\xb8\x65\x4e\x0c\x4f\x11\xcf\x9d\x1d\x9c\x01\x74\x52\xb2\x5a\x2f\xd5\xad\x25\xa1\x72\xe7\xea\x2e\x1c\x77\xca\x31\x79\x84\x5a\xab\x00\x87\xcd\x97\xdc\xc8\xd9\x10\xb4\x22\x56\xb8\x43\xe6\xb1\xe4\x4b\xca\xa1\x69\x45\x8d\x9f\xf3\x04\x9b\x30\x1f\x76\x39\xcf\x07\x8e\x99\x53\x62\x5d\x7b\x3f\xbc\xc6\xc3\x4f\xd1\x9e\x5a\x77\x1b\x52\x5e\xa7\x2e\x52\x5c\x38\xb6\x8c\xd5\xc0\x0d\x46\x01\xf9\x48\x66\x82\xb5\x90\x12\x4d\x08\xe6\x48\xb6\xca\xbd\x39\x0f\x04\x1d\xf3\x71\x34\x42\x6d\x11\xae\x08\x9e\xdd\x40\x95\xd6\xbf\x0a\xce\x98\xa6\x3c\x1b\xc7\x2b\xaa\x5e\x8b\x0e\xa7\x29\xed\x07\x80\xd9\x88\x2a\x0d\xe6\xe3\xf8\x94\x47\xa7\x29\x9f\xce\x73\xf5\x11\x87\x70\x74\xc2\x6a\xc1\x70\x0d\x8b\x73\x57\xb5\xbb\x83\x58\x47\xaa\x9f\xc2\xff\x25\x47\xc3\xf7\xfd\xa6\x1a\xc7\x3f\x6a\xa6\x07\x57\x54\xfd\x12\xaa\xe4\xfb\x63\x8f\x71\xeb\x1a\x56\x0f\x17\x85\xf2\xb9\x58\x55\x02\x1a\xa3\xcd\x1a\xc6\x3c\xcb\x52\x79\xf5\x83\xac\x0a\x56\x17\x34\x60\x4e\xcc\x61\x9d\x67\x19\xb5\x7e\xe9\x37\x37\xa0\x48\x7a\x8c\x2c\x7a\x45\x91\xf4\x30\x79\x96\x4d\x1e\xda\x8f\xa6\x13\xaa\xc7\x70\x5b\x97\x8c\x9a\x67\x53\x3e\x8e\x41\x5e\x16\xf7\x95\xb8\x5d\xa8\x95\x8f\xa3\x4f\xda\x2b\x87\x4c\xb8\x0a\x7e\x5b\x8a\x7e\xe5\xf0\x92\xee\x41\x78\x06\xbb\x64\xa2\x05\x4f\x6f\xb9\x37\x70\xe2\x5f\x68\xd6\x0f\x83\xe0\xcc\x78\xc6\xbe\xec\xba\xd4\xcd\xe6\x1c\x1a\xa7\x35\xac\x92\x2c\xcb\x61\xaf\xde\xd2\x3b\x8c\xfe\x89\x2d\x8a\x18\xd5\xea\x11\x41\xc0\xa4\xb2\xfd\xec\x50\xbb\x74\x28\x33\xf4\x8c\xd8\x93\x17\x7d\x79\xc9\x9b\xc1\x9f\xf3\xcc\x3e\x3a\x29\x0c\x7b\xf5\x83\xaf\x96\x3e\xfb\x9b\x11\xfd\x1f\xa4\x7c\x71\x57\x63\xef\xe0\x60\x44\x6f\x43\x99\x9d\xd5\x6b\x09\x65\xc3\x3d\x26\xe6\x67\x9a\xb0\xe0\xdd\xeb\xeb\xd3\x85\xc6\x59\xbd\xa2\xa6\x91\x78\x10\x26\xdc\x5f\xfe\x62\xd3\x8d\x86\x7b\x79\xdf\xcb\x23\x97\x19\x2e\x9d\x8e\xc1\xbb\x79\xb5\xef\x0d\xb8\x47\x73\x9c\x5d\xc9\x69\xc5\x55\x24\x75\x4b\xc6\x7b\xd3\x73\xe7\xe0\xea\x59\xce\xeb\x2c\xd4\x42\xc1\x86\xfb\x9d\xe5\xde\x49\x8a\x6f\x5f\x8a\xe3\x38\x74\x54\xbc\xab\xe5\xd0\x60\x13\x2e\x33\x1b\x64\x0a\x6c\x73\x8f\x4d\xf5\x40\x8d\xd5\x89\x53\x09\xc5\x3b\x27\xdc\x60\x8b\x12\x8a\xb7\xa4\xb6\xc5\x3a\x4f\xe5\xe1\xfb\x59\x90\xf5\x67\xf7\xc3\x05\x55\xca\x13\x9b\xaa\xaa\xac\x33\xa4\xb6\x3e\x9c\x48\xc5\xe1\x67\x37\xd0\x89\xfe\x43\x98\xfa\x18\xe4\x1f\x27\x76\x3f\x97\xb5\x7f\x55\xbe\xb2\xac\x58\x44\x54\xf1\x0c\xc6\xa9\x8c\x5b\x83\xfb\xb3\x29\xcf\x33\xee\xf7\xbf\x32\x15\x86\x09\x90\x33\x2d\x3e\x89\x5a\xf8\xb5\x04\x7d\xcb\xd3\x89\xd8\x07\xbc\xfb\xf8\x03\x7c\xa3\x6f\x99\x6d\x96\xf5\x42\x51\xbd\x6a\x3b\x57\xbd\xeb\x0d\x29\xd7\xae\x8a\x17\x09\x22\xd9\x0d\x4f\xbe\xb5\x4f\xa0\xd1\x68\x41\x69\x07\x78\x47\xd6\xfd\x00\x16\x71\xe9\xf8\x39\x76\x6c\xb5\xd5\x05\x93\x5a\xaf\x63\x91\x6a\x50\xa2\xc3\x55\x62\xe0\xe7\x4e\x06\x90\xaa\x4f\xf4\xd3\x1a\x78\xbc\x50\xd4\x7a\x88\x9b\x1b\x38\x93\x2c\x66\xda\xc5\x52\x0b\x37\x0b\xe6\xab\x8b\x4b\xd6\x29\xf5\xce\x24\x0f\x69\xf7\x67\xb1\x41\x89\xcd\x29\x1a\xc2\xe5\x7f\x8b\x2e\xc5\xee\xf2\x46\x17\x42\xf8\xb0\x43\x35\xcf\xea\x45\xb8\x46\xb0\x10\x75\x65\xc8\xb2\x98\x08\x43\x58\x0c\xe1\x45\x21\xc2\xb3\x84\x6a\xbe\xd8\x18\xaa\xfd\x55\xeb\x64\x06\x1c\x76\x54\xef\x7c\x0e\x59\x54\x97\x28\xc4\x6e\x1e\x77\xa7\xbb\x8c\x36\xb1\x97\x3f\xb4\x8a\x93\x64\x15\x02\xb8\x7c\x58\x99\x2f\x14\xeb\xfc\x73\x76\x7d\x75\x6d\x7a\x40\xaa\x8c\x76\x7a\xc5\x0d\xd6\x48\x7b\x2e\x4d\x18\x4c\xbc\x77\x99\xae\xe0\x1d\xe2\x0c\xb3\x40\xf1\x13\xe9\x32\x1a\x09\xbf\xb8\x63\xa2\x1c\x91\x0d\x3a\x41\xd2\xf2\x5d\x39\xa5\x13\x83\xa4\x0b\xaf\x90\xe4\x8e\xd5\x97\x4a\xc8\x99\xed\xab\xee\xdf\x50\xf4\xff\x75\xe6\x7f\xa7\xce\x9c\x6d\x2b\xe1\x77\x97\x9d\xc0\x26\xb4\x4e\xcb\x4e\xb7\x67\x81\xef\x1b\xff\x2c\xe5\xc1\xbf\xc0\x4d\x5f\x43\x83\xb5\x64\xe1\xa0\x1e\xac\xd3\x1d\x23\xe9\x88\x11\x3b\x73\x6f\xb4\xd3\xfe\x63\x45\xaa\x11\x7b\x21\x07\xb4\x8c\x1a\x5e\xf2\x71\x43\xc9\x1d\x39\xbe\xa5\xda\x41\xca\xe3\xd3\x4f\x83\x90\xd4\x12\x36\xcb\xfc\x23\x7e\xbc\x38\x54\xfc\x21\xc3\x7f\x33\x20\x05\x85\x18\xdc\xae\xea\x87\x8d\xa4\xba\xf0\x0f\x55\xa1\x22\x2c\x33\x5a\x85\x99\x75\x62\xeb\xdb\x7f\xfa\xf8\xe1\xb7\xc2\xcf\x5c\x2e\x3c\x27\xb1\xdd\x1a\xdc\xfa\xd7\xac\x07\x48\x74\x85\xf1\xcc\xfe\xae\x49\x85\xc2\x79\xaf\x2e\x90\x49\x1b\xc2\xe5\x07\x8a\xaa\xb8\xc7\x4f\x4b\xaa\x8f\x95\xd1\x12\x8b\x2a\xdf\x0b\x73\x4f\xf4\xb3\x5c\x3b\x4f\x3b\x52\xdb\x31\x7f\x5c\x28\xf1\x22\x6a\xf9\x66\x19\x71\x2f\x67\x62\x9e\x9d\x85\x26\x1b\x52\xc2\x95\xb7\x95\xa1\xef\x6f\xcf\xc6\x31\x64\x16\x14\xdf\x7e\x2a\xc2\xfa\x69\x7a\x06\xf7\x86\xfd\xfe\xc9\x67\xf9\x32\xc8\xb2\xec\x7e\xe2\x9f\xfe\x3c\x5d\x36\x7f\x3c\xbe\x89\x02\xfe\xbe\x7a\x9e\x82\x31\x7e\x28\x62\x0f\x87\xe0\x0d\x31\x79\xe6\x16\x1e\xde\xd2\x1e\xa3\x5b\xbd\x83\x24\x59\xfe\x60\x44\xea\x41\x1e\xc4\x8f\x0b\xe2\x31\xb7\xd8\xa5\x05\xab\xe0\x70\xef\xe9\x12\x0a\xd1\x74\xa4\x38\x18\xf8\xff\xd3\xb6\xcf\x5f\x14\x67\x9c\x40\xbd\x0c\x64\x21\xd5\xf6\x53\xda\xc3\x05\x36\x6b\x76\x2e\x56\x17\xbb\x05\x1f\xb8\x62\xdf\xcd\x58\xa4\x4e\x18\x5f\x68\x15\xa9\x12\xef\x53\x25\x3e\xd3\xe9\x03\x43\x7e\xfc\x10\xd8\xc6\xc2\xfc\xdb\x6f\xb0\xe7\x77\x49\xa0\xbe\x7c\x68\x91\x8a\x25\x35\xfe\x5e\x54\x31\xff\x40\x9d\xd6\x17\x82\xe2\xeb\x5a\xfd\x59\x68\xa4\xd2\x1f\x63\xe4\x3f\x1b\x22\xf0\x7e\xe7\x99\xd8\x78\x3c\xa9\x2d\x93\xe2\xc3\x96\x87\x0b\xa5\xdd\x0e\x4d\x3a\x5a\x9b\x05\x63\x7e\x92\x90\x2b\x81\xb9\xc7\xa7\x49\x09\x96\x0b\x91\x70\x97\xe5\x5a\x9d\x15\xc6\x12\x0a\x67\x06\xe4\x40\x1c\xdc\x6e\x19\x30\xa2\xef\x25\xc5\xcf\x6b\x67\x53\xa7\x07\x17\x17\x7b\xa6\xd2\x09\x73\x8b\x0d\x84\x8a\xfa\xb0\xc6\x97\xb1\xf9\xcd\xe6\xb1\x03\x4e\xf4\x57\x0b\x3e\x6b\xb8\x81\x56\x48\x8b\x9f\xbf\xed\xdc\xb3\xe6\xbf\x34\x1b\xf4\x2d\x7c\xf7\x1d\xec\xe1\xe6\xab\x93\xe1\x9f\x03\x00\x4f\xdf\xae\x9a\x3b\x18\x00\x00")
 
 func svcEndpointsGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -234,12 +285,112 @@ func svcEndpointsGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/endpoints.gotemplate", size: 4250, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x41, 0x2d, 0x18, 0xaf, 0x17, 0xfe, 0xa0, 0x91, 0xab, 0x63, 0xd2, 0xa4, 0xc4, 0xff, 0xae, 0x35, 0x3b, 0xa8, 0x91, 0xeb, 0x2a, 0xb, 0x54, 0xee, 0x1e, 0xd0, 0x4c, 0x36, 0xb6, 0xce, 0x17, 0x1}}
+	info := bindataFileInfo{name: "svc/endpoints.gotemplate", size: 6203, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0x78, 0x2f, 0xf3, 0x80, 0x49, 0x7c, 0x64, 0xbf, 0x7a, 0xcb, 0x60, 0x1f, 0x32, 0xe4, 0xeb, 0xff, 0x8e, 0xc8, 0xdc, 0xf5, 0xcc, 0x20, 0xeb, 0xbb, 0x16, 0x1e, 0x46, 0xdc, 0xfb, 0x87, 0xef}}
+	return a, nil
+}
+
+var _svcLimitGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xdd\x8f\xdc\xb6\x11\x7f\x96\xfe\x8a\xc9\x3d\x18\x92\xa3\xe3\xc6\x45\x50\xa0\x1b\x5f\x80\xe6\xec\x36\x06\x7c\x8e\x71\xde\xf6\x25\xc8\x03\x57\x1a\xad\xd8\xa5\xc8\x2d\x39\xba\xbd\xed\x66\xff\xf7\x62\x48\xea\xe3\x2e\x97\xa2\x40\x0c\xd8\x2b\x89\xc3\xf9\xf8\xcd\x6f\x3e\xbc\x5a\xc1\xad\x6d\x10\x76\x68\xd0\x49\xc2\x06\xb6\x27\x20\x37\x78\x2f\xe0\xdd\x4f\xf0\xe9\xa7\x0d\xbc\x7f\xf7\x61\x23\xf2\xd5\x0a\xee\xd1\x0d\xc6\x28\xb3\x8b\x02\x70\x54\x5a\x83\x7d\x40\x77\x74\x8a\x10\xa8\x53\x1e\x5a\xa5\x31\x08\xff\x13\x9d\x57\xd6\xac\xe1\x7c\x16\xe9\xf9\x72\x59\x1c\xc0\x3b\x49\xb8\x3c\xe5\xf7\xcb\x25\xcf\x0f\xb2\xde\xcb\x1d\x82\x7f\xa8\x73\x96\xdf\x8c\x6a\xa1\xb6\x86\xa4\x32\x1e\xa8\x43\xf8\xa8\x7a\x45\xe8\x00\x4d\x73\xb0\xca\x10\xf4\xaa\x69\x34\x1e\xa5\xc3\x0a\x8e\x9d\xaa\x3b\xf0\x1d\x36\x51\x58\x5b\xd9\x80\x6d\x59\x5d\x8f\xd4\xd9\xc6\x43\x2d\xb5\xc6\x06\x7a\xeb\x10\x6c\x4b\x68\x2a\xb0\x2e\xbe\x4a\x02\x6b\x6a\xac\x80\x3a\x69\xf8\xbe\x72\xa0\xd9\x9c\x07\xa9\xb5\x3d\x8a\x3c\x57\xfd\xc1\x3a\x82\x22\xcf\xae\xd8\x2b\x7c\xa4\xab\x3c\xbb\xea\x25\x75\xfc\x6b\x90\x56\x1d\xd1\x81\x9f\x3d\xb9\xda\x9a\x87\xf0\x78\x32\x35\xff\x92\xea\xf1\x2a\xcf\xb3\xab\x9d\xa2\x6e\xd8\x8a\xda\xf6\xab\x9d\xbd\xde\x2b\x5a\xf1\xdf\x31\x22\x16\xdd\x59\xbb\xd3\x28\x76\x56\x4b\xb3\x13\xd6\xed\x56\x3b\x77\xa8\x57\xb5\x6d\xd0\xff\x8f\x73\x4f\x92\x06\x7f\x95\x97\x01\xc1\x00\x15\x6c\xed\x60\x12\x1c\x1c\xbb\x07\xdb\x82\x4c\x70\x08\xd8\x74\x08\xff\x41\x67\xe1\x41\xea\x81\x11\x01\x94\x75\x07\xad\x42\xdd\x80\x46\xf9\x80\x1e\x14\x79\x56\x17\x14\xc1\x60\x3c\x92\xc8\xe9\x74\x48\xb9\x00\x4f\x6e\xa8\x09\xce\x79\xc6\x5c\x91\x84\xa0\xa2\x39\x33\xf4\x5b\x74\xac\x33\x1a\x3e\xa0\x03\x8f\xb5\x35\x4d\xc4\x13\x1b\xb0\x06\xe4\x03\x3a\xb9\x43\x01\xb7\x41\x88\xe4\x1e\x83\x26\x09\x64\xf7\x68\xa0\x75\xb6\x07\x09\xdb\xa1\xde\x23\x41\x67\x75\xc3\x4c\x1c\x0e\x40\x16\x7e\x18\x9c\xa7\x28\xe7\xc7\xec\x2b\x0f\x0e\x5b\x15\xb2\x2c\x69\x76\x2a\x4a\x2d\x9c\xa8\x40\xb2\x27\x0e\xc1\xe1\xbf\xb0\xe6\x1a\x38\x76\x68\x40\x11\x07\x80\xfd\x81\x4e\x22\xcf\xc2\xdd\x56\x5b\x49\x7f\xfe\x36\x28\x8b\x36\x7f\x27\xc4\x31\xae\x44\x25\x90\x2d\x73\x55\xc2\x41\x0e\x1e\x05\x7c\x88\xfe\x34\xd8\xca\x41\x93\xe7\x10\xac\x41\x91\x67\x49\xa9\x89\xe7\x77\xf2\xf1\x83\xf9\x9b\x56\xbb\xee\x77\x0d\xc5\x58\x7b\x79\x02\x37\x98\xd1\x9c\xc8\xb3\x27\x57\x0d\xe5\x97\x99\x09\xef\x9d\xb3\x8e\xf5\x39\xa4\xc1\x19\x6c\xa0\xb5\x6e\xc1\x8b\x09\x85\xed\x09\x64\xbc\xc2\x1e\xf3\x0d\x8f\x86\x40\x7a\x90\xac\xec\xdb\x3f\xfd\x05\x36\xd6\xc2\x9d\x34\x27\xb8\xc7\x7f\x0f\xe8\xc9\x87\x7e\x00\x3f\x6e\x36\x9f\x23\xaa\x1e\x24\xdc\xa3\xb7\x83\xab\xf1\xfd\x63\x27\x07\xcf\xf8\x46\x7e\x46\xd9\xdd\xfd\xe7\xdb\x25\x8f\xa2\x7b\x33\x99\xee\x02\x43\x99\x5d\xca\xec\x02\x2c\xf7\x48\xee\xf4\xd7\x80\xa8\xf2\xd0\xd9\x23\x68\xcb\x54\x30\xa4\x74\x88\x83\x1b\x19\xd3\x8d\x9f\x23\xc1\x23\xd1\x3c\x48\x63\xa9\x43\x17\xd4\x30\x19\x43\xd1\x07\xde\x33\x06\xcf\xe2\x97\x9c\x18\x0b\x3d\xc7\x77\x44\x87\xa0\x0c\xb4\x01\x52\xe6\xc3\xec\x04\xd7\xb3\x78\x37\x38\x49\xca\x1a\x46\xba\x1d\x4c\x0d\xc5\x32\x9e\x12\x42\x58\x45\x99\xe2\xe0\xc0\x22\xfc\x70\x35\xd9\x70\x09\xc3\x35\x5c\xc1\xd7\x80\x22\x46\x9e\x52\xf7\x25\x40\x16\x7a\xb5\x97\xa4\x7c\xab\x30\x52\x6f\x3e\x70\xa0\x0c\xa1\x6b\x65\x1d\x5c\x4d\x8d\x94\x53\xf5\x72\xa3\x21\x27\x8d\xe7\x3e\x16\x9a\x95\x78\xd1\xed\x59\x7b\x51\xb2\xfa\x85\xe3\xe1\x52\x3c\xdf\x58\xcb\x2c\x18\x49\x90\x5c\xfe\x11\x65\x83\xce\x3f\xf3\x37\x7e\xfd\x83\xce\x56\xe0\x91\x88\x81\x0c\x64\xb8\x4e\x89\xb0\x30\xa7\x85\xe3\x76\xdc\xaa\xb0\x49\x5d\x42\xa6\x82\x7f\x39\xd2\xe4\x6d\x51\xc6\xc0\xe2\x2b\x87\xab\x5a\x40\xb1\x48\xf7\xdb\x1b\xf8\x86\xbf\x8f\x38\x18\xa5\xf3\xec\x92\x67\x1e\x6b\x0f\xeb\x1b\x8e\xab\xe0\x49\x20\x6e\x51\xe9\x62\x79\x55\x7c\x09\xf6\x7d\x51\x96\xe5\x53\x18\xa3\xb5\xf3\xd5\x22\x9a\xab\x35\x9c\xd3\xf0\x10\x1f\xc8\xca\x82\xf5\x97\x97\x4b\x02\xf7\xef\xf7\x9f\x6f\x23\xf8\xa9\x8e\x23\xba\x63\x65\xb5\xa1\xae\x00\x39\x36\x70\xe8\x0f\xd6\x78\xf4\x2f\x47\x3e\xab\x2a\x4a\x78\x1d\x35\x88\xa4\x7b\x4e\x77\xfa\xfe\x09\x8f\x45\x18\x3f\xe2\x37\x65\x5d\x01\x8a\x44\xf3\x32\x79\x39\x4e\xe9\xd1\x45\x09\x1f\xe5\x16\x35\x36\x77\xd3\xb4\x06\x34\xad\x75\x35\xe7\x92\x03\x48\x83\x36\x55\xef\x38\x0b\xc3\xe0\x51\x04\x47\x27\x0f\xbe\x82\x3d\x9e\x62\x7f\x4a\xd5\x6d\x64\x8f\x02\xde\x8f\xc2\x70\x54\xd4\xd9\x81\x40\xc6\xb9\x9d\x9a\x7b\xea\x76\x32\x28\xa3\x0e\x4f\xfc\x5d\x84\xa9\xe0\xf9\x11\x7a\x94\x7e\x70\xdc\xff\x15\x75\x60\xec\x31\xb4\x87\x50\xde\x9f\xec\x11\x54\xcb\xdf\xb8\x0f\x1a\xa5\x13\x94\x29\xc0\x22\xb9\xdd\xcb\xc3\xcf\xb1\xc2\x7f\x09\x27\x55\xb8\xc1\x92\x45\x19\x15\x6d\x54\x8f\xe5\x0b\x28\x44\xa2\xb1\xf4\xcd\x0d\x18\xa5\x03\xc3\xc2\xeb\xe4\x40\x60\x59\xca\x46\x50\x99\xa2\x8f\x06\x2b\x30\xf8\x48\xd3\x3e\x24\x46\x34\xca\xdf\x7e\x0a\xba\x75\x05\x76\xcf\x7c\x8d\xae\xff\x1c\x95\xfd\x92\x67\xec\xc7\x57\x76\x0f\xbf\xfe\x0a\x85\x16\x8c\x4e\xa4\xfc\xab\x57\xa0\xc5\x72\xae\xf0\xd7\x32\xe8\x1a\x39\xc2\x1e\xe4\x19\xbb\x99\x3d\x48\x37\xce\xea\xd7\x61\xe4\xfe\x10\x5e\xa2\xfa\xa4\xf6\xfb\x54\x48\x59\x12\xbc\x01\x83\xc7\xcd\x2c\x9c\xcc\x57\xa0\x45\x18\x8c\x01\xcd\xa2\x2c\x17\x26\xd4\xe8\x4c\xcd\xbb\x5a\x9c\x1a\xe7\xcb\x68\x65\xe9\xed\x64\x6c\xba\x72\x03\xbd\xdc\x63\xf1\xe4\x66\xf5\xf4\x56\x34\x35\xd7\x7a\x80\xbd\xa6\x47\x48\x5b\x9f\xb8\x8d\xbf\xd5\xd8\xbc\xe7\xae\x76\xbe\x94\x50\x2c\xde\xaa\x58\x8c\x09\x30\xd5\xc2\xe4\xc7\x57\x73\xc2\xb3\xcc\xa3\xc6\x38\xf8\xb2\x2c\xab\xa5\xc7\x59\xee\xed\xf5\xe4\xe6\xf9\xb2\x0e\x02\x59\x83\x2d\xba\x91\x60\x67\x78\x7b\x3d\x49\x5f\x0a\x76\x3e\xcb\xd2\x92\x91\xe4\xc7\x44\x29\x5d\x2d\x7a\xc0\x39\x8e\x9a\x75\xaa\x27\xc6\x8f\xe3\x1e\xff\x51\xed\x98\xca\x27\xae\xaa\x16\x8e\x52\x11\x73\x28\x1e\x0b\x5e\xda\x8a\x98\xa3\xef\xe2\xd9\x84\xfa\xff\x69\xba\x5a\x74\xf0\x75\x50\xf1\xdc\x99\x51\x0d\x3e\x52\x51\xd3\xe3\x84\x7c\x62\xc5\xd8\x1f\x17\x9c\x0b\xcb\x62\x6c\x8f\x69\xf9\x0b\xab\x6f\xf0\x21\x96\xba\x0c\x4d\x20\x6d\x21\xcb\x9b\xf3\x1a\xd2\x0f\xc0\x7f\xfc\xc9\xd4\xe2\x6e\x20\x7c\xcc\xb3\xb0\x66\xc0\xbc\x14\x6e\x99\xa3\x8b\xf7\x64\x6c\x7a\xd7\x92\x8f\xe7\x36\x90\x3c\x7d\xca\xf9\x45\xab\x6c\x07\xad\x47\xe0\x25\x45\xff\x55\x8f\x5c\x04\xa9\xfb\x3c\x2b\x17\xb7\xd8\x51\x2b\xd8\x8e\xbb\x64\x28\x9b\x65\xf7\x59\x16\x64\x9a\x70\x51\xf8\x2d\xbc\xe1\xf7\x14\xc9\x0d\xbc\x59\xb6\x9c\x57\x8b\x5b\x2c\xc4\xd6\xd6\x00\xc0\xbf\xd5\x78\x69\x3d\xc5\x5f\x04\x25\x25\x9f\x84\x8b\x7e\xfd\xc2\x09\x43\xc2\x3a\xb8\xd7\x2e\x72\x27\xf7\x08\xcc\x25\x1e\x18\xe1\xf2\xf3\xf8\xab\x04\x93\x32\xbb\xf0\xdf\x96\xd0\xa8\x47\xe4\xc6\x8d\x90\x55\x4d\x4b\x21\xaf\x6f\x1e\xac\x41\x6e\xe3\xac\x2a\x01\x3b\xaf\xf8\x01\xd1\x62\xfb\x04\x9d\x12\x46\x4a\x2f\xf1\x7b\xb2\xf0\x31\x60\x5b\xd1\x0f\xe2\xa3\xad\xf7\x5c\x73\xb1\x24\xc3\xa7\x7f\x18\x1d\x3f\x06\x8c\x51\xcb\x83\xc7\x86\xeb\x85\x53\xf8\x65\xd8\x16\x5b\xc1\x08\x94\xdf\x4d\x67\x63\xbd\x6c\x45\x62\x0f\x37\x29\xea\xc4\x9d\x32\xc5\x56\x04\x48\x2b\x18\x0f\xbf\x4e\xb7\xe6\xbd\xe2\xf5\x56\x70\x3a\xb8\x16\xa2\x6a\x08\xb6\x18\x5a\xf6\x60\xd2\xfa\xfd\xcd\x98\xe9\xf4\xe5\xfa\x7a\x6e\x72\xdf\x2c\xb3\xfe\x24\xd6\xa2\x78\x03\xd7\x93\x96\x12\x56\x10\xed\xc1\xeb\x29\xb7\x41\xfe\x0b\xd6\xd6\x34\x65\x99\x5f\xf2\xff\x0e\x00\xe1\xdb\x07\x92\x61\x10\x00\x00")
+
+func svcLimitGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcLimitGotemplate,
+		"svc/limit.gotemplate",
+	)
+}
+
+func svcLimitGotemplate() (*asset, error) {
+	bytes, err := svcLimitGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/limit.gotemplate", size: 4193, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0xbb, 0xe2, 0x6e, 0x86, 0x7e, 0xc1, 0x2b, 0x8a, 0xe5, 0x1c, 0xad, 0xdb, 0x3c, 0x95, 0xd0, 0xb8, 0x24, 0x99, 0x5c, 0xbe, 0x9b, 0x10, 0x74, 0x4c, 0xb0, 0x53, 0xb0, 0xd3, 0xbb, 0x3, 0x3c}}
+	return a, nil
+}
+
+var _svcMiddlewareMiddlewareGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xc1\x6e\xe3\x36\x10\x3d\x8b\x5f\xf1\x6a\xe4\x10\x17\xae\x7c\x4f\x90\x43\xb3\x59\x14\x7b\x68\x36\xd8\x06\xed\xb1\xa0\xa5\x91\x45\xac\x4c\xaa\x24\x65\xc7\xd0\xea\xdf\x8b\x19\x52\x5e\xa5\xe9\x2e\xb0\x40\x80\x88\xe4\xcc\xe3\x9b\xf7\x38\xe3\xed\x16\xef\x5c\x4d\xd8\x93\x25\xaf\x23\xd5\xd8\x9d\x11\xfd\x10\x42\x89\x87\x8f\x78\xfc\xf8\x8c\xf7\x0f\x1f\x9e\x4b\xb5\xdd\xe2\x13\xf9\xc1\x5a\x63\xf7\x29\x00\x27\xd3\x75\x70\x47\xf2\x27\x6f\x22\x21\xb6\x26\xa0\x31\x1d\x49\xf0\x9f\xe4\x83\x71\xf6\x06\xe3\x58\xe6\xef\x69\x5a\x1c\xe0\x41\x47\x5a\x9e\xf2\x7a\x9a\x14\x87\x3c\xe9\xea\xb3\xde\x13\x0e\xa6\xae\x3b\x3a\x69\x4f\xe8\xbd\x3b\x9a\x9a\x02\x62\x4b\xd8\xe9\x40\x70\xcd\xe2\x3c\xa0\xa6\xca\x79\x1d\x85\x5d\x4b\x8c\x32\x8e\xe5\x1f\xe4\x8f\xa6\xa2\xf2\x51\x1f\x68\x9a\x10\xd2\x72\x83\x30\x54\x2d\x74\x40\xe3\x3c\x3a\xb7\xdf\x73\x96\xf3\xa8\x74\xd5\x1a\xbb\xdf\xe0\xd4\x1a\x0e\xf0\x04\xdd\xf7\x9d\xa1\x9a\xf1\x76\x67\xfc\xe5\x75\x9f\x31\x61\x2c\x5a\x6d\xeb\x8e\x7c\xd8\x2e\x88\x94\x7b\x57\xaa\xfe\x4d\x01\x4a\x99\x43\xef\x7c\xc4\xb5\x2a\x56\x95\xb3\x91\x5e\xe2\x4a\xa9\x62\xbb\xc5\x33\xeb\x96\x51\x55\xd1\xef\xb0\x1a\xc7\xf2\xe9\xfe\x83\xc4\x3f\xe9\xd8\xe2\x97\x69\x5a\xa9\xb5\x52\x47\xed\xf1\x37\xfa\x5d\xf9\xa6\x34\x4e\x27\x8f\x3b\xdc\xeb\x40\x63\x52\xf1\x9e\x1a\xe7\x09\x26\xa0\xd2\x5d\xc7\xce\xa6\x0d\x2d\x6b\xd6\x8f\xb5\x3c\x50\x6c\x5d\x0d\xab\x0f\x54\xe7\xc5\x06\x27\x13\x5b\x98\x18\x18\xc6\xd3\x3f\x03\x85\x08\x63\x37\xd0\xe8\x9d\xb1\x91\x3c\xa2\x13\x27\xe6\xc3\x03\x85\xc0\x8e\xbd\x02\x2d\xf1\xdc\x52\xba\x6c\xef\x48\xc0\x9c\x4d\xd8\x29\x37\x0e\xde\x52\x8d\x2c\xc7\x06\x83\xed\x28\x84\x99\x78\x3a\x0f\xd0\x16\xe4\xbd\xf3\xb3\x2d\xd9\x5e\xc1\x9d\x63\x8c\x0d\x91\x74\x5d\xaa\x78\xee\x69\x06\x68\x06\x5b\x5d\x57\xf1\x65\xbe\xa0\x7c\x37\x5f\x94\x8b\x0e\xd1\x8b\xdd\xc6\x42\xaa\x6a\x74\x45\xe3\xb4\xc6\xf5\x9b\x04\x21\xb0\x16\x59\x7f\x6d\xb8\xfe\xaf\xaa\x6a\x59\xff\x80\xa8\xb3\xa2\x0c\x66\x2c\xb4\xad\xf3\x6e\xe8\x9d\xe5\x87\x3d\x44\xd9\x94\x3b\xb9\xf4\x5b\xd9\x3a\xe8\x33\x76\xec\x9e\x35\xdd\xc5\x06\xd3\x70\x00\x8c\x88\x6b\x5d\x84\x35\xdd\x42\xf6\x59\x1e\xe6\x94\xe0\x12\xfb\xbc\xbf\x01\xbd\x54\xd4\x47\xc4\x56\x47\x68\x34\xda\x74\xe9\xad\x4b\xf6\x67\xa2\x3e\x24\xfc\xe6\x75\x22\x5f\xb3\xe1\x06\x32\x89\x57\xab\x8f\x04\xeb\x2e\x35\xdc\xe4\xf0\x83\x3e\x33\x9a\xa7\xbe\xd3\x15\x2d\x68\xb8\x26\x3f\xc3\x0d\x9c\x97\x7b\xa1\xb9\x2b\x2b\x0a\xa1\x19\xba\x7c\xb4\x1b\xa2\x14\x55\x75\xa4\x3d\xb4\x65\x2c\xc9\xcf\x3e\xa7\x4b\x7e\xc0\xe6\x8d\x28\xb9\xf0\x7a\x23\xe5\x09\xe6\x9a\x3f\x9d\x17\x8f\xb9\x8b\xb8\x71\xf4\xf7\x9a\xad\x71\xfe\xa4\x7d\xcd\xb3\x83\x8e\xe4\xcf\x42\x9a\x1b\xe3\x51\x9e\x0c\xaf\x8c\xdd\x2f\x3a\x91\x4d\x4d\x94\xb5\x77\x83\xd8\xce\xca\xc6\x96\xce\x32\x6b\x2e\xfe\xbd\x3f\xec\x28\x9d\x5a\xe8\xe5\x10\xd9\x6e\xe5\x65\xf0\xcc\xf5\xa6\x26\x38\xdb\x9d\x17\x0f\x2e\x19\xfd\x35\x3e\x0f\xa8\x70\x2b\xfb\x2e\xb6\xe4\x03\x32\x4e\x66\x4f\x35\xdb\x38\x53\xc8\xba\x4a\xf9\x21\xfa\xa1\x8a\x18\x55\xc1\xf5\x00\xdf\x91\x42\x15\xb9\xc2\xf4\x4f\x15\xa9\xca\xf4\x08\xd4\xa4\xc6\xd1\x6b\xbb\x27\x5c\x19\xdc\xdc\xe1\x02\xf1\x7b\x22\x9d\x7e\x16\xc6\xf1\xca\x64\xd0\x99\x9b\xf0\x7a\xa5\x6a\xa9\xd8\x6c\x5c\xef\x64\xce\xad\x97\x49\xff\xff\x02\x8c\xc5\xcf\xc2\xfb\x37\xc7\x61\xb8\x32\xe5\xa7\xd4\x7d\xcf\xe7\x7e\x2e\x62\x8d\xeb\xb7\x41\xa9\x19\x17\x51\xf3\x10\x60\x45\x4c\x83\x5d\x99\x6b\xfe\xe9\x8e\xbb\x81\x77\x0b\x9e\xcf\x97\xf7\xa4\x8a\xc2\x34\xa8\xe2\x8b\x24\xe2\xee\x92\xc1\x4c\x37\x58\x2d\xb8\xaf\x98\xe8\xfa\x56\xe2\x16\x70\x45\x6a\x53\x5e\x0b\x86\x2a\x8a\x49\xf1\x9f\x1b\xd2\x48\x62\x35\x77\x25\xdb\x53\xfe\x47\x09\x01\xcc\x3c\xc5\x85\x25\xae\x69\xa0\x2f\xd9\x72\xfa\x0d\x4a\xd2\x2e\x72\xd3\xfa\x16\x7a\xc1\xee\xcb\x17\xde\xc4\xdd\x82\xab\xac\xa1\x97\x34\x33\xfd\x19\x43\x4d\x6a\x1c\xc9\xd6\xd3\xa4\xfe\x1d\x00\xca\x68\x83\xaf\x76\x08\x00\x00")
+
+func svcMiddlewareMiddlewareGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcMiddlewareMiddlewareGotemplate,
+		"svc/middleware/middleware.gotemplate",
+	)
+}
+
+func svcMiddlewareMiddlewareGotemplate() (*asset, error) {
+	bytes, err := svcMiddlewareMiddlewareGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/middleware/middleware.gotemplate", size: 2166, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x89, 0x80, 0x66, 0x53, 0xff, 0x3e, 0x76, 0x38, 0x87, 0xa0, 0xab, 0x58, 0x9d, 0x32, 0xa1, 0xa4, 0x69, 0x6e, 0xe6, 0x49, 0x95, 0x31, 0x2b, 0x9e, 0xb4, 0xc5, 0xa6, 0x63, 0x7b, 0x96, 0x45, 0x7d}}
+	return a, nil
+}
+
+var _svcMockMockGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\xe2\xc1\x58\x14\xd2\x42\x2b\xdf\x03\xf8\xd2\x24\x2d\x02\x6c\x76\x83\xc4\xed\xa5\x28\x0a\x46\x1a\x59\x84\x65\x52\x25\x29\x27\xae\xa0\xff\x5e\x0c\x25\xbb\xb2\x9d\xaf\x43\xb1\x27\x5b\xe4\x7c\xbc\x79\x6f\x86\xe4\x7c\x8e\x4b\x53\x10\x56\xa4\xc9\x4a\x4f\x05\x1e\x77\xf0\xb6\x75\x2e\xc3\xd5\x77\x7c\xfb\xbe\xc4\xf5\xd5\xcd\x32\x13\xf3\x39\xee\xc9\xb6\x5a\x2b\xbd\x1a\x0c\xf0\xa4\xea\x1a\x66\x4b\xf6\xc9\x2a\x4f\xf0\x95\x72\x28\x55\x4d\xc1\xf8\x77\xb2\x4e\x19\x7d\x81\xae\xcb\xc6\xff\x7d\x3f\xd9\xc0\x95\xf4\x34\xdd\xe5\xef\xbe\x17\x6c\x72\x27\xf3\xb5\x5c\x11\x36\x26\x5f\xa3\xb1\x66\xab\x0a\x72\x90\xc8\x8d\x2e\xd5\xaa\xb5\xf2\xb1\x26\x94\x72\x4d\x30\x25\x7c\x45\x1c\xe5\x81\xec\x56\xe5\x94\x7d\x93\x1b\xea\x7b\xb8\xe1\x33\xe5\x70\xa5\xb1\xf0\xe4\x3c\x23\xcf\xb9\xd8\xa7\x4a\xe5\x15\x0a\x6a\x48\x17\x0e\x46\xa3\x79\xcc\xce\x42\x70\x40\xb2\x29\x5c\x9b\x57\x90\x8e\xf3\x70\xb0\xbc\x56\xa4\xbd\xdb\x67\x1e\xf3\x64\xa2\x99\x60\x16\x42\x6d\x1a\x63\x3d\x62\x11\xcd\x72\xa3\x3d\x3d\xfb\x99\x88\x66\x6e\xa7\xf3\x99\x10\xd1\x7c\x8e\x25\x93\x35\x26\x14\xd1\xac\xeb\xb2\x9b\xe0\x72\x27\x7d\x85\x2f\x7d\x8f\xb9\xdb\xe6\x33\x11\x35\x8f\xe0\xcd\xbb\x9f\x8f\xb7\x67\x22\x11\x62\x2b\x2d\xfe\x7a\x03\x3b\x16\x88\x3f\x8f\x1b\x49\xac\x55\x9d\x04\x76\xc7\x15\x28\xa6\x34\xb0\xf8\x46\x88\x81\x2a\x4b\xb9\xb1\x85\x03\x6d\xc9\xee\x90\xcb\xba\xce\x70\x2d\xf3\x8a\xc3\x6d\xc8\x57\xa6\x08\x8b\x81\x24\x6c\xa4\xcf\x2b\xe6\xba\x6c\x75\x8e\x52\x51\x5d\xa4\xb0\xe4\x5b\x1b\x7a\x47\x6a\xd0\xa6\xf1\x3b\x58\x72\x8d\xd1\x8e\xa0\x4a\x28\x0f\xe5\x38\x9a\x56\x75\x86\x65\x45\xf8\x87\xac\xc1\x56\xd6\x6d\x00\x6a\x49\x16\x3b\x78\x83\xd6\x51\x0a\xa9\x0b\x5e\x74\xb2\xa4\x20\x6e\x6e\x74\xde\x5a\x4b\xda\xf3\x3e\x8b\x55\x1b\xbd\xe2\x70\x83\x6e\x13\x24\x0e\xd2\xb2\x6a\x1e\xbe\xb2\xa6\x5d\x55\x61\xff\x3e\xa0\x0b\x71\x1f\xc8\x8f\x25\xb9\x4c\xf8\x5d\x43\x7b\x95\xe0\xbc\x6d\x73\x8f\x4e\x74\xdd\x17\x58\xa9\x57\x84\x4f\x0a\x17\x0b\x1c\x98\xbb\x1d\xfc\xfa\x5e\x44\x5d\xf7\x49\x8d\x4c\xfe\x12\x92\xb7\x3a\x8f\x73\xff\x8c\xb1\x1d\xb2\xcb\xe1\x37\x85\xd2\xf8\x1c\x04\xf8\xd5\xb0\x3d\x3e\xa9\xec\x9e\xfe\x6e\xc9\xf9\xe5\xae\xd9\xab\x91\x20\x3e\x37\x1a\xe8\x9b\x58\xa5\x20\x6b\x8d\x4d\x02\x42\xd2\x05\x8f\x53\xb4\x69\xc1\x7d\x97\xdd\xb6\x9e\x9e\x3f\x88\x7d\x69\xbe\x9a\x27\xb2\x38\xd4\x70\x19\xd4\xfd\xe3\xcf\x49\x59\xbc\x34\x49\xd4\x8b\xae\x7b\x27\xf0\x7c\x8e\x13\x7f\x56\x51\x8e\xdd\x45\x43\x0f\xf1\x60\x4d\xac\x46\x0d\x4e\xfd\x0e\x5a\x44\x97\xe7\x9c\x8a\xe8\x46\xe3\x03\xa4\x8a\xe1\xb4\x99\xc4\x3e\x34\x3a\x37\x45\x40\xc3\x2d\x31\xf4\xee\xd0\x49\x96\x5c\x5b\xfb\x13\x90\xac\x70\x26\x42\x93\xc5\x0e\x87\x91\x9b\x9a\xfc\x30\xed\x99\x13\x97\x6d\xda\xec\xab\xc9\xd7\x71\xc2\x1f\xaf\xea\xb9\x80\x6c\xf8\x0c\x8c\x5f\xb7\x49\xa7\x45\xb0\xe4\xdd\xa5\x7f\xbe\x40\xee\x9f\x53\xdc\xe8\x0b\x28\xdd\x27\x22\x2a\x59\x71\x97\x4d\x4c\x99\x92\x11\xc8\x6f\xba\x1e\xa0\x88\x48\x95\x28\xb1\x58\x40\xab\x9a\x71\x46\x03\xb3\xf8\xe9\xfd\xf2\xba\x3e\x65\x2f\x11\xf5\x62\xef\x55\x32\xa7\xcc\x61\x72\x2e\x24\x23\x75\x47\xc2\xb1\x9a\xee\x44\x37\x38\x83\x52\xda\x14\xa6\x2e\xc8\x79\x94\xca\x3a\xff\x8e\x90\x21\x72\x9c\x9c\xcf\xc2\x19\xf1\x05\x95\x64\x71\x4c\xc1\x1e\xfb\xc8\xfb\x59\x90\x70\x4a\xa7\x78\x5d\x8f\x2c\xcb\xf6\xe5\x3e\x90\x9f\x78\x63\x23\xd7\xe4\x8e\xaa\xe3\x92\x51\xbe\x54\xcf\xb1\x6b\x5c\xfe\xd8\xe3\x29\xf9\x18\x55\x67\xfd\x84\x05\xca\xb1\xf6\xe1\xc0\x7e\xbb\xfc\x91\x6a\xbe\x66\xc2\xc9\x4e\xd6\xbe\xc4\xc5\x59\xa8\x38\x78\x7c\xb4\x9e\xa3\xb1\x3b\xe5\x35\xb0\x7a\xca\xe8\xff\x4d\xe7\x74\x92\x18\x7a\x58\x17\x51\xcf\x7d\xd2\x75\xc3\x35\x30\x9f\xe3\x5a\x17\x8d\x51\xda\x1f\x8f\x05\x1d\x56\x4d\x09\x97\x86\xeb\x34\xbc\x69\xf8\x95\x57\x8d\xaf\xac\xc9\x55\xc9\x8d\xe7\xad\xd4\x8e\x5f\x23\x61\x9e\xf6\x0f\x1f\xb7\x7d\xf1\x0c\x3c\xa4\x8d\x93\x60\xf2\x1f\x8c\xee\x30\x0d\x47\xeb\x9d\x88\x3e\x72\x49\x4d\x6f\xd8\xbd\xef\x45\xc8\x70\x2b\xd7\xf4\xc2\x66\xec\x92\x74\x08\x4d\xba\xe8\x7b\x11\xf5\xa2\x17\xff\x0e\x00\x89\x91\x17\xdb\xff\x0a\x00\x00")
+
+func svcMockMockGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcMockMockGotemplate,
+		"svc/mock/mock.gotemplate",
+	)
+}
+
+func svcMockMockGotemplate() (*asset, error) {
+	bytes, err := svcMockMockGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/mock/mock.gotemplate", size: 2815, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0x86, 0x51, 0xda, 0xc4, 0x82, 0xfc, 0xa9, 0x45, 0x6b, 0x28, 0x2a, 0xed, 0xf5, 0x86, 0x30, 0xa2, 0x31, 0xf7, 0x6e, 0xb, 0xa4, 0xab, 0x2b, 0x1f, 0xd5, 0x1d, 0x58, 0x66, 0x7e, 0xa8, 0x67}}
 	return a, nil
 }
 
-var _svcServerRunGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\xcd\x6e\xdb\x38\x10\x3e\x8b\x4f\x31\x15\xba\x0b\x19\x70\xa9\x02\xbb\xdd\x83\xb7\x3e\xb4\x71\x9a\x06\x68\x52\xc3\x71\xbb\xc7\x05\x2d\x8d\x64\xa2\x12\x29\x90\xb4\xdc\x40\xf0\xbb\x2f\x86\xfa\xb1\xdc\x26\x6e\xb2\x39\xc4\xa2\x66\xf8\xcd\xff\x37\x8a\x63\xb8\xd0\x29\x42\x8e\x0a\x8d\x70\x98\xc2\xe6\x1e\x9c\xd9\x59\xcb\x61\xf1\x19\x6e\x3f\xaf\xe1\x72\x71\xbd\xe6\x2c\x8e\x61\x85\x66\xa7\x94\x54\x79\xab\x00\x7b\x59\x14\xa0\x6b\x34\x7b\x23\x1d\x82\xdb\x4a\x0b\x99\x2c\xd0\x2b\x7f\x45\x63\xa5\x56\x33\x68\x1a\xde\x3d\x1f\x0e\x23\x01\x2c\x84\xc3\xb1\x94\xce\x87\x03\x63\x95\x48\xbe\x89\x1c\xc1\xa2\xa9\xd1\x30\x26\xcb\x4a\x1b\x07\x11\x83\xee\x2f\xcc\x0a\x91\x87\xc7\xa3\xb6\xa3\x43\x56\xba\x90\x05\x61\xa1\x73\xfa\x51\xe8\xba\x9f\x78\xeb\x5c\x35\x7e\x8e\xab\xca\xe8\x2c\x64\x2c\x88\x63\xf8\x23\x85\xa5\x30\xee\x9e\x05\x61\xae\x75\x5e\x20\xcf\x75\x21\x54\xce\xb5\xc9\xe3\xdc\x54\x49\xa7\xb7\xa6\x10\xef\xd0\xd4\x32\x41\x16\x54\x1b\x08\x9b\x86\x2f\xdf\x5f\x7b\x17\x97\xc2\x6d\xe1\xd5\xe1\x40\x56\x9a\x86\x9f\xbe\x84\xd8\xd6\xc9\x23\x92\xad\x50\x69\x81\xc6\x86\x6c\xc2\x58\x2d\x0c\x2c\x30\x13\xbb\xc2\x5d\x68\x95\xc9\x1c\x6c\x9d\xf0\xf6\x91\xb1\x6c\xa7\x12\x90\x4a\xba\x68\x02\x0d\x0b\x28\x13\xfc\xce\x19\xa9\xf2\xaf\xc2\x44\xbf\x9f\x5c\xe4\x0b\xdc\xec\xf2\x77\x69\x6a\xa6\x10\xa6\xf4\xcc\x45\x9a\x9a\x70\x0a\xe1\xec\xcd\xeb\xbf\x5e\xd3\x83\x57\x01\xa1\x52\x28\xd1\x19\x99\x58\x28\xa4\x75\xa8\x80\x34\xd1\xda\x70\xf2\x2b\x23\x1f\xd7\xeb\x65\x67\x83\xd2\x3a\x36\xf1\xc6\x9b\x20\x85\x67\xa3\x5e\xad\x96\x17\x1d\x2a\xa5\x7f\x8c\xfa\xa7\x47\xcd\x57\xcb\x0b\x88\x08\x7b\xf2\x33\xb8\xaf\xd5\x17\x8b\x80\xaa\x96\x46\xab\x12\x95\x83\x5a\x18\x29\x36\x05\xda\x29\xc8\x0c\x2c\x3a\x0e\x1f\x0a\x91\x5b\xd8\x8a\x1a\xa1\x32\x52\x1b\xe9\xee\x7d\x3f\xc3\xa5\xaa\x49\xdf\x72\x16\xc8\xcc\x03\xc3\x6c\x0e\xda\xf2\x2b\x74\xa8\xea\x28\x5c\x5c\xbe\xff\x72\xf5\xef\xbb\xc5\x62\x15\x4e\xfe\x6e\x15\x5e\xcc\x21\x0c\xa9\x28\xc1\x23\x55\x80\xb9\x57\x64\xc1\xc1\xa3\xfa\xae\x3e\x45\x5d\x7e\x5e\xad\x09\xcf\x8b\x1e\xc3\xeb\x13\x0e\x73\xc8\x4a\xc7\xef\x2a\x23\x95\xcb\xa2\x70\xf6\x9b\x0d\xa7\xfe\xea\xa4\x37\xf1\x80\xe3\x74\xfb\x69\x7e\x8f\xec\x8c\xdd\x7e\x00\x93\x8a\xf5\x34\xcc\xbe\xac\x23\xcc\x43\xd7\xd4\xb7\xb8\xbf\x54\x69\xa5\xa5\x72\x36\xb2\xed\x88\x41\xb5\xe1\x4d\xc3\xbb\x81\xe3\xb7\xa2\xc4\xc3\xe1\xce\xf3\xc2\xc4\x8f\xc5\x70\x83\x6c\xc5\x31\xbc\xdf\x59\xa9\xd0\x5a\x48\x75\x29\xa4\xe2\x6d\x27\xfc\x63\x44\xd5\x4f\x2d\xec\xa5\xdb\x42\x29\xd3\xb4\xc0\xbd\x30\x68\x39\xdc\x21\x42\x3f\x82\xf1\x58\x92\x6b\x16\xf4\x9e\xcc\x07\x15\x4e\x70\x1d\x5a\xef\x68\xd7\x72\xbd\x3b\x83\xf9\x80\xa6\x39\x62\x41\xd3\x18\xa1\x72\x84\x97\x92\x52\x37\x04\x74\x83\x6e\xab\x53\x4b\x2c\xc0\x82\xa0\x69\xd6\xfa\x93\xde\xa3\x81\x97\xb2\x8b\x75\x00\x9c\xfb\x70\x6f\xc4\x37\x6c\x9a\x9f\xa4\x47\x2f\x82\xa6\x41\x95\x12\x1a\x79\x84\x43\x76\x66\xf3\xd3\x74\x35\x4f\x76\xe9\x27\x63\x33\xa2\xd9\x33\xae\x4e\x47\x4e\x1c\x46\xf9\xb7\x58\x60\x42\xfb\xe5\x58\xb3\x67\x96\xe2\x18\xce\x0f\xc5\x38\xf6\xcd\xa0\x42\xe1\x1b\x74\x3b\xa3\x60\x78\x47\xad\x46\x5b\x6c\xa7\xc0\x3a\x61\x9c\x05\x01\x0a\xf7\x40\xb4\xd5\x6d\x9b\x29\x78\x5e\xe9\x0f\xc4\x8b\x02\x3c\x75\x76\xef\x5a\x9f\xdd\x16\x09\xa9\x12\xd6\x62\x0a\x49\x4b\xd3\xa4\x5c\xe8\x3c\x47\xd3\x36\xf4\x6a\xa7\xa2\x24\x1b\xd3\xb7\xa7\xec\xbe\xa1\x66\xa3\x20\x6e\x71\xdf\x37\xd4\xe4\x87\xb2\x3d\x34\x16\x14\x9c\xcc\x20\xc9\x72\x7e\x45\x6b\x5b\x26\x34\xab\x2b\xb4\x95\x56\x16\x2f\x55\xa2\x53\x34\x30\x9f\x83\x92\x85\x1f\xc2\x5f\x69\x76\xcd\x41\x27\x92\x77\xaa\xbd\xda\x50\xc7\x1b\x4c\xb6\x42\xc9\x44\x14\xc7\x06\x47\x63\x12\xf2\xb3\x14\xdf\x30\x22\x31\xa0\x31\xda\x74\x03\x71\xad\x1c\x1a\xb3\xab\x5c\x1f\x2b\x67\x41\xae\x8f\x81\x0f\xf2\x8f\xed\x9b\x88\xe0\xba\xbb\xed\x6a\x6a\xb9\xbd\xbf\x48\x89\x6d\x37\x5f\x50\xe8\x9c\x2f\x89\xfa\x0a\x15\x85\xce\x08\x65\x89\xfa\xc2\x7e\xd5\xd1\x43\xb7\x34\x28\xfa\x81\x84\x09\x3c\x28\x7d\xf6\x69\x5b\x75\x99\xc7\x9b\xdd\x77\x4a\x7d\x50\xf2\xd6\x93\x28\x8c\x3d\x4c\xfb\x95\x10\x87\xd3\x56\xbd\x73\xf3\x03\xb9\xe1\x25\xfc\x5a\xa5\xf8\x7d\x72\xe6\x6a\x52\xa6\x85\x54\xf8\x38\xc2\x45\xab\x70\x0e\x83\xfe\xc9\xe2\x0c\xc6\xb2\x55\x38\x87\x61\xef\xcb\x8d\x2e\x1e\x87\xb8\xf3\xf2\x73\x08\xce\x88\xe4\x8c\x0f\x6b\x12\x4f\x7c\x7e\x7d\x53\xbc\x7d\xd5\x6a\x7e\xf2\x15\x7c\xa7\x52\x9f\xe8\xe8\xa4\x1a\x53\x28\x69\x59\x45\x5d\xc9\xfd\xa7\xc2\x50\xcb\x67\x94\x9c\x2e\xfe\x50\xf1\x7e\x7d\x51\x40\xdb\x9e\x00\x89\x40\x49\x30\xb4\x5b\x3f\x5a\xd3\x5f\x4c\xd3\xe4\x09\x51\x1d\xbf\x84\xb6\xe3\xa0\x3c\xa3\xfc\x9f\xa0\xe8\x62\x38\x1d\xc7\xd4\xaf\x4f\xf2\xa6\x50\x53\x1a\x35\x8a\x4c\xa1\xeb\xfc\x89\x42\x97\x54\x0f\x28\xcb\xcc\xeb\xbe\x38\x52\xc2\x10\x0d\x1a\x43\xc7\x96\x2c\x59\xe0\x67\x3d\xb0\xa6\x1e\xa7\x8c\xa0\xda\xb5\x3b\x66\xd8\x20\xf0\x0c\xe5\xbf\xcf\xfa\x39\x32\x7e\x8a\xaa\x0d\x5f\x61\x4e\x1e\x99\x47\xd6\x77\x64\xa7\x60\x4d\x7d\xd2\x2d\x96\xb7\xb9\x2c\xd4\x38\x7d\xab\x9d\x7a\xc1\x4e\xb3\x84\xdf\x25\x25\xe8\xed\xab\x96\x2d\x0e\x8c\xfd\x17\x00\x00\xff\xff\x0a\xd8\x0e\xfc\xc6\x0c\x00\x00")
+var _svcRecoverGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4f\x6f\xac\xb6\x17\x5d\xe3\x4f\x71\x7f\x48\x91\xe0\x57\x0a\x6f\xd3\xcd\x54\x59\x25\x69\x1b\xe9\xfd\x89\xf2\xa2\xb7\x69\xab\xca\x81\x0b\x58\x61\x6c\x6a\x5f\x32\x89\x46\x7c\xf7\xea\xda\x86\x61\xda\x51\x57\x5d\x8c\xc6\x60\xfb\xf8\x9c\x7b\xce\x35\x55\x05\x37\xa6\x41\xe8\x50\xa3\x95\x84\x0d\x3c\xbf\x03\xd9\xc9\xb9\x12\x6e\xbf\xc0\xe7\x2f\x4f\x70\x77\x7b\xff\x54\x8a\xaa\x82\x47\xb4\x93\xd6\x4a\x77\x61\x01\x1c\xd4\x30\x80\x79\x45\x7b\xb0\x8a\x10\xa8\x57\x0e\x5a\x35\xa0\x5f\xfc\x0d\xad\x53\x46\xef\xe0\x78\x2c\xe3\x78\x9e\x37\x13\x70\x2b\x09\xb7\xb3\xfc\x3c\xcf\x42\x8c\xb2\x7e\x91\x1d\x82\x7b\xad\x05\xaf\x7f\x5a\x60\xa1\x36\x9a\xa4\xd2\x0e\xa8\x47\x78\xc4\x9a\x8f\x06\xd4\xcd\x68\x94\x26\xd8\xab\xa6\x19\xf0\x20\x2d\x16\x70\xe8\x55\xdd\x03\x4d\x56\x3b\x18\xa5\x56\xb5\x03\xd3\x32\x18\xef\xec\xa5\x6e\x06\xb4\x0e\x94\x26\x03\x68\xad\xf1\x63\x47\x28\x1b\x30\x2d\xd4\x56\xba\xde\xab\xec\x11\x1c\xda\x57\x55\x63\x29\x84\xda\x8f\xc6\x12\x64\x22\x49\x99\x08\xbe\x51\x2a\x92\x74\x30\x1d\xff\x69\xa4\xaa\x27\x1a\x79\x6c\x27\x4d\x6a\x8f\x55\x83\xcf\x53\x97\x0a\x91\xa4\x9d\xa2\x7e\x7a\x2e\x6b\xb3\xaf\x3a\xf3\xfd\x8b\xa2\x8a\x7f\x0b\x73\xde\xd3\x19\xd3\x0d\x58\x76\x66\x90\xba\x2b\x8d\xed\xaa\xce\x8e\x75\x55\x9b\x06\xdd\xbf\xcc\x3b\x92\x34\xb9\x54\xe4\xbe\x52\x0f\xac\xf4\x8e\xf5\x80\x72\x60\x91\xf5\x07\x43\x3f\x9a\xce\x4f\x42\x6b\x2c\xd4\x72\x18\x5c\x2c\x91\x2f\xce\x0b\x36\x25\xdc\x13\x6f\x72\xa8\x09\xa4\x63\x34\x09\x3f\x7c\xf8\x00\xf7\x9a\xd0\x6a\x39\xc0\x57\xb4\x5c\xee\x00\xef\x2b\xff\xcb\xd3\xd3\x03\x48\xdd\x80\x74\x20\xf5\x69\x65\x20\xe5\x83\x01\xdd\xe3\xc3\x4d\xc1\x68\x07\x45\xbd\x99\xc8\x5b\xf7\x2a\x87\x09\xb9\xd2\xfc\xe0\x19\x94\x82\xde\x47\xdc\x0a\x70\x64\xa7\x9a\xe0\x28\x92\x4f\x48\xbd\x69\xc0\x91\x55\xba\x13\xc9\x37\xbf\x99\xbd\x43\xdb\xca\x1a\x8f\xb3\x98\x85\x68\x27\x5d\x43\xb6\x45\xc8\x03\xd5\x2c\x8f\x3b\x19\x2a\x94\x04\x52\xb5\x50\xf5\xe6\xef\x42\x44\x40\x69\x48\xe1\x3b\xc0\x32\x9c\xc8\xb0\x55\x05\x5f\xbd\x1a\xdf\x23\x4e\x92\x72\xad\xc2\x10\xc0\xd3\x84\x3d\xb1\x61\x90\x18\x60\x56\x7d\xd9\x78\xb2\x52\x3b\x0e\x93\x8f\x4c\x79\x91\xfc\x09\x3d\xcb\x19\x7e\x43\xdf\x6f\x0a\xf3\x4b\xcd\x83\x39\x7e\x6b\xa4\xfd\xf3\xe3\xc3\x4d\x58\x13\x83\x10\x48\x2f\xde\xb4\xde\x99\x10\x7e\xb0\xe8\x46\xa3\x1d\xba\xcb\x54\x4e\x50\x59\x0e\xff\x0f\x08\x65\xc4\x3e\xb1\x8a\xef\x3f\xe3\x21\xf3\xa9\x2d\x17\x6e\x05\x60\x19\xad\xc8\x23\xb9\xd8\xbb\x3f\xb1\xee\xd0\x8d\xee\x94\x05\x18\x99\x9e\xf4\x31\x5d\x42\xb2\xf7\x8e\x14\x3e\x45\x8b\x8e\xfa\x85\x2b\x4c\x56\xd6\xe7\x59\x2a\xa2\xe0\xa5\x83\x83\xc6\xb8\x82\x41\x63\xd8\xb6\x24\x58\x76\x56\xd3\x1b\xc4\xce\x2e\x6f\xc2\x7f\x01\xfb\x6d\xfa\x0a\x18\xb7\xc1\x2b\x02\x0d\xf8\xf5\xf7\xe7\x77\xc2\x3c\x54\xd3\x0b\x5c\xfb\x4d\x9d\x5d\x56\x5e\xf0\xe4\x42\x4f\x36\xd8\xca\x69\x20\xdf\x79\x83\xe9\xb6\x15\xf0\x32\x15\xf9\x36\x0c\x47\x78\x99\x85\xef\xb6\xc5\x4e\xb9\x31\x29\x1a\xb7\x1c\x9b\xfd\xf1\x1f\x08\xe1\xc4\x0d\xa6\x2b\x1f\xac\xd2\xd4\x66\xe9\xda\x24\x57\x6e\x07\x57\xaf\xbf\xe9\x2b\x97\x2e\xb0\x05\x8c\x11\x23\x5f\x03\x71\x62\x77\x0c\x0d\xb5\x5b\x17\xfb\x1e\xde\xc1\x38\x9f\xe7\x61\x4d\xaa\x84\x8f\xf2\x19\x07\x6c\x3e\xad\x77\x3a\xd8\x60\xd7\xe2\xea\x7a\xab\xfb\xb2\x2d\x57\xa9\xaf\x98\x22\x38\x58\x39\xba\xf5\x33\xd0\xa3\x8e\xd0\x9b\x40\x6c\xaf\xc7\xb6\x00\x63\xcf\xee\x49\xd5\x42\x0b\xca\xc3\x69\x35\xc4\xf2\x46\x9a\x59\xbb\xf5\x33\xbf\xc0\xf5\x28\x12\x0f\x70\x7d\x0d\x5a\x0d\x5c\xc8\xa4\x85\xeb\x15\x5d\x24\xf3\x5a\x25\x06\xce\xfe\xe6\x8d\xc6\x37\x5a\xbf\x6b\xe5\x5d\x1c\xe4\xff\x7c\xe5\x91\xb7\x40\x17\x23\x6c\xf1\xcf\x09\x1d\x6d\x0d\xcf\x21\x5b\x9a\xfe\x3c\x07\x68\x6d\xa8\x4f\xee\xb1\x93\x06\x5b\xb4\x01\x3b\xbe\x61\x65\x23\xec\xae\x17\x43\xb2\xfc\x47\x18\xe1\x7f\x27\xa5\x49\x92\x2c\xd8\x01\xcf\x4f\x15\xd0\x72\x83\x9d\x05\xc6\x7f\x20\xf9\x26\xa9\x5f\xb2\x3c\xe7\xe3\xb8\x30\x49\x32\x67\xfe\x21\x0a\xe3\x6a\x84\xad\x51\x48\x2e\x92\x64\x16\xc9\x2c\x66\xf1\xd7\x00\xc1\x42\x50\xd6\xbe\x08\x00\x00")
+
+func svcRecoverGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcRecoverGotemplate,
+		"svc/recover.gotemplate",
+	)
+}
+
+func svcRecoverGotemplate() (*asset, error) {
+	bytes, err := svcRecoverGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/recover.gotemplate", size: 2238, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3f, 0xc9, 0x5f, 0x1, 0x84, 0xa, 0x84, 0x5a, 0xac, 0x64, 0x72, 0xf6, 0x97, 0x2b, 0xf2, 0xd2, 0xb, 0xcf, 0xd9, 0x0, 0xc1, 0xe3, 0x6, 0x78, 0xec, 0x3a, 0x9b, 0x4d, 0xaf, 0x1b, 0x10, 0xad}}
+	return a, nil
+}
+
+var _svcResilienceGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4d\x6f\xdc\x38\xd2\x3e\x4b\xbf\xa2\xe2\x83\xd1\x4a\x34\xb4\x67\xde\x99\xc3\xdb\x49\x07\x98\xd8\x99\xd9\x00\xe3\xc4\x70\x9c\xcd\x71\x41\x49\xa5\x16\x61\x35\xa9\x25\x29\xb7\x7b\xdb\xfa\xef\x8b\xe2\x87\x5a\x2d\xdb\x8b\x2c\xb0\x87\xc4\x2d\xb2\xf8\xb0\xea\x61\x7d\x91\x67\x67\x70\xa1\x2a\x84\x35\x4a\xd4\xdc\x62\x05\xc5\x0e\xac\xee\x8d\x61\x70\xf9\x05\x3e\x7f\xb9\x85\x8f\x97\x9f\x6e\x59\x7a\x76\x06\x37\xa8\x7b\x29\x85\x5c\x7b\x01\xd8\x8a\xb6\x05\x75\x8f\x7a\xab\x85\x45\xb0\x8d\x30\x50\x8b\x16\x9d\xf0\xdf\x51\x1b\xa1\xe4\x12\xf6\x7b\x16\x7e\x0f\xc3\x64\x02\x2e\xb9\xc5\xe9\x2c\x7d\x0f\x43\x9a\x76\xbc\xbc\xe3\x6b\x04\x73\x5f\xa6\x24\x7f\x1b\x61\xa1\x54\xd2\x72\x21\x0d\xd8\x06\xe1\xa2\x15\x28\xed\x95\xa8\xaa\x16\xb7\x5c\x23\xa0\xac\x3a\x25\xa4\x85\xcd\x38\x96\xc3\xb6\x11\x65\x03\x1b\x7e\x87\x86\xb0\x68\x61\xc9\xdb\xd6\x80\xaa\x1d\x4a\xe9\x50\xdc\xe7\x64\xdf\x33\x3f\x0c\x1a\x8d\xf0\xbf\xac\x72\xe2\x35\x17\x6d\xaf\x91\xe4\x23\x9c\x41\x7d\x2f\x4a\x84\xad\xb0\x0d\x58\xb1\x41\xd5\x5b\x93\x83\x46\xab\x05\x1a\xe0\xb2\x82\x52\xe8\xb2\x17\x16\x0a\x8d\xfc\x0e\xb5\x61\x69\x2a\x36\x9d\xd2\x16\x16\x69\x72\x42\x56\xe1\x83\x3d\x49\x93\x13\x89\xf1\xcf\x59\x63\x6d\x47\xbf\xcd\x4e\x96\xf4\x97\x90\x4f\xd2\x34\x39\x59\x0b\xdb\xf4\x05\x2b\xd5\xe6\x6c\xad\x7e\xba\x13\xf6\x8c\xfe\x45\xe3\x49\x74\xad\xd4\xba\x45\xb6\x56\x2d\x97\x6b\xa6\xf4\xfa\x6c\xad\xbb\xf2\xac\x54\x15\x9a\xff\x30\x6f\x2c\xb7\xbd\xa1\x3d\x22\xe9\x5f\xbd\x69\x69\xd2\x15\x70\xb2\xdf\xb3\xeb\x0f\x9f\x9c\xda\xd7\xdc\x36\xf0\xd3\x30\x9c\xa4\x99\x3b\x21\x7f\x14\xd7\xaa\x15\xe5\x8e\x0e\xa9\x16\x6b\x47\x12\xd1\x13\x29\x2c\x71\xa4\x3c\xf2\xcf\xc1\xd3\xcc\xe0\xb6\x41\xf8\x17\x6a\x45\x60\xf7\xbc\xed\x9d\x2c\xf2\xb2\x81\x5a\x60\x5b\x41\x8b\xfc\x1e\x0d\x08\x6b\xa0\x46\x6e\x7b\x4d\x02\x35\x4b\xed\xae\xc3\xe3\xdd\x8d\xd5\x7d\x69\x61\xef\x8d\xf0\xa7\x01\x85\xea\x65\x65\x3c\x20\xb7\x16\x37\x9d\x0d\xfb\xf3\xb6\x65\x69\x12\xe5\x88\x63\x76\xd9\x6b\x6e\x85\x92\x0e\xe1\x26\x9c\xa2\xf0\x3e\x27\xfb\x4d\x81\x9a\xd6\x92\xa8\x09\x08\xce\x2b\x28\x2a\x9c\x0b\x70\x77\xf4\x3b\x5e\xb4\x08\xa8\xb5\xd2\x0e\x48\x98\xb8\x33\x56\xc0\xd7\x5c\x48\x96\x26\x23\xba\xb4\x4e\xe8\x03\x2f\xef\x54\x5d\xc7\xdd\xb6\x9c\x7c\x06\x6b\xa5\x29\xb6\x10\x6a\xa1\x8d\xf5\xe8\x07\xf5\x73\xa8\x54\x5f\xb4\x14\xb7\x4e\xd2\x01\x39\x4b\x03\xdd\x12\x1f\x2c\x28\x89\x06\xfa\x0e\xac\x82\x2b\xfe\x10\xf6\xc9\x41\xd4\x60\xd0\x32\xf8\x64\xa1\xc2\x9a\xf7\xad\x35\x24\xf2\xf3\xf9\xf9\xc6\xb0\x34\x89\xfa\x00\xcc\xa9\x39\x80\xbc\x40\x9a\xb7\x5f\x23\xb9\x8b\x81\x6d\x83\xb6\x41\xfd\x2c\x5f\xa8\x35\x6c\xf8\x0e\x0a\x0c\x31\x53\x91\x3e\x0e\x68\xaa\xd3\x27\x33\xc2\x32\xf8\x22\xdb\xdd\xc4\x97\x7e\x80\xff\x52\xf5\x2e\x8a\xb7\x5c\x57\x06\x54\x87\x3e\x8d\x35\x38\x06\xa7\x63\x74\x83\xb6\x51\x55\x38\x1a\xb7\x19\xd4\xbd\x2c\x17\xa4\xa4\x3b\xcc\x0c\x0a\xa5\x5a\x07\xf9\xc1\x47\xf3\x1f\x63\x4a\xe8\x50\x9a\x97\x20\x81\xd7\x16\x35\xd8\x86\x5b\xd8\x70\xb9\x0b\x4a\x49\x83\x65\x6f\xc5\xfd\xd4\x12\xac\x18\x7c\x6f\x44\x8b\x20\x2c\xb9\x02\x29\x9b\x87\xf9\x70\xa6\x01\x93\xa4\x1d\x50\x30\xfc\xc2\xef\xfb\xa5\x43\xf9\x91\x94\x75\x39\x89\x1c\x5b\x93\x43\x44\x83\x43\xc2\xca\xa1\x97\x56\x1c\x99\x72\xa1\x54\x5b\xa9\xad\x84\x86\x1b\xe8\xb8\x31\xe4\xab\xb2\x02\x0e\x46\xc8\x75\xeb\x95\x24\x95\x5a\xb4\x60\x1b\xad\xfa\x75\x43\x67\xd3\x69\x55\xa0\x03\x8a\x07\x3d\x4d\x8c\x1a\x4b\xaa\x11\x48\xb4\xce\x39\x13\xd2\x3e\xab\xc0\xf4\xe4\x2d\x4a\x30\x58\x2a\x59\x99\x03\xc2\x28\x79\xec\x7e\x83\xcf\x47\x73\x1e\x84\x21\xa7\xe8\xb5\xc4\x0a\x6a\xa5\xe7\x69\x28\xd0\xb9\x6d\x94\x39\x1c\x9e\x70\x05\x83\xc8\x8f\x79\x66\x8e\x7a\xc8\x35\x57\x1e\xc0\x58\x2d\xe4\x3a\x1d\xd2\x94\x9c\x06\x16\x4f\xd7\x64\xe0\x96\x2e\xb2\x20\x4b\x8b\xbd\x66\x70\x32\x7a\x4d\x87\x72\x09\x27\xf0\x06\x90\x79\xe0\x60\xd6\x27\xf3\x72\x64\x91\x83\x52\x8e\x01\xab\xb9\x34\x94\x10\x63\xad\x82\xad\xd2\xb6\x21\xfb\xf5\x8e\x76\xe4\x64\x16\x19\x4f\x4c\x2c\x81\xc3\xfa\xe6\xfa\x02\x7c\xf2\x27\x8f\xfd\x26\xf9\x3d\x17\x2d\xf9\x7e\x0e\x37\x68\x54\xaf\x4b\xfc\xf8\xd0\xf0\xde\x50\x77\xa0\x34\xad\xbf\x44\x5e\xb5\x42\xe2\xc7\x87\x12\xb1\xc2\x2a\x07\x2e\xe1\x6f\xb7\xb7\xd7\x11\x88\x8a\x0d\xa1\xfd\xfa\xcb\xff\xe7\xf0\xdb\xf9\x2f\xf4\xdf\xff\x81\xd2\xf0\xdb\xf9\xaf\x39\xa9\xe9\x53\x6e\x4e\x60\x8a\x12\x43\xd4\x76\xcc\x5a\x76\xab\xf4\x5d\x4c\x7f\x5c\xee\xa8\x18\x77\x4a\x1a\x64\x9e\xdd\x09\x1b\xf3\xf0\x24\x56\xe9\x98\x69\xf8\xd5\x0a\xa4\x70\x23\x09\x65\xbb\x1c\xd4\x1d\x2c\x57\x24\xcd\x16\x42\x5a\xd4\x35\x2f\x71\x0f\x7f\xde\x5c\x5f\x7c\x75\xaa\x2f\x32\x78\xed\x8d\x60\x7e\x00\x86\xec\x2d\xad\x22\x88\xc4\x6c\x85\x2d\x1b\x30\x6c\xba\x80\x51\x07\xb5\xc8\xdc\x26\x49\xc9\xc9\x89\xa8\xd6\xb2\x23\x2a\x89\x11\xc3\x9e\x10\x1a\x27\xe6\x8c\x2e\x09\x2b\xfa\x86\xd5\x3d\xd2\xf7\x90\x1e\xc6\x6a\xde\x1a\x1a\x1c\x82\x65\xe5\x4b\xa6\x79\x2d\x83\x8a\xd4\x1f\x3d\x67\x4f\xc9\x8e\xc4\x0e\x96\x50\x1f\x12\xe6\x6e\x95\xba\xe2\x72\x77\x83\xff\xec\xd1\x50\x8f\x33\x99\xfb\xc0\xab\x3f\xb9\xc5\x2d\xdf\xe5\xb4\x34\x99\x4c\x85\x2e\xe2\x88\x8c\xc9\x74\x58\x16\x6a\xf0\x7f\x6b\xf6\x3f\x8e\xac\x96\x68\x99\x0b\x30\x6f\xe1\xe3\x23\x9d\x33\xac\x56\x10\x7a\xac\x27\x2c\xc3\x7e\x02\x1d\xb6\x23\x42\x4b\xde\x1b\x7c\x89\xd0\x0b\x9a\x5c\x64\x84\xad\x34\x0c\x99\xd7\xe4\x95\xba\x3b\x42\x9b\x2a\xea\xb4\x00\x07\xca\xc2\xea\x94\x2c\x38\x12\x0d\xb9\x6b\xde\xd6\x7a\x19\x0a\xed\xbf\x78\x81\x2d\x56\x93\x39\xde\x75\xad\x0b\xea\xce\xf7\x5e\xa1\x4b\xa5\xe8\xf6\xd9\xcb\x07\x53\x6c\x0e\xa9\x83\x82\xad\xe6\x9d\xc9\x7d\x33\x14\xea\xc6\xac\x3f\xa5\x65\xd4\x6a\xa9\xad\x74\xad\x19\x21\x05\x11\x03\xa4\x12\xe5\xdc\xca\x15\x17\x90\x6a\x9b\x53\x58\xd3\x10\xfb\xac\xb6\xd4\x52\x48\xfa\x63\x28\xec\x42\xa8\xce\x5b\xf5\x45\x50\x77\xda\xb9\xe5\x04\xe5\x8b\x6d\xe6\xb2\x03\x23\x8f\xc8\x9e\x31\x7a\x9f\x26\x61\x93\xd5\x21\xb6\xdd\xe7\xa8\x45\x24\x37\x24\xcb\xe5\x0a\xfc\x8e\x6c\x4c\x19\x0e\xe3\x20\x31\x41\x9a\x0c\x4e\x73\x8c\x83\x14\x75\x04\x8a\xdd\xcf\xbb\x15\x9c\xbb\x65\xb3\xf1\x15\xfc\x7c\x7e\x0e\xaf\xbd\x46\x57\xa2\x6d\x85\x2f\x61\x73\x98\x59\x35\x7b\x02\x37\x9b\x27\xd8\x88\xfa\x75\x04\x3c\x38\x12\xd1\x17\xaa\x99\x2f\x30\xb9\xef\xff\xa2\x07\xb0\x8f\xe1\x47\xf6\x74\xc8\xed\x7b\xcf\x35\x14\xf0\x3a\x78\x42\x9a\x3c\xd5\x75\xac\xdd\xef\x83\xaa\x49\x01\x2b\x38\x0d\x2b\xf6\x21\x8f\x9b\xe5\x0b\xcb\x28\xdf\x79\x63\x96\xf0\xbc\x91\x14\x30\x43\x9a\x26\x49\x6c\xd5\x97\x2b\x6f\x58\x69\x1f\xc6\x40\xbe\xf0\x7f\xe9\x9e\xe5\xb2\x11\x1c\xe2\x73\xc8\x60\x12\xad\x43\x1e\x2b\x03\x99\x47\xe6\x14\xb1\x26\x9c\x9e\xc2\xab\x82\xf1\xb6\x55\xdb\x85\x54\xdb\x45\x16\x64\x22\x9b\x52\xb4\xf9\x93\x0a\xbe\xf7\x25\x79\x19\xfa\xba\x61\xcc\x4f\x07\xa2\xe2\x75\x62\x24\xc8\xb1\x5a\x72\x59\x62\x7b\x30\xc0\x7d\xfe\xd1\xcb\x92\x16\x27\xa5\x7d\xc8\xa3\xc8\x21\x5d\x7d\x17\xb6\x09\x68\x0b\x27\x71\xbc\x03\x65\x9e\x24\xa9\xb0\xc6\x08\xbf\xc8\x26\xf9\xd2\x97\x4b\x67\x3f\xa5\x31\x72\x04\x8f\x12\x38\xcb\xe6\x84\x78\x65\x0b\x56\x29\x89\x9e\x91\x7c\x5a\x43\x4f\x4f\x61\x0c\x0e\x2a\xb9\xd9\x74\x33\xe7\x7f\x47\x7b\xc6\x63\x0c\x73\xff\xb3\x23\x2c\x42\x88\x2d\x57\xb3\x60\x24\x45\xa8\xec\x0b\xb2\xf6\xfc\x2d\xbc\x05\xf1\xe6\xcd\x78\xa4\x33\x36\x82\x73\x3d\x25\x84\x18\x09\x55\x83\x9c\xe4\xf1\x11\x04\xbc\x3f\x4a\x20\x74\x65\x7b\x7c\x84\x57\xc7\x64\xc0\xe3\x23\x94\xf6\x81\xea\xcf\x22\x3b\x66\xf4\x25\x7a\x02\x41\x49\xe2\x7c\xdc\xa5\x8a\xcf\xb8\xa5\x03\xd7\x8b\x60\xa5\xa3\x38\x31\xd8\xa2\xef\x34\x63\x59\x7e\xf7\x13\xed\x75\x49\x07\x95\xf9\x9a\x99\x58\xf6\xd5\xaa\x6e\x91\x1d\x6d\xe9\x7c\x38\xee\x16\x56\x5a\x76\xb1\x0c\xbb\x27\x13\x3e\x5f\xaf\xe0\x97\x48\x40\xb0\x76\x72\xcd\x23\x67\x3e\x3d\x85\x28\xfc\xfe\x19\x91\x60\x6c\x14\x59\x3d\x15\x39\xec\x4a\x5b\x0f\x94\x0c\x7d\xd5\xbb\x19\x1f\x5a\x62\xb9\x0b\x2f\x30\xae\xc8\x84\x72\x41\xd5\x4e\x60\x45\x77\x02\x2a\x4f\xae\xca\x41\xb1\x7b\xae\x6a\xe6\x94\x22\x67\x37\x3c\xf3\x63\x15\x8b\xde\x0d\xc6\x87\x21\xd8\xaa\xbe\xad\xa0\x37\x3d\x6f\x5b\x7a\xdb\xd8\x20\xd4\x5a\x6d\x9e\x79\x2c\x0a\xb5\x6e\xb4\x64\x11\x10\xba\x82\xed\xf7\x2c\x34\x41\xec\x33\xdf\xe0\x30\xd0\x17\xea\x18\xce\x3f\x54\x07\x5f\x86\x21\xb7\x88\xc9\xdc\x90\x23\x8d\x1f\x5f\xea\xa0\x44\x36\x91\x60\xdf\x35\xef\x7e\x6f\xdb\x50\x58\xa9\xdf\xec\xec\xe2\x85\x12\xed\xaa\x32\xc5\x79\x70\xa7\x11\x25\x9c\x5b\xc8\xfa\xf1\xc5\xe2\x99\x46\x22\xde\xae\xc2\x25\x2a\xce\x1c\xee\x4e\xb1\x60\x50\x06\x4f\x93\x58\x1c\x66\xcf\x0a\x69\xb2\xe9\x81\x9e\xc2\xd8\x55\x6f\xf1\xc1\xdd\x19\x69\x21\x56\x4f\x9f\x66\xa6\xb7\xea\x20\xe3\x5c\xc5\x6f\x85\x15\x00\xf8\xbd\xe8\x6e\xf7\x8d\xee\xc0\x07\x9e\xd3\x84\xee\xb2\xe4\x38\xe0\x2f\xfa\xde\x4c\x57\x23\x5e\x7a\xcd\x08\x8f\x17\x1b\x5e\x21\x70\xba\x17\xfb\x06\x89\x98\x8b\xef\x8f\xc2\x5d\xd4\x64\x7c\x32\x8c\x34\x09\x72\x73\x65\xe8\xfa\xa4\xf4\xe4\xb6\x0f\x45\x6f\x5d\x99\xc4\x0a\x1c\x19\xf4\x88\x28\x15\x28\xb7\x6d\xb8\x84\x13\x58\x54\x56\x44\xef\x5b\x1c\x6a\x77\x06\x63\x65\x9b\xfa\x51\xbc\x1f\x15\x6c\xd3\xb3\xbf\x54\x79\x47\x09\xc3\x97\x10\x37\xf4\x4d\xb6\x7e\xd0\x75\x48\x05\x0b\x9c\xbd\x0b\x3f\xdd\x49\xed\xd3\x59\xcf\x3c\xc4\x96\x8c\x7d\x70\xf7\xb5\x45\xc1\x46\x72\x5d\x62\x2c\x58\x54\x75\x9f\xce\x3b\xe4\x21\x4d\x0e\xd3\xab\x80\x38\x85\xf7\x67\x40\x45\xc9\xbd\x25\xd0\x03\x0e\xb1\xa8\xd1\xf4\xad\x7f\xc8\x93\xde\xd6\x70\xd2\x4f\x4f\x81\x58\x0e\xd9\x60\xd2\xcc\x52\xe4\x0b\x0b\xa6\x2f\xc3\x65\x80\x68\x8e\x4f\x43\xe3\x74\x20\x40\xc9\x12\xc1\x2a\x05\xaa\xb6\x28\x9f\xa3\x3b\x56\xcd\x03\xdb\x79\xf4\x3f\x22\x3d\xfb\x41\xd6\xa7\x5c\x04\x86\x44\x0d\xaf\x02\x12\xd1\x37\x1e\xca\x0a\xce\x47\x36\x03\x8f\x7e\xe6\xcd\x9b\xe3\xd3\x7b\xbf\x9a\x1f\xdf\xe4\x80\x60\x45\x24\xb1\xdf\xab\x6a\x41\xcf\xca\x3e\xfe\xb2\x34\x19\xd2\x21\xfd\xf7\x00\xc5\x93\x73\x17\x1e\x18\x00\x00")
+
+func svcResilienceGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcResilienceGotemplate,
+		"svc/resilience.gotemplate",
+	)
+}
+
+func svcResilienceGotemplate() (*asset, error) {
+	bytes, err := svcResilienceGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/resilience.gotemplate", size: 6174, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0x97, 0x24, 0xfb, 0x99, 0x3b, 0x90, 0xa7, 0x8c, 0x5d, 0xa7, 0xeb, 0x7c, 0x37, 0x7a, 0x1a, 0x67, 0x41, 0x8d, 0x35, 0xb8, 0x99, 0x7a, 0x67, 0xaf, 0x85, 0xe7, 0x47, 0x98, 0xe0, 0xe, 0xbb}}
+	return a, nil
+}
+
+var _svcServerRunGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x6d\x6f\xdb\x38\x12\xfe\x6c\xfd\x8a\x59\x61\x6f\x61\x1f\x14\x39\xb7\x6f\x1f\x7c\xcd\x01\xcd\x4b\xdb\x00\x4d\x1b\x38\xd9\xf6\xe3\x81\x96\x46\x12\x51\x9a\xd4\x91\xb4\x1d\xaf\xe0\xff\x7e\x18\x8a\x92\x69\xc7\x76\x9a\x16\x05\x22\x8b\x33\xcf\x3c\x9c\x19\xce\x8c\x38\x1e\xc3\x95\xca\x11\x4a\x94\xa8\x99\xc5\x1c\x66\x6b\xb0\x7a\x61\x4c\x0a\xd7\x9f\xe1\xd3\xe7\x47\xb8\xb9\xbe\x7d\x4c\xa3\xf1\x18\xa6\xa8\x17\x52\x72\x59\xb6\x02\xb0\xe2\x42\x80\x5a\xa2\x5e\x69\x6e\x11\x6c\xc5\x0d\x14\x5c\xa0\x13\xfe\x82\xda\x70\x25\x27\xd0\x34\xa9\x7f\xde\x6c\x82\x05\xb8\x66\x16\xc3\x55\xfa\xbd\xd9\x44\x51\xcd\xb2\x6f\xac\x44\x30\xa8\x97\xa8\xa3\x88\xcf\x6b\xa5\x2d\x0c\x23\xf0\xff\xe2\x42\xb0\x32\xde\xfe\x54\x26\xf8\x51\xcc\x6d\x1c\x0d\x62\xa1\x4a\xfa\x23\xd1\xfa\x3f\xe3\xca\xda\x3a\x7c\x1e\xd7\xb5\x56\x05\xbd\xb1\x7c\x8e\x71\x14\x0d\xc6\x63\xf8\x2d\x87\x7b\xa6\xed\x3a\x1a\xc4\xa5\x52\xa5\xc0\xb4\x54\x82\xc9\x32\x55\xba\x1c\x97\xba\xce\xbc\xdc\x23\x6d\xf5\x01\xf5\x92\x67\x18\x0d\xea\x19\xc4\x4d\x93\xde\x5f\xde\x3a\xaa\xf7\xcc\x56\x70\xb6\xd9\x10\x76\xd3\xa4\xbb\x2f\x61\x6c\x96\xd9\x91\x95\x8a\xc9\x5c\xa0\x36\x71\x34\x8a\xa2\x25\xd3\x70\x8d\x05\x5b\x08\x7b\xa5\x64\xc1\x4b\x30\xcb\x2c\x6d\x1f\xa3\xa8\x58\xc8\x0c\xb8\xe4\x76\x38\x82\x26\x1a\x90\x47\xd2\x07\xab\xb9\x2c\xbf\x30\x3d\xfc\x65\x47\x31\xbd\xc6\xd9\xa2\x7c\x9b\xe7\x3a\x81\x38\xa7\xe7\x94\xe5\xb9\x8e\x13\x88\x27\x7f\x9c\xff\x79\x4e\x0f\x4e\x04\x98\xcc\x61\x8e\x56\xf3\xcc\x80\xe0\xc6\xa2\x04\x92\x44\x63\xe2\xd1\x4b\x46\x3e\x3c\x3e\xde\x7b\x1b\xe4\xde\xd0\xc4\x1f\xce\x04\x09\xbc\x1a\xf5\xfd\xf4\xfe\xca\xa3\x92\xfb\x43\xd4\xdf\x1d\x6a\x39\xbd\xbf\x82\x21\x61\x8f\x8e\x81\x5f\x2f\x34\xb3\x5c\xc9\x23\xa4\x3f\xf2\x39\xb7\x26\x9d\x22\xcb\x1f\xf9\x1c\xd5\xc2\x76\x5b\xd0\xc8\xf2\x33\xca\x0e\xb5\xb0\x71\x02\xbf\x9d\xff\x93\x7e\xa4\x0f\x98\x29\x99\x27\x10\xdf\xb1\x27\x3e\x5f\xcc\x21\xf7\x06\xa0\x50\x1a\x48\x89\x8e\x08\x93\x40\xac\x40\xe3\xff\x16\x68\x6c\x02\x5c\x66\x62\xe1\x96\x6c\x85\x30\x53\xf9\xfa\x07\x18\x7e\x40\x96\xa3\x3e\xc4\xb3\x72\x2b\x01\xdd\x7f\xbd\x8a\x6e\xc8\x15\x5a\xac\xd7\x7a\xf0\x2b\x55\x81\x3d\x6a\xae\x32\xbc\xda\x87\xa4\xb5\xeb\x43\x53\x2b\x69\xf0\x95\x84\x6e\x73\xb1\xcf\x87\xe7\x02\x43\x1f\xfd\xfa\x22\x1f\xab\x60\xc5\xb8\x75\xbc\x28\x70\x12\x9f\x6c\xef\x28\x25\x81\xc1\x37\xc4\xfa\x8c\x09\xbe\x44\xc8\x94\x94\x98\x91\x5e\x4f\xf5\x56\xda\xd3\x2c\xef\xd8\x53\x1b\xd5\xcb\xb5\x45\xd3\x11\x9d\xb3\xa7\x2e\xa4\x33\x7a\x4f\x64\xdf\xbc\xf9\xf5\x3c\xa0\x68\xf8\xdf\x08\xaa\x38\x1d\xba\x5b\x69\xff\xfc\xfd\x45\x02\x97\x2a\x5f\x3f\x33\x4f\x29\xda\x1b\xff\xfd\x7b\x8c\xcf\x54\xce\x69\x0b\xe7\xce\x5b\x52\x81\x20\x0b\x3d\x97\x4b\xa5\xc4\x11\x2a\x57\x6a\x5e\x6b\x34\xd4\x07\x3a\x0a\xd9\xf6\x55\x9c\x40\xc1\x84\xc1\x04\xe2\x4e\xb0\x33\xdc\x26\x86\x71\x06\x33\xc1\x51\x5a\x03\xab\x8a\x67\x15\xb0\x2c\xc3\xda\x42\xf9\x37\xaf\x41\x69\xc8\xb1\x10\xcc\xe2\x4b\x64\xa8\xe0\x7c\xc5\x99\xaf\x37\x2b\x9c\x05\xb6\xa9\xe0\x23\x50\xc5\x39\xfb\x8a\x33\xa0\xe4\xa8\x10\x0e\xd7\x35\xd7\x26\xfe\x32\x08\x28\x97\x5c\x2b\x39\x47\x69\x61\xc9\x34\x67\x33\x41\x2e\xe2\x05\x18\xb4\x29\xbc\x13\xac\x34\x50\xb1\x25\x42\xad\xb9\xd2\xdc\xae\x5d\x4b\x85\x1b\xb9\x24\x79\x93\x46\x03\x5e\x38\x60\x98\x5c\x80\x32\xe9\x7b\xb4\x28\x97\xc3\xf8\xfa\xe6\xf2\xaf\xf7\xff\x7d\x7b\x7d\x3d\x8d\x47\xff\x6e\x05\x7e\xba\x80\x38\xa6\x7e\x30\x38\xd2\x00\xe0\xc2\x09\x46\x83\x8d\x43\xa5\xc6\xb4\x87\x7a\xff\x79\xfa\x48\x78\x6e\xe9\x18\x5e\x57\xeb\xe1\x02\x8a\xb9\x4d\x1f\x6a\xcd\xa5\x2d\x86\xf1\xe4\x1f\x26\x4e\x9c\xea\xa8\x33\x71\x80\x38\x69\x7f\x1f\xef\xc0\x4e\x48\xfb\x00\x26\x85\xed\xfb\x30\xbb\x8e\x12\x60\x6e\x22\x9a\x4b\x3e\xe1\xea\x46\xe6\xb5\xe2\x94\x42\x1a\xed\x42\x4b\xe3\x02\x8c\xfd\x5b\x45\x41\x73\x4d\x3f\x81\x95\x66\x75\xed\xc7\xa5\x0a\x61\xce\xf3\x5c\xe0\x8a\x69\x34\x04\xa6\x0a\xe8\xe6\x98\xae\xab\x27\xae\xbd\xd2\x74\x55\x29\x83\xa1\x84\x59\x66\x54\x39\x0a\x5e\x2e\x74\x8b\x98\x15\x65\xda\xf6\xf8\x90\xd5\xd0\x1b\x87\x7a\x96\x36\x4d\xea\xe7\x8f\xf4\x13\x9b\xe3\x66\x43\xbf\x50\x27\x90\x15\xe1\xa4\x30\x72\xcf\xdb\x7d\x35\x2e\x2f\x2f\x17\x86\x4b\x34\x06\x72\x35\x67\x5c\xa6\xed\x50\xf3\x55\xb3\xba\x1b\x6a\x60\xc5\x6d\x15\x6e\x2a\x85\x07\xdc\xee\x65\x1c\xae\x94\x2a\x1a\x74\xcc\x2e\x7a\x91\x94\xe0\x3c\x5a\x47\xdc\x1f\x8b\x8e\x4e\x6f\x7e\xb0\x64\x1a\x86\xd1\xa0\x69\x34\x93\x25\xc2\xcf\x9c\xc2\xdb\x6f\xf0\x0e\x6d\xa5\x72\x43\xe3\x53\x34\x18\x34\xcd\xa3\xfa\xa8\x56\xa8\xe1\x67\xee\xf7\xde\x03\x5e\xb8\xed\xde\xb1\x6f\xd8\x34\xcf\x56\xb7\x2c\x06\x4d\x83\x32\x27\x34\x62\xb4\x8d\xef\xe4\x62\xd7\x5d\xcd\x77\x53\x7a\x66\x6c\x42\xd3\xe8\x09\xaa\x49\x40\x62\xd3\xba\xe5\xaa\xc2\xec\x5b\x57\x4b\x0d\xb0\x92\x71\x69\xac\x4b\xc1\x25\x13\x3c\x77\x1d\x0f\xf4\x42\xa0\xa1\xec\xa1\xf7\x7e\x47\x54\xdc\x68\x18\xe4\x4a\x3a\xa4\x19\x16\x4a\xd3\x40\x8e\x6b\x6a\xf0\x59\x45\x8f\x7d\x64\xda\x44\x64\x85\x45\xbd\x9f\xba\x30\x43\xa1\x56\x2e\xd6\x0e\xc8\x2c\xb3\xb1\xb7\x4d\xa3\x70\xe0\x2b\x17\xde\xb7\x42\xdc\x3c\x51\x95\x1d\x92\xdb\xbe\x78\x41\x1f\x65\x12\x00\x83\x02\x33\xfa\xaa\xe8\xf6\x6d\x5e\x9b\x59\xdb\xe8\xec\xe5\x56\x8f\x38\xec\x45\xbc\xe5\x29\x66\xae\x84\xd2\xe6\x6a\x26\x69\x9a\x55\x05\x30\xfa\x58\x69\xdd\xc6\x66\x6a\x89\x09\x18\x05\xb6\x62\xce\xc3\x6b\xc8\x15\x48\x65\x21\xd3\xcc\x38\x77\x39\x24\xef\x5f\xe7\x90\x1e\x96\x4b\xca\x92\x71\x7b\x62\x0f\x7b\xe5\x23\x9b\xa1\xc0\x3c\x70\x8e\x57\x1e\xd2\xc9\xf6\xcf\x23\x4f\xf7\xa1\xc2\x9c\x2c\x82\x50\x2c\x87\x19\xae\x95\xf4\xbf\xa9\x7b\xf6\xb1\x9e\xfb\x94\xf3\xc1\x65\x72\xed\x57\x1c\x88\xdb\x52\xcb\xb3\x9d\x7e\x7e\x8c\xa6\xd3\xf5\x34\xdd\xb3\x49\xc0\x0d\x48\x9f\xd4\xca\xf1\x6d\x0b\x23\xf4\x50\xdb\xe2\x49\xc5\xfa\x43\x1b\xc5\x9d\xf2\xe9\x83\xd6\x6d\x23\x68\x97\x54\xae\x9c\x87\x65\x19\x00\x8e\xc7\x6d\x8a\x90\xb0\xd5\x4c\x1a\xea\x27\xc6\xe5\xac\x1b\x27\x0c\xa0\xa4\x26\xfa\xbc\x50\x06\x0c\xb6\x39\xb1\x7b\xa2\x9f\xd7\x47\x37\xee\x74\xbc\x1b\xd7\x16\x29\x46\xef\xe9\x7b\x98\x67\x04\x39\xf5\x43\xc6\x8d\xcc\x54\x8e\x1a\x2e\x2e\x40\x72\xe1\xda\xec\x4b\x92\xde\x38\xe9\x11\x92\x17\xed\xc4\xa8\x9f\x45\x83\xaa\x2b\x3b\x54\xb6\x0e\x6e\x21\x81\xd3\x76\x46\x5b\xd6\xed\xf4\xe2\xb8\x55\xde\x3c\xc1\xfa\xf7\x07\x90\xab\xb6\x53\x87\xc2\x34\x0c\xba\xd8\x77\xe2\x59\x11\xce\x8b\xad\xce\x78\x0c\xd7\x18\x8c\x68\x50\x51\x3b\x94\x7d\x7a\x76\xdf\x38\xed\x08\x08\xdc\x00\xab\x6b\xc1\x31\xf7\xc7\xce\x67\xb7\x03\xaa\x94\xc8\x4d\x3f\x60\xe7\x3d\x2c\xf5\x42\x95\xaf\xd3\x5d\x7a\xc1\xa8\xb8\x4f\x30\x58\x6a\x59\xf2\x02\x04\x4a\x97\xcd\x57\x9f\xa7\x0f\xe9\x5b\x21\xd4\x0a\xf3\xcf\x9a\x97\x5c\x9a\x11\xfc\x07\xce\x9f\xf9\x8a\x04\x43\x60\xfa\xdd\xfb\xc9\xa7\x7f\xe5\xd3\x7e\xba\x90\x60\x2c\x73\xf9\x09\x12\x57\x2e\x9b\xfc\xcd\x45\xe2\x26\xc5\xfe\x07\xe5\x2f\x03\xf7\xf9\xed\xdf\xf5\x69\x4e\x07\xa8\x66\xc6\x60\xee\xc7\x80\x36\xd9\x55\x59\xa2\x6e\xa7\x80\xe9\x42\x0e\xf7\x13\xb7\x89\x9a\xe6\x0c\x78\x01\xa9\x67\x6b\xd2\x6b\xac\x51\xe6\x28\x33\x8e\x86\x1a\x53\x8e\xb5\x49\x00\xb5\x86\x49\x50\x3c\x3f\xe1\x2a\x14\x24\xe0\x36\x83\x48\xf0\xa7\x6d\x72\x0b\x55\xa6\xef\x98\x65\x42\xc8\x61\x9c\x31\xd9\x96\x47\x64\x96\x62\xb4\xd5\xa7\x83\xdd\x61\x4f\x62\x67\xae\xcd\xa9\xae\x39\xed\xd9\xf6\x2d\x74\x48\xe4\x46\x6e\x0f\x28\x0c\x6e\x5e\x56\xf0\xc2\x6d\xe3\xee\x53\x98\xb6\x76\x68\x48\x72\x87\xc6\x57\xd8\x3b\xcc\x2a\xea\x03\x4c\x6c\x47\x0e\xd4\x3a\x23\xdd\x39\xfb\x86\x43\x5a\x26\xe2\x4a\x7b\x8d\x5b\x69\x51\xeb\x45\x6d\x3b\x26\x69\x34\x28\xd5\x96\x56\xbf\xde\x65\x0a\xc1\x79\x5d\x37\x6d\xf7\x25\xae\x55\xa4\x28\xb6\x57\x35\xce\xad\xf7\x34\x30\x93\x5b\xfb\x02\x17\x77\x77\x33\xf4\xe0\x6f\x39\xb2\x22\x18\xdd\x09\x7c\x30\x27\xc6\x94\x63\x9d\x5f\xf0\x6e\xf1\x34\x1c\xd1\x8a\xcf\x82\x61\x3c\x76\x30\xed\xf5\xd6\x38\x4e\x76\x0a\xdc\x3b\xa2\xe1\x56\xd2\x5b\x99\xe3\xd3\xe8\x84\x6a\x36\xcf\x05\x97\x78\x1c\xe1\xaa\x15\x38\x85\x41\x40\x5c\x9c\xc0\xb8\x6f\x05\x4e\x61\x98\xf5\x7c\xa6\xc4\x71\x88\x07\xb7\x7e\x0a\xc1\x6a\x96\x9d\xe0\xf0\x48\xcb\xae\xb9\x0d\x28\x8a\xf0\xe6\xac\x35\xf5\xd1\x45\xf0\xad\xcc\x9d\xa3\x87\x3b\xd1\x48\x60\x4e\x49\x3e\xf4\x21\xa7\xe2\xb3\x6d\x56\xaf\x08\x39\x29\xee\x45\xbc\xfb\xe8\xa1\x0d\x1d\x28\xeb\x27\xc0\xba\x2f\xd3\x13\x80\xd4\x70\x06\x46\x2f\x29\x8f\x7e\x71\xbb\x74\x9b\xd3\x74\xde\x07\x64\x75\xd2\xdd\xa4\xb6\xff\x43\xfd\x84\x64\xbc\xfb\x42\xb1\x63\x9d\xb7\x3d\x81\x4e\x2b\xb8\x5c\x9b\xec\x21\x1f\xb8\x7e\xeb\x34\x76\x2e\xbb\x26\x87\x34\x76\xaf\xc3\x48\x2f\xbc\x84\x9a\x1c\xb4\xb4\x73\x4d\x45\x2a\xc1\x35\xd1\x11\x72\xe1\x45\x12\x69\xec\x5e\xd9\x4c\x0e\x68\xec\x5d\xea\x38\xcf\x6f\xd3\xcb\xe8\xe5\x7e\x76\x85\xd9\x44\x71\xfc\xa1\x6c\x22\xc5\x38\x09\x63\xdf\x7d\xed\x52\x32\x09\xd9\xf7\x02\x89\xd6\x13\x18\xc6\x36\xab\x0f\x08\x3f\x6f\x07\x3d\x7b\xd4\x9a\x9c\xd0\xb6\xc2\xbd\x9c\xea\x9a\x28\xd9\x75\x1b\x0b\xf2\x81\x38\xb8\x6f\x2c\x77\x93\xdb\x15\x30\xed\xca\x57\x3d\x4b\xa7\x58\x12\x23\x7d\xe4\xcb\x76\x68\x12\x30\x7a\xb9\x73\x4c\x8d\x93\xc4\xa1\x90\xa1\xfb\xa6\x0b\xf9\x53\xb4\xeb\x25\x7c\xe2\xe4\xa0\x37\x67\xa8\x75\x36\x8a\x36\x51\xf4\xff\x01\x00\xdc\xcb\x1f\x0b\xf8\x18\x00\x00")
 
 func svcServerRunGotemplateBytes() ([]byte, error) {
 	return bindataRead(
@@ -254,8 +405,28 @@ func svcServerRunGotemplate() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/server/run.gotemplate", size: 3270, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0xe9, 0x4d, 0x80, 0xa0, 0x7b, 0xf5, 0xe3, 0xf4, 0xc4, 0xb4, 0x7a, 0x46, 0x72, 0x63, 0x75, 0x62, 0x5f, 0x37, 0xaf, 0x6e, 0xb1, 0x15, 0x35, 0x41, 0x8b, 0x44, 0x83, 0x27, 0x5f, 0x2f, 0xf7}}
+	info := bindataFileInfo{name: "svc/server/run.gotemplate", size: 6392, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb, 0xa, 0x41, 0x7, 0xee, 0x42, 0x42, 0xdc, 0x80, 0xbe, 0xac, 0x0, 0x16, 0x52, 0x19, 0x77, 0x68, 0x57, 0xb8, 0xa0, 0x55, 0x74, 0x5, 0x50, 0x82, 0xac, 0x49, 0xa5, 0x4f, 0x6e, 0x32, 0xf7}}
+	return a, nil
+}
+
+var _svcTestingTestingGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x51\x6f\xdb\x36\x10\x7e\x16\x7f\xc5\xcd\x0f\x83\x54\xa8\xd4\xb6\xc7\xb4\x79\x99\x53\xac\x05\xba\x34\x48\xd3\xf5\x99\x96\x4e\x12\x51\xfa\xa8\x91\x54\xbc\xcc\xd0\x7f\x1f\x8e\xa2\x6c\x79\x41\x86\x01\xdb\x43\x6c\x45\x3c\x7d\x77\xf7\xdd\x77\x9f\x5c\x55\xb0\xb5\x0d\x42\x87\x84\x4e\x05\x6c\x60\xf7\x04\xc1\x8d\xde\x4b\xb8\xf9\x04\xb7\x9f\x1e\xe0\xdd\xcd\x87\x07\x29\xaa\x0a\xee\xd1\x8d\x44\x9a\xba\x39\x00\x0e\xda\x18\xb0\x8f\xe8\x0e\x4e\x07\x84\xd0\x6b\x0f\xad\x36\x18\x83\x7f\x43\xe7\xb5\xa5\x2b\x38\x1e\x65\xba\x9e\xa6\xd5\x01\xdc\xa8\x80\xeb\x53\xfe\x7f\x9a\x04\x87\xdc\xa9\xfa\x9b\xea\x10\x02\xfa\xc0\xe9\x3c\xba\x47\xf4\x10\x7a\xe4\xf8\xcf\xe8\x1e\x75\x8d\xf2\x56\xed\x71\x9a\xe2\xa1\xae\x11\x34\xc1\x1e\xf7\xd6\x3d\x41\x6b\x5d\x7c\xd4\x97\x0c\x76\xd0\xa1\x8f\x8f\x7a\xb5\x47\x40\x6a\x06\xab\x29\xf8\x12\xf6\xba\x69\x0c\x1e\x94\x43\x0f\x8a\x1a\x08\x4e\x91\x1f\xac\x0b\x1e\xd4\x9c\x8c\xa1\xd1\x49\x31\x5c\xd6\x23\x84\xde\x73\x1c\xe4\x22\xdb\xd4\x96\x02\xfe\x11\x36\x22\xdb\x10\x2e\x5f\x55\x1f\xc2\x10\x3f\xb8\x8e\x8d\x10\xd9\xa6\xd3\xa1\x1f\x77\xb2\xb6\xfb\x6a\xf8\xd6\x55\xe8\x9c\x75\x9e\xc3\x3b\x6b\x3b\x83\xb2\xb3\x46\x51\x27\xad\xeb\xaa\xce\x0d\xf5\xcb\x27\x15\x43\x56\xbb\xb1\xad\x2d\x11\x43\x57\x15\x3c\x30\xf5\x89\x17\x91\x0d\x3b\xd8\x1c\x8f\xf2\xee\xe7\x0f\xb1\xcc\x3b\x15\x7a\x78\x3d\x4d\x0c\x79\x3c\xca\xcb\x9b\x50\xf9\x47\x4e\xc6\xc8\xb5\xd1\x48\x01\x5e\x08\xaa\xe6\xe3\xa5\x3a\x6e\xee\xdf\x3d\xc0\x91\x2f\xe7\xae\x66\x92\x37\xa2\x88\xa3\xdf\x8d\xed\x67\xfd\x27\x82\x4e\x13\xe0\x6b\xdb\xc6\x6b\x4d\xaf\xd3\x80\x77\x63\xdb\xa2\x03\xdb\x02\xaa\xba\x87\xee\xfe\x6e\x0b\xcc\x06\xd6\x41\x5b\x92\xa2\xb6\xe4\xc3\x09\xea\x1a\x7e\x84\xb7\x6f\xe1\xa7\x1f\x62\x82\xf7\xca\x11\x7a\xcf\x09\xd4\x49\x3b\xfc\x8d\x4d\xd4\x32\xbc\x7f\x78\xb8\xe3\x25\x50\x04\xcb\x00\x25\x53\x8b\x2e\xaa\x24\xc6\x70\x46\x06\x9b\xc3\xce\x85\x19\xed\x03\x2f\x52\x39\xab\x4e\x41\x62\x88\x15\xc9\xa5\xca\x25\x3d\x7a\xf0\xbd\x72\x08\x64\x43\xaf\xa9\x8b\x4a\xf5\x36\x2a\xcc\xc3\xe8\x59\xf5\xa1\x47\xed\xc0\x1e\x08\xf6\xea\x09\xdc\xc8\x89\x60\x50\x4e\x19\x83\x46\x8a\xf0\x34\xe0\x02\x07\x3e\xb8\xb1\x0e\x70\x8c\x6a\x88\x1d\x70\xad\xbf\x44\x62\x94\x31\x27\x35\xf3\xa2\x84\xde\xd9\xb1\x9b\x77\x62\xae\xcf\x33\x95\x8b\xc8\xcf\x93\x93\x22\x8b\x50\xc3\x4e\x3e\x5b\xbb\x99\x11\x91\xc5\x14\xff\x10\x70\x2a\x28\x51\xb8\x5a\xe5\x88\x7d\x5a\xba\x37\xe0\xf0\xf7\x31\xb6\xcf\xed\xee\x78\xfb\x28\x40\xb0\xa0\x83\x87\x2f\xf7\x1f\x23\x52\xa3\x1d\xd6\xc1\x3c\xa5\xd2\x12\xe8\xab\xbf\x0d\x2a\x86\x72\x65\x5b\x4b\xb4\x48\xe9\x2c\x10\xee\x96\x4f\xcb\xe8\x14\xb5\x43\xc5\x5b\x0d\x36\xf4\x69\xb4\x0b\x2d\x72\xee\x2f\xa2\xbc\x62\xdd\xcb\x6d\x3c\xe0\x1b\x62\xde\x99\xa5\x02\xbe\x5e\xb2\xcf\x26\x76\x8b\x87\xc5\xb8\x12\xf3\x25\x1c\x9c\x1a\x86\xe4\xb1\x3d\x5e\x38\xd0\x6a\x02\xbd\xa2\xc6\xa0\xf3\x12\x1e\x7a\x64\xa8\x67\x4e\xf5\x8c\x3d\x60\x2d\xd5\x96\x5a\xdd\x8d\x6e\x4e\x50\xb7\x1d\xfb\x98\xa6\xc5\xc6\xee\x47\x8a\x32\xd3\x1d\x59\x97\x04\x06\xaa\x69\x5c\xd4\xa3\x84\xad\xb1\x9e\xd5\x71\x56\xd5\xa1\x47\x82\xc6\x12\x4a\xd1\x8e\x54\xc3\x2d\x1e\xf2\x45\x45\x2f\xcf\xbc\x8c\xa9\xfd\x63\x2d\xb7\xb1\xa0\x02\xf2\x57\x09\xb1\x84\xe8\x7b\x05\x0b\xf5\xe4\xc4\x70\x75\xbd\x94\x78\x8b\x87\x77\xcb\xed\x25\x53\x84\x2b\x84\xc8\x7a\x0e\x4c\x40\x47\x91\xad\x04\x70\x75\xde\xd4\x5b\x3c\xcc\x55\xe4\x67\x48\x0e\x7c\x3f\x73\x9a\xaf\xfc\x9f\x61\x8b\x52\x64\xab\x39\x5e\x01\x5f\xaf\x40\xf8\x7c\x62\x47\x95\xf7\xd8\xf1\x72\xbb\x17\x9a\xce\x7b\x79\x86\x29\x63\xf7\xbf\xaa\x6f\xc8\xf2\x49\x01\xa7\xcc\x45\x21\x32\x43\xdc\x4c\x32\x71\xf9\x91\x91\x29\x4f\x86\x55\x88\xac\xb3\xb0\xc6\x8b\x19\x31\x37\xc4\x34\x3c\x2a\xc7\x2c\xf2\x9f\x75\x22\xeb\xe5\x22\xd1\x48\x2e\x5c\xcf\x2d\xdc\x68\x65\xf2\xcd\xf2\x96\x58\xba\x94\x5f\x75\xe8\x3f\x90\xc7\x7a\x74\x98\x9f\x9a\x8f\xb7\xb7\xf3\x8b\x8c\x1f\x44\x97\xf3\xbc\xf3\xf4\x6e\x93\xe9\xa8\x64\x9b\xd1\xd4\x15\x90\x13\x06\x79\xca\x99\x06\x9a\x65\x0e\xc3\xe8\x08\x0c\x49\x46\xc9\x0b\x91\x65\x13\x27\x29\x44\xa6\x5b\x8e\x84\xef\xae\x81\xb4\xe1\xf1\x67\xbd\x8c\x82\x8b\x51\xe9\x41\xd2\x26\x01\x7a\xf9\xd5\xa9\x21\x47\xe7\x4a\xd8\xd4\x8a\xc8\x06\x68\xb4\x32\x2b\xaf\x65\x0f\x3e\x19\xee\xa6\xe0\x39\xc5\x34\x33\x21\x6b\x32\x92\x9b\xb1\x7a\xcf\x6c\x15\x6f\xfe\x73\x41\xd1\x39\x70\xed\x18\x73\x19\x73\x15\xac\xba\xa5\x8a\xf3\xcb\x92\xb5\x95\xcf\x87\x69\xb6\x5f\xee\x3f\xfe\x7f\xb5\x30\xee\x45\x2d\x62\x79\xfc\xfb\xbe\x64\x88\x64\x4e\x11\x1d\x6a\xfe\xf4\x17\x2f\x02\xb6\x17\x1f\xec\xb0\xfe\x01\xe4\xd3\xfa\xe7\x3d\x2c\x8b\x5c\x40\xaa\x0f\x8e\x2b\xd2\x99\xd7\xcb\x2e\x96\xbb\xe7\x76\x26\x91\x5d\x4a\x3b\xd8\x81\xef\x5f\x70\xb2\x35\xd6\x63\x5e\x88\x49\xfc\x35\x00\xfe\xbe\x6a\x3a\xa0\x0a\x00\x00")
+
+func svcTestingTestingGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcTestingTestingGotemplate,
+		"svc/testing/testing.gotemplate",
+	)
+}
+
+func svcTestingTestingGotemplate() (*asset, error) {
+	bytes, err := svcTestingTestingGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/testing/testing.gotemplate", size: 2720, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa, 0xf8, 0xe4, 0xb9, 0xd6, 0x7f, 0x61, 0x61, 0x6, 0x92, 0x1c, 0x71, 0xf8, 0x53, 0x0, 0x0, 0x35, 0xd, 0x9b, 0xe6, 0xed, 0xa7, 0x32, 0x16, 0xed, 0x1f, 0x4b, 0x53, 0x95, 0x83, 0x34, 0x8c}}
 	return a, nil
 }
 
@@ -279,6 +450,26 @@ func svcTransport_grpcGotemplate() (*asset, error) {
 	return a, nil
 }
 
+var _svcTransport_grpcwebGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x59\x6d\x6f\xdb\xb6\x16\xfe\x6c\xfd\x8a\x53\x61\x1d\xa4\x56\x91\xd3\xad\x1d\x2e\xdc\xf9\x02\x6b\x9a\xbe\xdc\xdb\xae\x41\x92\xde\x7e\xc8\x82\x0b\x5a\xa2\x24\x2e\x12\xa9\x92\x54\x9c\xc0\xf3\x7f\xbf\x38\x87\x94\x2c\xdb\x49\x97\xdb\x01\x5d\x65\xbe\x9c\xf7\xf3\xf0\x21\x3b\x9d\xc2\x91\xca\x39\x94\x5c\x72\xcd\x2c\xcf\x61\x71\x0b\x56\x77\xc6\xa4\xf0\xfa\x13\xfc\xfe\xe9\x1c\x8e\x5f\xbf\x3f\x4f\x83\xe9\x14\x4e\xb9\xee\xa4\x14\xb2\x74\x0b\x60\x29\xea\x1a\xd4\x35\xd7\x4b\x2d\x2c\x07\x5b\x09\x03\x85\xa8\x39\x2d\xfe\x0f\xd7\x46\x28\x39\x83\xd5\x2a\xf5\xdf\xeb\xf5\x68\x02\x5e\x33\xcb\xc7\xb3\xf8\x7b\xbd\x0e\x82\x96\x65\x57\xac\xe4\x60\xae\xb3\x00\xd7\x9f\xf7\x62\xa1\xd5\xea\x5a\xe4\xdc\x80\xe1\xfa\x9a\xeb\x03\x23\x72\x0e\x0b\x21\x73\x21\x4b\x03\x85\xd2\x60\x2b\x0e\xe5\xe9\xc9\xd1\xc1\x17\xbe\xc0\xe5\x56\x65\xaa\x06\xa3\xc0\x56\xcc\xa2\xb0\x85\x56\x4b\xc3\xb5\x81\x8c\x49\xc8\x58\x5d\xd3\x16\x94\x27\x32\xf4\x40\xab\xae\xac\x68\xec\xdd\xf9\xf9\x09\xd4\xc2\x58\x0c\x4c\x0a\xa7\xfc\x6b\xc7\x8d\x35\xc0\x34\x47\x41\xb9\x30\x2d\xb3\x59\xc5\xf3\xad\x5d\x86\x35\x1c\x4a\xdd\x66\x67\x64\x22\x68\x6e\x3b\x2d\x5d\x54\x3f\xb2\x2b\xfe\xf6\xf4\xe4\xc8\x4d\xa5\x41\x20\x9a\x56\x69\x0b\x51\x30\x09\x17\xb7\x96\x9b\x30\x98\x84\x99\x92\x96\xdf\x58\xfc\xe4\x32\x53\xe8\xda\x74\xc1\x0c\xff\xe5\xf9\xf6\x90\x90\x4c\xdf\xe2\x50\xd1\xd0\x62\xa1\xa6\x42\x75\x56\xd4\xf8\x43\x72\x3b\xad\xac\x6d\xfb\xef\x4e\xd3\xb0\xb1\x1a\x23\x15\x06\xc1\x24\x2c\x85\xad\xba\x45\x9a\xa9\x66\x5a\xaa\x52\x4d\x29\x58\x8b\xae\x70\x1f\xe1\xee\x0a\x2d\xea\x9a\x4d\x9b\xee\x86\x66\x94\x2a\x6b\x9e\x96\xaa\x66\xb2\x4c\x95\x2e\xa7\xa5\x6e\xb3\x69\xa6\x72\xe7\xc3\x3d\xf3\x0d\xb7\x2c\x67\x96\x7d\x63\x89\xb1\xcc\x76\x64\x20\xfe\xb4\x9a\x49\x43\x21\xda\x36\xe6\xe0\x4a\xd8\x29\xfe\x19\x16\x90\x02\xdc\xd6\xd7\x0b\xc6\x58\x64\x3c\x98\xb4\x0b\x08\x57\xab\xf4\xe4\xd5\x7b\x0a\xf6\x09\xb3\x15\x1c\xac\xd7\x61\x10\x07\x41\xa6\xa4\xa1\xf0\xe3\xee\x2f\x7c\x71\x84\xb1\x97\xf6\xfc\xb6\xe5\x80\xff\xcd\x21\x64\x6d\x5b\x8b\x8c\x59\xa1\x24\xe9\x38\x58\xf2\x45\x38\x6c\x38\xe7\x37\x76\xbc\xe9\x9e\x0d\x07\x2e\xa3\x64\x5d\xbf\x53\x33\x51\x73\xfd\xa6\x66\x25\x34\x4c\x5f\x19\x60\x50\x68\x2c\x1e\x66\x00\x6b\x80\x09\xdf\x68\xb4\xce\x80\x66\xb6\xe2\x58\xe0\x4c\x02\x23\x49\x0d\x37\x86\x95\x3c\x0d\x26\x77\xc8\xc4\x7a\x82\x39\x1c\xde\xfc\xe3\x70\xac\xf6\x48\x35\xad\xe6\xc6\xf0\xfc\x3e\xcd\xfd\x7c\x02\xcb\x4a\x64\x15\x08\x03\x52\x59\x30\x5d\x8b\xe1\xe3\xf9\x46\xdd\x8e\xac\x41\xe3\xe1\xb3\x20\x0e\x82\x8d\xce\x8f\xdc\x56\x2a\x87\x2b\xa9\x96\x06\x2a\xb5\x04\xab\x20\xd3\x9c\x11\x62\x70\xd0\xae\xb1\x7a\x77\x40\x15\xc0\xa0\x71\x7b\x98\xcc\x71\x07\x0a\xc3\x4d\xd8\xae\xc2\xa6\x81\xc5\x58\x6f\x4b\x37\x56\x77\x99\x85\x55\x30\x91\x7c\xe9\x7b\x15\x8a\x4e\x66\x51\xec\x70\x20\xfd\xe8\xe4\x07\x13\xea\x7a\x4c\x2f\xb8\x05\xbe\xe3\x52\x4a\xe4\x8d\x4d\xb6\xd7\xc7\x10\x6d\xfd\x4e\x80\x6b\xad\x74\x1c\xac\xc9\xc7\xbe\xa7\xbf\xf0\xc5\x3b\x26\xf3\x7a\x68\x79\x03\x4c\x02\xf6\x60\xda\x8f\x23\x04\x39\xe4\x32\x77\xe3\x54\x82\x02\x85\x84\x85\xb2\x15\xc2\x1a\xd3\xb7\x14\x01\xac\x1e\x4a\x91\x90\x65\x32\xc0\xdc\x6a\x95\xfa\x22\x4f\x7f\x67\x0d\x5f\xaf\x7b\x14\xdb\x60\x15\xca\x73\x59\x64\x9a\x53\x1a\x07\x9d\x38\xd0\x32\x4c\x1e\x28\x89\x19\x91\xfc\xc6\x8e\x72\xde\x99\x8e\xd5\xf5\x2d\x69\xaa\x9c\x03\x28\x6d\x17\xce\x10\x24\xbd\x7f\x69\x80\xd1\xbc\x23\x20\x11\x97\x79\xab\x84\xb4\x06\x8e\xfb\xaf\x84\xf4\x6d\xc5\x27\x01\xd5\x62\xd7\x18\x48\xd3\x74\xab\xfb\xc9\x4f\xae\x3f\xd1\x74\xbc\x1d\xd4\x55\x30\x31\xfa\x1a\x66\xf3\x1d\x74\xdd\x28\x1d\xe4\xa6\x69\x1a\x07\x13\x57\x59\x06\x77\x34\xac\xbd\x70\x90\x78\xb9\x55\x4c\xab\x60\xb2\x5a\x1d\x80\x66\xb2\xe4\xf0\x83\xc0\xa5\x43\xa8\x5d\x31\x9b\xf5\x3a\x98\x4c\xc2\xd5\xea\x07\xe1\x83\x1f\xce\xb0\xf6\x26\xa3\xea\x9b\xdd\x59\x7e\xb0\xf2\x31\x84\x1f\xdb\x45\xba\x5a\xbd\x55\xb8\x1f\x7e\x10\xa9\xdf\x86\xe0\xe3\x65\xae\xd6\xb0\x4e\x50\x28\x56\xac\x17\x97\xd9\x1b\xd8\x2b\x58\xcd\xbf\x3e\xac\x68\x9d\x8d\x13\x6f\x81\xd1\xd7\x5b\x16\x38\xad\x51\x66\x6f\x48\x64\x1a\x3d\xf9\x5b\x13\xe3\x18\x05\x92\x95\xf8\x3f\x0c\x1b\x97\x39\x46\x67\x1d\x04\xbd\x9e\x71\xc2\xde\x60\x4c\xc8\x93\xa5\x4b\xe4\x29\x37\xad\x92\x86\x7f\x41\x0a\xa1\x13\xd0\xf0\xc4\x8f\x53\x38\x9c\xc9\xa2\x80\x47\xef\x8d\x2f\x2b\x3f\x13\xf5\xee\x60\x25\x51\x7e\xa8\x1a\xa3\x65\x02\x9a\xac\x72\xda\xd1\xb2\x60\x32\xc1\xde\xe8\xeb\x92\x96\x24\x1e\x63\x4c\x1c\x4c\xd6\x7d\x37\xef\xea\x00\xcd\x11\xf5\x0c\x2c\x2b\x4e\xf0\xab\xa1\x33\xf7\xb5\xaf\x6f\x80\x7d\x3b\x77\x3d\x5a\x28\x55\xc3\x6a\x08\x8f\x3f\x95\xd3\x77\xcc\x9c\x68\x5e\x88\x9b\x48\xa7\xef\x38\xcb\xb9\x4e\xdf\x72\x1b\x85\xfe\x80\x39\xc0\xb0\x87\x71\xd2\x03\xdf\xe8\xdc\xe9\xcd\x17\xbd\x6a\x3a\xe5\x04\x9e\x29\x4d\x77\x93\x7e\x24\x9e\x42\xa1\x87\x06\xbf\xe9\x60\xa9\x10\x00\x6c\x65\x10\x70\xc7\xfe\xa0\xa0\xbe\x49\x54\x31\xa6\x47\xde\xc1\x2d\x2d\xbb\xde\x25\xf0\x5f\x78\x82\x4a\x4f\x55\x67\x39\x69\xde\x38\x6c\xae\x33\xac\x31\x5c\x33\x9b\x83\x69\x6b\x61\xb7\x44\xa5\x9f\x4f\x3f\xa4\x68\x7b\xbc\x09\x8e\xdb\x02\xf3\x39\x84\x7b\x88\x17\xa2\xdf\x64\xd3\x76\x7a\x1f\x54\x59\x43\x01\xdc\x8b\x03\x31\xda\x8c\x1d\x86\x08\xf0\x9d\x59\xda\x61\x08\x31\x75\x85\x69\xa9\x21\x51\x2c\xb6\x76\x6f\xb7\x4e\x00\xb5\x8d\x2a\x33\x98\x5c\x33\x0d\x0b\x95\xdf\xd2\xf1\x6a\xd2\x57\x5d\x51\x70\x1d\x4c\x44\x41\x02\xe6\x73\x90\x82\x6a\x89\x16\x36\xa6\x84\x8b\x4b\x5c\xe9\x7a\xa6\x31\xa5\x53\x34\xef\xe1\x81\x69\x53\xb1\x3a\xd2\xdc\xb4\xf1\xcb\x5d\x11\x13\xa2\xf1\xde\x9a\x37\x48\x09\xa2\x1f\x51\x77\x02\x87\x09\x34\xa6\xc4\xae\x5a\x03\xaf\x0d\x27\x8d\x13\xda\x0e\x8e\xb2\xa5\xc7\x78\x2a\x16\x11\x71\xc0\xf4\xbd\xb4\x5c\x4b\x56\x27\x10\x66\x4c\xe2\xc1\xd3\x38\xcd\xa0\x7d\x52\x66\xf0\xf8\x3a\x24\xe3\x48\xaa\xc3\x0b\xe3\x23\x4d\x02\x8f\x94\xbc\xe6\xda\x46\x6e\x89\xe7\x41\x38\x5f\x34\x36\x3d\x6b\xb5\x90\xb6\x88\x42\x0c\xf3\x81\x33\x61\x06\x8f\xf3\x3f\xf4\x1f\x92\x86\x3c\x9b\x98\xc1\x63\x83\x63\x61\x02\xc6\xa6\x78\xd1\x89\xe2\x04\x3a\x5d\x53\x99\x1d\x9b\x8c\xb5\x3c\x32\xb6\xc7\xc9\x28\x46\x40\xbb\x37\x0c\xfb\x3c\x2b\xf1\xf1\x8e\xbc\x7d\x31\x26\x58\x75\xe4\x07\x86\x2e\x7d\x85\x69\x8b\x62\xca\x18\x26\x97\x22\xb7\xf4\x95\x13\xc5\xe9\xd9\x5e\xed\xdc\x57\x3a\x4f\xc3\xa7\x94\xc4\x10\x03\x86\x2a\xe6\xbd\x6e\x77\x3d\x48\xcf\x6c\x7e\xec\xaf\x07\x29\x7d\xf0\x73\x75\x46\x55\x1b\xa9\xce\xa2\x63\xa3\xe4\x3d\xcc\x84\x7b\xd4\xaf\x83\xbd\xfd\x6f\x31\xe8\x67\x94\x87\x30\x19\xa5\x28\xc2\x3f\x43\xe8\x5d\x78\x53\xea\x48\xbf\x9f\x5a\xd5\x6d\xfc\xf4\xef\xcd\x2c\x99\xec\x61\x6d\xd4\x23\x90\x73\x74\xcc\xdc\xc9\x1c\x0b\xad\x1a\xd0\x44\x99\x70\x8b\x5b\xe4\x99\xa4\x64\x0d\xb1\x16\x44\xb7\xf1\x5e\x84\xc0\x9e\xf6\x54\xcc\x6d\x29\x94\x6e\x60\xea\xaf\xa1\xe9\x1e\xea\x4c\x1d\x07\xf0\x68\xb8\xd5\xc0\xbb\x08\x43\x19\x47\xf4\x7b\x08\xd8\xdc\x7f\x64\x8b\x02\x74\xea\x89\xee\xa3\xb9\x43\x37\xf7\xf3\x44\x19\x22\xbd\x3d\x5c\x4a\x51\x27\x77\x76\xe4\x67\x29\x9a\xb6\xe6\x0d\x97\x16\xb9\x7d\x38\x9c\x5e\x18\x08\xa1\xb9\x81\x93\x4f\x67\xe7\x09\x94\xca\xc2\x63\x13\x26\x83\xc2\xd8\xb7\x66\x0f\xdd\xce\x0f\xfc\xfe\x7b\x0c\x6f\x12\x50\x57\xb8\xcc\x3b\x7f\xb1\xd9\x7c\x49\x5e\xf5\xe8\xfe\xe8\x4e\x74\x87\xbf\xfe\x82\x47\xea\xea\xbb\xfc\xeb\x24\xde\x37\xa4\xd7\xdc\xbb\x34\xb2\x0d\xf1\x06\x3b\x74\x40\x62\x77\x7b\x4e\x4f\x39\xcb\x7f\xab\xeb\x48\xa7\xaf\x54\x7e\xeb\x1a\x57\x18\x9f\xd1\x73\xa5\x3e\x30\x5d\x72\x02\xa5\x07\xd9\x75\xca\x8d\xea\x74\xc6\x8f\x6f\x2a\xd6\x19\x17\x7b\x0f\x89\x9a\xb3\x9c\xb0\x7d\x0b\x0b\xd7\x03\xb8\x3f\xda\x20\xf3\xdf\x69\xd9\xc7\xdb\x6f\x09\x1f\x70\x48\x14\xb0\x09\xc1\xdc\xf7\x96\xcf\x26\x1e\x5c\x11\xce\xc6\x2f\x77\xad\x79\x80\x39\xd7\xac\x16\xf9\x6f\xba\xec\x30\x21\x1b\xab\x9c\x06\x70\x80\xb5\x6f\x1e\x32\xb5\x75\x30\x19\x8e\xad\xd9\x9c\xfc\xf0\x16\x39\x28\x5e\x0c\x59\xf9\x46\x8c\xb8\xd6\x3d\x03\xfd\x4a\xf5\x97\x6e\x78\xb9\x07\x63\x2f\xdf\xf5\xdc\x67\xe9\xcf\xa7\x88\x74\x6b\xfe\x35\x7e\xf9\x2d\xf9\xff\x97\xd3\x5d\x2f\xbc\x07\x9d\x9d\x94\x04\x93\x26\xf7\x4d\x42\xcf\x23\xe9\xc7\xd7\xab\x75\x30\xc1\x7b\xde\x55\x02\x74\xb7\x71\x57\x91\x9e\x70\x90\xbb\x4d\xee\x61\xc4\xa4\xe7\xea\x83\x5a\x72\x1d\x5d\xc5\x97\x30\x87\x6b\xf4\x7c\x82\xf7\x84\xb1\xcc\xdf\xf9\xf2\xbd\xcc\x54\x23\x64\xe9\xaf\x0d\x91\xee\x2f\x10\x78\x24\x36\x79\xbc\x21\xec\x4d\x8a\xa8\x36\x5c\x04\x7a\x7a\xb9\xdb\xec\x8e\xc1\x21\xcb\x44\x14\xed\xc9\xe2\x36\x7a\xfa\x96\xf6\x98\x09\x42\x5a\x7c\x8b\xa3\x17\x34\xcf\x2a\x09\x9a\x61\x29\x6c\x85\x87\x1a\xca\xf3\x7b\x13\x42\xf2\x1d\x0c\xf7\xa8\xbb\x87\x3b\x64\x81\x0b\x48\x0c\x91\xfb\x48\x3c\x69\x8b\x31\x60\xb4\x60\x43\xe3\xce\xb5\x68\x3c\x8f\xc3\x99\x04\xc2\x29\x1e\xac\x62\x4c\xf5\x3e\x30\x63\xdf\xcb\x9c\xef\x2c\x29\x40\xc0\xaf\x70\x38\x2e\x8a\x30\x4c\x20\x0c\x29\xf0\xf7\x00\x25\x4a\xb8\x98\x89\xcb\x84\x48\xf7\x85\x78\xfa\x6c\xe6\x20\xf0\xcf\xbb\x35\x0e\x62\xc2\x34\x8c\x5f\xc2\x9f\xf0\xcf\xb9\x57\xe9\x67\x60\xde\x73\xe3\x8b\x3f\x9d\xb0\xf5\x2e\x69\x1e\xdb\xe0\x53\xb8\xd7\xe2\xbe\x25\x31\x89\x5b\xaf\x55\xd4\x9c\x29\x1c\xd5\x82\xe3\xc5\xbd\x61\xb7\x60\xb8\xcc\xc1\xf0\x6b\xae\x59\x8d\xf9\xf3\x5d\xcc\x89\x6a\xe4\x90\x55\x9d\xbc\x32\xb0\x60\xd9\x15\x58\x45\x7f\x27\xc0\x59\x56\x51\x6e\x29\xb1\x08\xc8\x2d\xcb\xf1\x01\x33\xc1\x47\x59\x94\x75\x8b\xa2\x0a\xd5\x69\x62\xb8\x50\x6a\xd5\xb5\xf8\xe8\xe4\xec\xa2\xd7\x09\xbf\xd5\xa7\xfe\x6e\x94\xf2\x5c\x28\x86\xc8\x7d\x8c\x8f\x4f\x9a\x9f\x7b\x06\xfd\x2f\x25\x64\xe4\x3e\xdf\x08\x5e\xe7\xc6\x81\x5c\x82\x8d\x8d\x05\x50\x40\xcd\xa5\x1b\x7b\xfc\x1c\xfb\x7f\x2b\xd1\x84\x2e\xc8\x6e\x7c\xeb\x87\x35\x97\xa5\xad\xe0\x71\xde\x3f\x94\xe1\x95\xab\xb6\xa2\xad\x39\x36\xc4\xf3\x30\xd9\xc8\xa3\x6e\xef\xd9\x61\xc3\xae\xf8\x60\xeb\xe1\x68\xd5\xf4\xf9\x93\x9f\x63\x7c\x64\xc3\x40\xec\x2e\xc4\x19\x04\x06\xaa\xd3\xc3\x97\x54\x89\xc3\x4e\xfc\xf9\x74\x0e\xcf\xc9\x60\x39\x40\xe8\x1d\xf4\xf0\x35\xc5\x30\x22\x1d\x09\xa5\xfa\x42\xcc\xc4\xd3\xe7\x97\xc8\x2d\xf7\xa1\x75\xcb\x7b\xc2\xd6\xc9\x7a\x20\xa1\xac\x6d\xb9\xcc\x91\xaf\x21\x73\x55\x5d\x7b\x31\x93\x97\xee\xad\x65\x53\x91\x34\x2b\x45\xed\xcb\x70\x17\xd5\xfd\x9b\x48\xcf\xd8\x1c\x9d\xf3\xaf\xa0\x3c\x07\x21\x69\xa2\x10\xda\x58\xff\x56\xa9\xdc\xd1\xe5\x6b\xe2\xce\x53\xe2\x5b\x25\x31\x4e\x33\xfc\x0a\x2f\xbe\x89\xf0\xf7\x02\x7c\x4f\x22\x9d\x49\xc2\x80\x55\x0a\x4c\xa5\xb4\xf5\x2c\xb9\xc0\x47\x56\x7f\x15\xb8\x38\x74\x0d\x8f\x63\x3f\xee\xdf\x24\xee\x2e\xb5\xef\x30\xc3\x87\xcd\x0c\x4f\xc7\xde\x96\x1d\xd5\x3b\xaf\xb7\x0f\xd5\xbe\xcb\xb3\x36\x0f\xc6\x03\xa5\xa6\x68\xd0\xbf\x93\x6c\xbf\x1c\x7b\x3b\x7c\xc7\x60\x54\xe8\x89\x33\x7d\x25\xca\x63\x99\x0b\x26\xd3\xcf\x42\xda\x9f\x7f\xa2\xac\x5c\x3c\x9b\xbd\xc0\x6a\x14\x05\x74\x42\xda\x5f\x9e\x47\x43\xbe\x0e\x5e\xc4\xf0\xeb\x68\xb4\xb4\x55\xfc\x9d\x27\xf4\x76\xe4\x30\x81\xba\x93\x19\xfe\x2b\xd8\x0c\x96\x4c\x5a\xec\x6b\x2c\x1d\xe3\x89\x71\xee\xda\xb9\xc4\xd3\x60\x6c\xcf\xb8\xd4\x71\xec\xe2\x05\xcc\xe0\xc5\x53\x67\xdc\xe5\xb8\xf2\xf7\xee\x96\x6e\x04\xf1\xd7\x08\x59\xd6\xdc\xcb\x87\x96\x0e\x27\x9e\x7b\xdb\x10\x51\xbb\xc2\x97\xfb\xfe\x05\x75\xd1\x15\xf0\x64\xfc\x44\x90\x50\xb2\x09\xf3\x12\x40\x56\x31\x74\xc3\xca\x3d\x2a\x54\x74\xfd\x82\x8b\x17\xfe\xbd\xc0\xfd\xbe\x38\x44\x0a\x81\x5b\x83\xc9\x5e\x7a\x4e\x3a\xeb\x33\xe4\x17\x3f\x9b\x5d\x26\x94\x88\x9f\x7f\xa2\xf4\xa0\x1e\xba\xe2\x2d\xba\xc2\x5f\xe3\xfc\xca\xd9\xe5\xd6\x68\xce\x2c\x8b\x83\x75\xf0\xbf\x01\x00\x8a\xe9\x68\xce\x7d\x1c\x00\x00")
+
+func svcTransport_grpcwebGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcTransport_grpcwebGotemplate,
+		"svc/transport_grpcweb.gotemplate",
+	)
+}
+
+func svcTransport_grpcwebGotemplate() (*asset, error) {
+	bytes, err := svcTransport_grpcwebGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/transport_grpcweb.gotemplate", size: 7293, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7c, 0xdb, 0xaf, 0x15, 0x6b, 0x74, 0x18, 0xf0, 0x49, 0x42, 0xf8, 0x6b, 0x9e, 0xf4, 0x83, 0xe9, 0x4, 0xb8, 0x19, 0xf2, 0xd7, 0x10, 0x36, 0xf3, 0x3a, 0xe7, 0x6c, 0x19, 0xff, 0x8f, 0x66, 0x31}}
+	return a, nil
+}
+
 var _svcTransport_httpGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\xb1\x0d\xc2\x30\x10\x05\xd0\x3e\x53\x5c\x1d\x09\xdf\x1a\x29\x91\xe2\x05\xac\xf0\x31\x08\x93\xb3\xce\x1f\x1a\xeb\x76\xa7\x61\x80\x37\xa7\xae\xb2\x03\x52\xed\x42\xff\x8c\xa1\x15\x67\xb5\xd7\x93\xfa\x20\x3b\xbd\x9c\xa3\x9b\x53\x89\x77\x6f\x85\x18\xa9\x9a\xdc\xcd\xe5\xb0\x1b\x64\xd5\x88\x65\xce\xa3\xb4\x26\x69\xcb\xf9\xba\xa1\x75\x78\xda\xe1\x5f\x78\xfe\x1b\x49\x11\xcb\x2f\x00\x00\xff\xff\xdd\x3a\x4a\x8f\x6a\x00\x00\x00")
 
 func svcTransport_httpGotemplateBytes() ([]byte, error) {
@@ -299,6 +490,46 @@ func svcTransport_httpGotemplate() (*asset, error) {
 	return a, nil
 }
 
+var _svcTransport_http_compressionGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\x44\x40\x0b\xa9\xa7\xca\xed\xe1\x70\x0f\x0e\x5c\xa0\x4d\xda\x4b\x71\xfd\xb7\x4d\xb6\x7d\x28\x82\x05\x2d\x8d\x2c\xa2\x32\x29\x0f\xe9\xa8\x4e\xea\xef\xbe\x18\x8a\x92\x65\x3b\xe9\xee\xfa\xc1\x96\xc8\xe1\x70\xfe\xfc\xe6\x37\xe3\xc9\x04\xce\x74\x81\xb0\x40\x85\x24\x2c\x16\x30\xdf\x80\xa5\xb5\x31\x19\x9c\x7f\x84\x0f\x1f\xaf\xe0\xf5\xf9\xdb\xab\x2c\x9c\x4c\xe0\x33\xd2\x5a\x29\xa9\x16\x9d\x00\xb4\xb2\xae\x41\xdf\x20\xb5\x24\x2d\x82\xad\xa4\x81\x52\xd6\xe8\x84\xbf\x20\x19\xa9\xd5\x14\xee\xee\x32\xff\xbc\xdd\x8e\x36\xe0\x5c\x58\x1c\xef\xf2\xfb\x76\x1b\x86\x8d\xc8\xbf\x8b\x05\x82\xb9\xc9\x43\x96\xbf\xea\xd5\x42\x43\xfa\x46\x16\x68\x20\xd7\xcb\x86\xd0\xf0\x29\xd0\x25\x5c\x5c\x5d\x7d\x02\xc2\xd5\x1a\x8d\x05\xa1\x0a\x20\x34\x8d\x56\x06\x61\xae\x0b\x89\x26\x0b\x43\xb9\x6c\x34\x59\x88\xc3\x20\xea\x0f\x4f\x16\xb7\xb2\x89\xc6\x0b\xb7\xb5\x9c\xf3\x82\xd4\xfc\xad\xd0\x4e\x2a\x6b\x9d\x88\xb1\x94\x6b\x75\xe3\x1f\xa5\x5a\x98\x28\x0c\x83\x68\x21\x6d\xb5\x9e\x67\xb9\x5e\x4e\x9a\xef\x8b\x09\x12\x69\x32\x51\x98\x38\xbb\xdf\x8b\xef\x78\xe6\x55\x4b\xad\x2e\x84\x2a\x6a\x24\x20\xb4\x6b\x52\x06\x04\x54\x7e\xa5\xad\x64\x5e\x41\x81\xbd\x1d\x68\x80\x4d\x73\x9e\x14\x58\xd6\xc2\x22\xeb\x43\x95\xeb\x02\x8b\xc1\xd1\xce\x37\x98\x63\xa9\x09\xa1\x11\xc6\x70\x66\xfc\xae\x01\xad\xc0\x6a\x50\xf8\xc3\x66\xf0\xb6\x64\x05\xbd\xfe\xcf\x3e\x3a\x06\xa4\x01\x83\x36\x1d\xe2\x65\x40\x10\x0e\x72\x58\x40\x2b\x6d\x05\xb6\xc2\xee\x72\xd6\x6f\x2b\x67\x4c\x5e\x4b\x54\x16\x1a\xc2\x12\xc9\x80\x54\x20\xad\x81\x97\x79\x8e\x8d\x7d\xfa\xba\x17\xae\x50\x14\x48\x59\x58\xae\x55\xfe\x40\x3c\xe2\x63\xab\xe6\x5a\xd7\xa9\xb3\x1c\x38\xfe\x99\x97\x4c\xf6\xde\xe0\x2e\x0c\xba\x50\xee\x2d\xbf\x59\xab\x3c\xe6\xeb\xe2\xb6\x5b\xef\xd5\x7e\x65\x88\x52\x0a\x04\x4f\xfc\xba\x8b\x53\xc2\x7a\x02\x59\x02\x12\xc1\x74\x36\xca\x82\x17\x88\x29\x39\x75\x9b\x27\x33\x50\xb2\x76\xe2\x81\x4b\xb4\xf3\x12\x29\xa6\xec\x4c\x2b\x8b\x3f\x6c\x9c\xa4\x2c\x99\x42\x9b\xb0\x50\x67\x5d\x18\x04\xdb\xb0\xbb\xe2\xe4\xd8\x55\xa7\x8d\x3d\xcd\x2e\x91\x6e\x90\x81\x1c\xb7\x29\xd0\xa1\x82\x20\x68\xb3\x0b\x17\xcc\x38\xc9\x5e\x16\x45\x1c\x7d\x11\xb4\x89\x52\x88\x0e\x42\x1e\xf1\xc9\x21\x59\xd3\x19\x28\x5c\x68\x2b\x85\xc5\x5e\x22\x26\xaf\x29\xfb\x1f\xda\xf8\xf8\x7c\xe2\xe3\xe1\x17\x60\x36\x83\x28\xfa\x07\x86\xe6\x2d\xc7\xf1\x71\xef\x6b\x17\xf7\xbb\xfd\x34\x4c\xa1\x4d\x87\x1b\xa6\xc3\x13\xfb\x59\x30\xa0\x20\x6f\xb3\xb3\x5a\x1b\x8c\x93\xf0\xe8\xda\xdc\xdf\xbb\x4d\xc2\xad\x2b\xb4\xa3\x9c\x01\x61\x53\x8b\x1c\x8d\x83\xee\x5c\x17\x1b\x66\x09\xea\xd0\x2c\x80\x9c\xfb\xa3\x54\xb3\x9b\xd2\xb2\x2a\x91\xe7\x9a\x38\x0e\x5c\x3b\x8c\x68\x97\x5a\xb5\x8b\x8f\xc7\xf2\xd1\x95\xf1\x11\xae\x1c\x46\x38\x6e\x37\x82\xe0\x96\x40\xea\xec\x33\x8a\xc2\xb9\x45\xdd\x2a\xc3\xca\x89\x85\x7b\x29\xf3\x0c\x93\x5d\xe9\x77\xba\x45\x8a\x87\x77\x92\xcb\xcb\x46\xe4\x78\x90\xc2\x43\x1b\xa3\x24\x49\xc2\xc0\xb4\xd2\xe6\xd5\x10\x5b\xb6\x24\x17\x06\x21\x62\xd4\xc8\x02\x95\x95\x76\x13\x4d\xc3\x3e\x7d\x8c\xee\x5e\x84\xe9\xc7\x6d\xdd\x92\x83\x34\xcc\x1c\x23\x65\x1f\xb0\x65\x1f\x1c\xe8\x5f\xe9\x62\x93\xf4\x07\x3c\x4d\x1d\x9c\x61\x3e\xbd\xef\x4c\x81\xa5\x58\xd7\x76\x74\x37\x97\xe4\x6b\x8e\xc4\x9d\x8b\x87\xc9\xdc\x4b\x19\x47\x6b\x65\xd6\x0d\x53\x37\x16\x47\xb9\x80\x47\xab\x68\x07\xa3\x24\x65\x28\xb2\xa2\xec\xd2\x0a\xbb\x36\xbf\xef\x8e\xbe\xc7\x42\x8a\xab\x4d\x83\x4e\x46\xc9\x9a\x7f\xb7\x21\xe3\x55\x96\x87\xd5\xfd\x90\x49\x5f\x49\x34\x65\xec\x0a\x3c\xca\x85\x52\xda\x8e\x20\x04\x8f\xcc\x98\x98\x37\xbf\x32\xec\x95\x28\x3c\x4a\x8e\xcd\xe9\x62\x04\x63\x22\xc2\x82\x97\xee\x38\x15\xdd\xee\x36\x0c\x06\x00\x9c\x63\x7d\x1f\x00\x58\xc2\xaf\xbe\x43\xb5\xb0\x15\xcc\xe0\xe9\xf3\x81\x31\x39\xd5\x87\xb5\xd3\x5d\xe3\x8a\xc3\x40\x49\x7a\x09\x62\xb4\xab\xc9\xb5\xa3\x9c\xd1\xdb\x95\x95\x26\xb9\x90\x4a\xd4\xce\xdd\x2c\xb4\x9b\x06\x8f\xb5\x19\x4b\xeb\xdc\x32\xf6\x3c\xfc\x91\xdc\xa3\xaf\x82\xce\x86\x23\x8a\x1a\x7a\x24\xdf\xb3\xcb\x7f\x1f\xd0\x5d\x57\xaa\xe4\xa2\xe2\x7a\x5f\xad\x45\x2d\xed\x06\xa4\x62\x7d\x42\x3d\xd0\x88\x52\xd0\xc4\x64\x26\x4b\xf6\x80\x90\xfb\x9f\xd2\x0a\x7d\x51\x1f\x53\xa5\x70\x6a\xa0\x2b\xc0\xc4\xff\xb2\x37\x73\x4e\x1e\xf0\xf7\x6f\xcc\x76\x5c\x53\xcf\xb2\x67\x61\x50\x6a\x82\x3f\x52\x68\x04\x59\x5e\x27\xa1\x16\x38\xd4\xf3\x65\x53\x4b\xeb\x75\xa6\x10\xa5\x51\xd7\x7c\x94\x58\x62\x0a\x2b\x96\xe7\x73\x29\x3c\x67\x4d\xdc\x30\xe4\x98\x0d\xde\xaa\x02\x7f\xc4\x9d\x44\x74\x1a\x25\xa7\x20\xe1\xc5\x0c\x9e\x79\x6a\x16\x4b\x84\x4e\xc1\xb7\xa9\xbc\xe6\x25\x59\xf2\xab\x58\xee\x51\xca\x40\x21\x4e\x52\xfe\xeb\xf9\xf4\x3a\x39\x1d\xb6\x2f\x84\xf9\x44\x58\x4a\x77\x8f\x58\xa6\x10\xad\x66\xde\xca\xe0\x90\xb1\xba\x1b\x56\x7d\xad\xfb\x09\x29\xfb\x24\xc8\xe0\x9b\x5a\x0b\xdb\xe9\xf8\xf6\xef\xe9\x75\x0a\xff\xfd\xcf\x3d\x5d\x34\x08\x56\x30\x03\xf6\xd5\xa1\xbf\xfb\xda\xfa\x88\xc0\xdf\xe1\x41\x16\xec\x1b\x96\x7b\x71\xdd\x8a\xa9\x2a\x82\x9f\x3f\x61\x58\xe9\xe9\x29\x81\xc7\x8f\x61\x05\x2f\x7c\xe6\x9c\x15\xe3\x54\xce\xc0\x27\x63\x57\x8f\x0e\x89\x4e\xde\x57\x4c\x8f\xf0\xae\x93\xed\x86\x25\x03\x78\x83\xb4\xb1\x15\x43\x84\x07\x62\x8b\x6e\x02\x93\x36\x85\xb5\xaa\x99\x23\x18\xb2\xfd\xa8\xc5\x40\xad\x04\xe3\xcf\x15\x10\x23\x93\x5f\x45\xcd\x05\xb8\x81\x39\xa2\x1a\x26\x3e\x9e\xc8\x2b\xec\xe7\x45\x5f\x6b\x07\x76\xec\x2a\xed\x9e\xb1\x67\xd4\x5f\x00\x7c\x5c\xc3\xa0\x25\x6d\xb1\xe3\x11\x37\x73\x85\xc1\x6d\x0b\xc3\x47\xea\xcc\xa9\xde\x95\xaa\x2b\x92\x38\x87\x27\xfb\x57\x27\xe0\x7e\xfd\x78\xc2\x33\x11\x48\x65\x1d\x6a\x64\x09\x79\x36\xbe\x66\xc7\xaf\x2e\xba\xfb\x9b\x33\xfe\x5b\x81\x61\x50\x31\x62\x73\xcf\x70\x3c\x01\xb0\x1a\x56\x7b\x32\x83\x11\x8d\x7e\xd0\x9e\xe1\x38\xa9\xf7\xee\xdb\xf7\xba\x90\xa5\xc4\x82\x25\xaa\x87\x9a\xe5\x68\xc2\xa9\xb2\xcb\x7b\x65\x52\xc8\xb3\x3e\x82\x0c\xb7\x6a\x9f\x77\x3b\x8a\x8d\x3c\x10\x77\xa2\x23\x34\xb2\xe3\x41\x9e\xdd\xb6\xa3\x56\xea\xc2\x46\x71\x7e\x90\x2c\x56\xb3\x05\xac\x0d\xee\x9d\xea\x9b\xe9\xaf\x4e\xf9\xa0\xee\xef\x64\x87\xe9\x49\xfe\x3a\x99\xf1\x1c\xbe\x5d\xcf\x37\x16\x13\x88\xa5\xb2\xae\xc8\x35\xf5\x39\x3d\x39\x4e\x6a\xbe\x77\xcb\x28\x0b\x1f\xff\x9f\xf4\x7d\xb6\x73\xe4\xa8\xd1\xde\x6f\x6f\x3c\x4f\xc6\x05\xc8\x67\x77\x1b\x5d\x25\x3a\x64\x42\x59\xaf\x4d\x85\x06\x84\xda\x8c\xff\xb9\x14\xc2\x0a\xff\xcf\x8a\xeb\x8a\xbb\xf5\x06\x6d\x57\x57\xbe\x3a\xb3\x87\x83\xe0\x87\xcf\xdd\x10\xf7\xb0\xf9\xdc\x4b\x0f\x0d\x3d\xab\xb5\xc1\x38\x09\xb7\xe1\x9f\x03\x00\x8f\x76\x5a\xc2\x5f\x0f\x00\x00")
+
+func svcTransport_http_compressionGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcTransport_http_compressionGotemplate,
+		"svc/transport_http_compression.gotemplate",
+	)
+}
+
+func svcTransport_http_compressionGotemplate() (*asset, error) {
+	bytes, err := svcTransport_http_compressionGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/transport_http_compression.gotemplate", size: 3935, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0x5e, 0x2a, 0x70, 0x12, 0x82, 0x1e, 0x5, 0xf4, 0x9b, 0x33, 0x84, 0x7a, 0x54, 0xbf, 0x1d, 0x76, 0xea, 0x6a, 0x3c, 0xaa, 0x5c, 0x65, 0xce, 0xa2, 0x6c, 0xfe, 0xb0, 0x63, 0xb, 0xa0, 0x7a}}
+	return a, nil
+}
+
+var _svcValidateGotemplate = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x51\x6f\xdb\xb0\x11\x7e\x96\x7e\xc5\x41\x0f\x85\xd4\xaa\x74\x31\xec\xc9\x45\x06\xac\x49\xb6\xa5\x40\xd2\x20\x0d\xb2\x87\x20\x68\x69\xe9\x24\x71\x91\x49\x95\xa4\xed\x04\x82\xfe\xfb\x70\x24\x65\xc9\x89\x93\x2d\x40\x60\x9b\x3c\x7e\xbc\xfb\xee\xbb\xe3\x2d\x16\x70\xaa\x4a\x84\x1a\x25\x6a\x6e\xb1\x84\xd5\x33\x58\xbd\x31\x86\xc1\xd9\x0f\xb8\xfa\x71\x0b\xe7\x67\x17\xb7\x2c\x5e\x2c\xe0\x06\xf5\x46\x4a\x21\x6b\x6f\x00\x3b\xd1\xb6\xa0\xb6\xa8\x77\x5a\x58\x04\xdb\x08\x03\x95\x68\xd1\x19\xdf\xa1\x36\x42\xc9\x25\xf4\x3d\x0b\xdf\x87\x61\xb6\x01\x67\xdc\xe2\x7c\x97\x7e\x0f\x43\x1c\x77\xbc\x78\xe4\x35\x82\xd9\x16\x31\xd9\xdf\x8e\xb0\x50\x28\x69\xb9\x90\x06\x6c\x83\x70\xc7\x5b\x51\x72\x8b\x80\xb2\xec\x94\x90\x16\xd6\xa2\x2c\x5b\xdc\x71\x8d\x39\xec\x1a\x51\x34\x50\x34\x58\x3c\x1a\xd0\xf8\x67\x83\xc6\x1a\x42\xe3\x35\x21\x58\x07\x91\x6e\x03\x06\xd3\x9b\x16\x4d\x06\xaa\xb3\x42\x49\x03\xaa\x72\xfb\x95\xc0\xb6\x1c\x7f\x09\x0d\x6b\x34\x86\xd7\x68\x58\x1c\x8b\x75\xa7\xb4\x85\x34\x8e\x12\xf2\x0a\x9f\x6c\x12\x47\x09\xca\x42\x95\x42\xd6\x8b\xff\x18\x25\x69\x41\xa2\x5d\x34\xd6\x76\xf4\xdd\x58\x2d\x64\x6d\x92\xb8\xef\x3f\x83\xe6\xb2\x46\x60\x21\x08\xa1\x24\xbb\x70\x88\x66\x18\xe2\x28\xe9\x7b\x36\x0c\xde\x10\x65\x49\xa4\x44\x49\x2d\x6c\xb3\x59\xb1\x42\xad\x17\xb5\xfa\xfc\x28\xec\x82\xfe\xc7\xe0\xe9\x82\x5a\xa9\xba\x45\x56\xab\x96\xcb\x9a\x29\x5d\x2f\x6a\x94\x9d\x56\x56\x2d\xfc\x16\xef\x84\x59\xe8\xae\x58\xa0\xd6\x25\x5a\x2e\x5a\xf3\xc6\x39\x32\x2a\x54\x89\xef\xed\x1b\xcb\xed\x26\x84\x23\xaa\x83\x58\x2e\x03\x51\xce\xf3\x6e\x05\x14\xd0\xf5\x37\x1f\xe1\x35\xb7\x0d\x7c\x3e\x0c\x2f\x73\x89\xfe\x07\xd1\x7d\x27\x54\xeb\x40\xa0\x44\x53\x68\xb1\x42\x03\x8d\xda\x01\xf7\xd9\xa0\x64\xf0\x31\xa1\xb0\xd2\xc8\x1f\x0d\x28\x89\xb4\x2e\x7c\x86\x43\x52\x09\xc2\xa5\x95\xc5\xf6\xb9\xc3\x97\xe8\xc6\xea\x4d\x61\xa1\x8f\xa3\xf1\x66\x10\x5e\x58\x1d\x39\x38\x57\x00\xec\x84\x6d\x84\x74\x0b\xe1\xe6\x1c\xcc\xa6\x68\x80\x1b\x48\x24\x5f\x63\x92\x3b\x98\x44\xed\x24\x6a\x26\xca\x04\x94\x86\x44\x58\x5c\x9b\xfb\xbf\x3c\xd0\x02\x8b\x23\xe7\x00\xf8\x3f\xaf\x05\xf8\x4d\x3a\x59\x26\x2e\xb2\xe4\x77\x1c\x9d\xb9\x90\xbb\xd1\xc1\x99\x49\x39\xed\x24\xbf\xe3\xc1\xf1\x35\x11\x7e\xae\xb5\xd2\xe4\xbf\x46\xbb\xd1\xd2\x17\x71\xd8\x46\xa8\x94\xde\x97\x80\xa7\x8c\x80\x6d\x83\xc7\xd8\x9a\xd4\xee\xbc\x32\x0c\x2e\x2c\x21\x1b\x94\x96\xe2\xe5\xf0\xd7\x2f\x5f\xe0\x1b\x2f\xe1\x26\x24\x81\x1a\x00\x21\xfd\xeb\xf6\xf6\x1a\xb8\x2c\x9d\x95\x84\x0b\xe9\xa0\xff\xae\xeb\xcd\x9a\xce\x7a\xb9\xb8\x76\x01\xf5\xcd\xf5\x69\x0e\x2b\x65\x1b\x68\x85\xb1\xe4\x0e\x6e\x51\x3f\x13\xcc\x76\x4c\x51\xc8\xdb\xcb\x28\xa7\xc4\xed\x93\x69\xe0\xfe\xe1\x30\xbd\xc4\x50\xb5\x91\x05\xa4\xaf\x00\x32\x70\x1f\x69\x36\x32\xdc\xc7\xd1\xda\xd4\x06\x96\x27\xb0\xe6\x8f\x98\xde\x3f\xf8\x8d\x1c\x5a\x94\x29\xb2\xe9\x9a\x2c\x8b\x23\x22\x53\xe4\xb0\x85\xe5\x49\x28\xe2\xb9\x05\xb9\xe5\xd0\xee\xc5\x03\x9c\xc0\x96\x39\xb7\xe0\x13\x24\x90\xc0\x27\xd8\xb2\x59\x86\xe3\x68\x88\x23\x9f\x30\x48\x84\x67\x6b\xcc\xd3\xd2\x99\x87\x8e\xc1\xbe\x2b\x21\x53\x42\xcd\x21\xf9\x0a\x49\x16\xf2\xff\xd3\x31\xea\xba\xb7\xe1\x56\x98\x4a\xa0\x57\xf0\xb4\xa1\x41\x48\x8b\xba\xe2\x05\x82\x90\x10\x5a\x2b\xd1\x7c\xbc\x9d\x58\xcd\xa5\xa1\x2a\x75\x5d\x8b\xbd\xcd\xe1\x74\x45\x9a\xd1\x1d\xd0\xef\x63\x71\x27\xfd\xfe\x37\x5e\x06\x95\x04\x97\x2f\xb9\x36\x0d\x6f\xbf\xff\xfc\x71\x15\xb4\xea\x3d\x5e\xa9\xf2\x99\x94\xe7\x34\x84\x74\x05\x68\x34\x9d\x92\x06\x4d\x0e\x8d\x6a\xcb\xb9\x64\x27\xba\xb9\x81\x1d\xb6\x2d\x49\x8e\x60\xfc\xc9\xd0\xa6\xdf\xf1\x7e\xe6\x46\x9a\x41\x7a\xff\xb0\x7a\xb6\x98\xfb\xe3\xd9\x2c\x14\x2a\x4f\x16\x8c\xd3\x49\x78\x91\x83\x39\xa8\xe4\x50\xd7\x00\x63\xc1\x3a\x2c\xaa\xe9\xf7\x64\x3a\x1a\xef\x35\x6f\xe8\xc4\xb0\xbf\x62\xe9\x21\x91\x05\xc9\xe6\x07\x70\xcb\x03\xe9\xe5\x71\x34\x8c\xca\xf8\xe7\xcd\xf5\xa9\xcf\xc0\x01\xcb\x63\x09\x56\xae\x00\x5f\xf3\x4c\x7d\xce\x19\x4e\xfe\x10\x1a\xb7\x96\x17\x0d\xfa\xc2\x86\xf0\x1a\xe8\xae\x60\x53\x7a\xc1\x3f\x27\xef\x50\x3e\xb9\x94\x66\xf0\xd1\x7b\xc2\x82\x8f\x7d\x1c\x19\x4b\x05\x15\x96\xaf\x70\x97\xba\xd7\x87\xbd\x68\x22\xf9\x44\x45\x16\x47\x5b\xae\xc3\xbd\x06\xa6\x17\x6d\xe6\x95\xaf\xd6\x5f\xef\x57\xeb\x78\xec\x30\x33\x06\x4e\x80\x77\x1d\xca\x32\x7d\xc3\x20\x87\x0f\x47\x2f\xfd\x75\x68\x47\x57\xf8\xd6\x1f\x92\x39\x36\x05\xca\xe5\xbc\xe1\x2f\x0f\xbb\x03\x6d\x0f\x99\xeb\x11\xa2\x72\x2f\xd0\x99\xbf\xca\xc9\xd4\x93\xc5\xfe\x3d\x2d\xa7\x1f\x82\x2b\xd9\x57\x67\x70\x72\x02\x52\xb4\x4e\xad\x41\xcc\x33\x8c\x79\xeb\xd9\xd7\x66\x48\x19\x52\xb7\xe7\x72\x3f\x56\xb1\xcb\xfd\x58\x75\x7c\xaa\x9a\x8f\x54\xff\xcf\x8b\x92\x07\x4d\x52\x45\xf3\x97\x42\x01\x42\x42\x5e\x82\xaa\x08\xab\xe0\x6d\x4b\x76\x12\x9f\x2c\x88\x0a\xb8\x7c\x76\xa0\xe4\xe3\x4a\xab\x47\x94\x41\x72\xa3\xf3\xa9\xb3\xdc\xfb\x7e\x1e\xbe\x64\xaf\x97\x66\x65\x4e\x08\x69\x61\x9f\x20\x8c\x72\xec\xd4\x7f\xe6\x63\x8c\x53\x17\xed\x87\x0c\xd2\xd9\xaf\x79\xd3\x70\x82\xdc\xbe\x5d\xed\x71\x14\x1d\x99\x95\x82\x6c\xdc\xe0\x17\x99\x9d\xb0\x45\x43\xd7\x52\x86\xc3\xed\x2c\xa5\x87\xd0\xf5\xa5\xe8\xf8\xec\x78\x80\x51\x70\x83\xf0\xb1\x5b\x31\x37\x45\x2e\x49\x66\x33\xa7\x4e\xc6\xfc\xa0\xdb\x4e\x35\xfe\xc9\x21\x49\xb2\x80\xed\xe7\xb1\x28\x1a\x5e\xfc\x16\x95\x7b\x0d\x27\xa0\x0c\xfe\x06\x5f\x9c\x4b\x23\x8b\x52\xb4\xf9\xcb\x74\xf6\xfb\xe0\xcd\x72\xd6\xb7\xc7\x1b\xc6\x93\xf8\x64\x89\xff\x3d\xdf\x4e\xf7\x43\xdc\xf7\x2f\xb8\xba\xe6\xd6\xa2\x96\xc4\x15\x71\x9d\xbe\x31\x4a\xcf\xcc\xa2\xbe\x67\x77\x5c\x0f\x03\x10\x9d\x35\x3e\x75\xec\x72\x63\xec\xa9\x5a\x77\xa2\xc5\xb4\xef\xd9\xf9\x53\xa7\x87\x21\x3b\x98\x46\xfb\xde\xf3\x70\x1c\x7e\x36\xdd\x4e\x72\x27\x3a\xaf\xf8\x1a\x87\xe1\xa0\xe5\x4e\x31\x87\x32\x38\x5a\x1e\x42\xe6\xa4\xf6\x4e\x63\x25\x9e\xc2\x4b\x07\x34\x58\xd2\x21\xe4\x45\x33\x1b\x44\x83\x55\xd0\xfd\xab\xdb\x53\x21\xc7\xe4\x7b\x77\xf2\x70\x20\xbc\x54\xd9\xeb\x47\xa8\x8f\x23\x51\xd1\x78\xf0\xba\x67\x48\xd1\x52\x2a\xfe\xb7\xb2\x67\x4c\x9d\xba\xf6\x10\xb8\xf7\x1f\x7b\x1d\x05\xd8\x09\x2a\x1e\xe2\xbe\x47\x59\x0e\x43\xfc\xdf\x01\x00\x9d\x24\xdc\xbf\x8a\x0e\x00\x00")
+
+func svcValidateGotemplateBytes() ([]byte, error) {
+	return bindataRead(
+		_svcValidateGotemplate,
+		"svc/validate.gotemplate",
+	)
+}
+
+func svcValidateGotemplate() (*asset, error) {
+	bytes, err := svcValidateGotemplateBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/validate.gotemplate", size: 3722, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x18, 0xff, 0x92, 0xb3, 0x76, 0xfc, 0xd1, 0xd9, 0x44, 0x2, 0x15, 0x12, 0xbd, 0xbf, 0x39, 0x10, 0x75, 0x45, 0x99, 0x32, 0x60, 0x31, 0x26, 0x55, 0x4, 0x9f, 0x8e, 0x82, 0x67, 0x44, 0xa1, 0xa6}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -390,17 +621,28 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"cmd/NAME/main.gotemplate":          cmdNameMainGotemplate,
-	"handlers/handlers.gotemplate":      handlersHandlersGotemplate,
-	"handlers/hooks.gotemplate":         handlersHooksGotemplate,
-	"handlers/middlewares.gotemplate":   handlersMiddlewaresGotemplate,
-	"svc/client/grpc/client.gotemplate": svcClientGrpcClientGotemplate,
-	"svc/client/http/client.gotemplate": svcClientHttpClientGotemplate,
-	"svc/config.gotemplate":             svcConfigGotemplate,
-	"svc/endpoints.gotemplate":          svcEndpointsGotemplate,
-	"svc/server/run.gotemplate":         svcServerRunGotemplate,
-	"svc/transport_grpc.gotemplate":     svcTransport_grpcGotemplate,
-	"svc/transport_http.gotemplate":     svcTransport_httpGotemplate,
+	"cmd/NAME/main.gotemplate":                  cmdNameMainGotemplate,
+	"handlers/handlers.gotemplate":              handlersHandlersGotemplate,
+	"handlers/handlers_test.gotemplate":         handlersHandlers_testGotemplate,
+	"handlers/hooks.gotemplate":                 handlersHooksGotemplate,
+	"handlers/middlewares.gotemplate":           handlersMiddlewaresGotemplate,
+	"svc/balance.gotemplate":                    svcBalanceGotemplate,
+	"svc/client/grpc/client.gotemplate":         svcClientGrpcClientGotemplate,
+	"svc/client/http/client.gotemplate":         svcClientHttpClientGotemplate,
+	"svc/config.gotemplate":                     svcConfigGotemplate,
+	"svc/endpoints.gotemplate":                  svcEndpointsGotemplate,
+	"svc/limit.gotemplate":                      svcLimitGotemplate,
+	"svc/middleware/middleware.gotemplate":      svcMiddlewareMiddlewareGotemplate,
+	"svc/mock/mock.gotemplate":                  svcMockMockGotemplate,
+	"svc/recover.gotemplate":                    svcRecoverGotemplate,
+	"svc/resilience.gotemplate":                 svcResilienceGotemplate,
+	"svc/server/run.gotemplate":                 svcServerRunGotemplate,
+	"svc/testing/testing.gotemplate":            svcTestingTestingGotemplate,
+	"svc/transport_grpc.gotemplate":             svcTransport_grpcGotemplate,
+	"svc/transport_grpcweb.gotemplate":          svcTransport_grpcwebGotemplate,
+	"svc/transport_http.gotemplate":             svcTransport_httpGotemplate,
+	"svc/transport_http_compression.gotemplate": svcTransport_http_compressionGotemplate,
+	"svc/validate.gotemplate":                   svcValidateGotemplate,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -454,10 +696,12 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	}},
 	"handlers": {nil, map[string]*bintree{
 		"handlers.gotemplate": {handlersHandlersGotemplate, map[string]*bintree{}},
+		"handlers_test.gotemplate": {handlersHandlers_testGotemplate, map[string]*bintree{}},
 		"hooks.gotemplate": {handlersHooksGotemplate, map[string]*bintree{}},
 		"middlewares.gotemplate": {handlersMiddlewaresGotemplate, map[string]*bintree{}},
 	}},
 	"svc": {nil, map[string]*bintree{
+		"balance.gotemplate": {svcBalanceGotemplate, map[string]*bintree{}},
 		"client": {nil, map[string]*bintree{
 			"grpc": {nil, map[string]*bintree{
 				"client.gotemplate": {svcClientGrpcClientGotemplate, map[string]*bintree{}},
@@ -468,11 +712,26 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 		"config.gotemplate": {svcConfigGotemplate, map[string]*bintree{}},
 		"endpoints.gotemplate": {svcEndpointsGotemplate, map[string]*bintree{}},
+		"limit.gotemplate": {svcLimitGotemplate, map[string]*bintree{}},
+		"middleware": {nil, map[string]*bintree{
+			"middleware.gotemplate": {svcMiddlewareMiddlewareGotemplate, map[string]*bintree{}},
+		}},
+		"mock": {nil, map[string]*bintree{
+			"mock.gotemplate": {svcMockMockGotemplate, map[string]*bintree{}},
+		}},
+		"recover.gotemplate": {svcRecoverGotemplate, map[string]*bintree{}},
+		"resilience.gotemplate": {svcResilienceGotemplate, map[string]*bintree{}},
 		"server": {nil, map[string]*bintree{
 			"run.gotemplate": {svcServerRunGotemplate, map[string]*bintree{}},
 		}},
+		"testing": {nil, map[string]*bintree{
+			"testing.gotemplate": {svcTestingTestingGotemplate, map[string]*bintree{}},
+		}},
 		"transport_grpc.gotemplate": {svcTransport_grpcGotemplate, map[string]*bintree{}},
+		"transport_grpcweb.gotemplate": {svcTransport_grpcwebGotemplate, map[string]*bintree{}},
 		"transport_http.gotemplate": {svcTransport_httpGotemplate, map[string]*bintree{}},
+		"transport_http_compression.gotemplate": {svcTransport_http_compressionGotemplate, map[string]*bintree{}},
+		"validate.gotemplate": {svcValidateGotemplate, map[string]*bintree{}},
 	}},
 }}
 
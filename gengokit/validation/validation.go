@@ -0,0 +1,278 @@
+// Package validation provides a template helper for generating the checks of
+// the validation rules declared on the fields of a service's messages.
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/metaverse/truss/svcdef"
+)
+
+// Helper is the data needed to template the Validate middleware of a service.
+type Helper struct {
+	// Messages are the messages which need checking: those with validation
+	// rules on their fields, and those holding such messages in their
+	// fields. They are in the order of the definition.
+	Messages []*Message
+	// Requests are the names of the messages among Messages which are the
+	// request of a method, each named once.
+	Requests []string
+	// Patterns are the regular expressions of the pattern rules, which are
+	// compiled once into package level variables.
+	Patterns []*Pattern
+
+	imports map[string]bool
+}
+
+// Message is a message to check, with a Go statement for each of the checks
+// of its fields.
+type Message struct {
+	Name   string
+	Checks []string
+}
+
+// Pattern is a regular expression to be compiled into the variable Var.
+type Pattern struct {
+	Var string
+	// Expr is the quoted regular expression.
+	Expr string
+}
+
+// NewHelper returns the Helper for the messages and service of sd.
+func NewHelper(sd *svcdef.Svcdef) *Helper {
+	rv := Helper{
+		imports: make(map[string]bool),
+	}
+
+	checked := checkedMessages(sd.Messages)
+	for _, m := range sd.Messages {
+		if !checked[m.Name] {
+			continue
+		}
+		msg := Message{Name: m.Name}
+		for _, f := range m.Fields {
+			msg.Checks = append(msg.Checks, rv.fieldChecks(m, f, checked)...)
+		}
+		rv.Messages = append(rv.Messages, &msg)
+	}
+
+	if sd.Service != nil {
+		seen := make(map[string]bool)
+		for _, meth := range sd.Service.Methods {
+			name := meth.RequestType.Name
+			if checked[name] && !seen[name] {
+				seen[name] = true
+				rv.Requests = append(rv.Requests, name)
+			}
+		}
+	}
+
+	return &rv
+}
+
+// Imports returns the import paths the checks require, besides those the
+// Validate middleware always imports.
+func (h *Helper) Imports() []string {
+	var rv []string
+	for imp := range h.imports {
+		rv = append(rv, imp)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// checkedMessages returns the names of the messages which have fields with
+// validation rules, directly or within messages held by their fields.
+func checkedMessages(msgs []*svcdef.Message) map[string]bool {
+	checked := make(map[string]bool)
+	for _, m := range msgs {
+		for _, f := range m.Fields {
+			if len(f.Rules) > 0 {
+				checked[m.Name] = true
+			}
+			for _, o := range f.Type.Oneof {
+				if len(o.Rules) > 0 {
+					checked[m.Name] = true
+				}
+			}
+		}
+	}
+
+	// Holding a checked message makes a message checked in turn, so repeat
+	// until no more are found
+	for found := true; found; {
+		found = false
+		for _, m := range msgs {
+			if checked[m.Name] {
+				continue
+			}
+			for _, f := range m.Fields {
+				if held := heldMessage(f); held != nil && checked[held.Name] {
+					checked[m.Name] = true
+					found = true
+					break
+				}
+			}
+		}
+	}
+	return checked
+}
+
+// heldMessage returns the message held by the field f, directly, as the
+// elements of a list or as the values of a map, or nil if it holds none.
+func heldMessage(f *svcdef.Field) *svcdef.Message {
+	if f.Type.Map != nil {
+		return f.Type.Map.ValueType.Message
+	}
+	return f.Type.Message
+}
+
+// fieldChecks returns the statements checking the field f of the message m.
+func (h *Helper) fieldChecks(m *svcdef.Message, f *svcdef.Field, checked map[string]bool) []string {
+	var rv []string
+	for _, r := range f.Rules {
+		if check := h.ruleCheck(m, f, "in."+f.Name, r); check != "" {
+			rv = append(rv, check)
+		}
+	}
+
+	// Fields of a oneof are checked only when set
+	for _, o := range f.Type.Oneof {
+		if len(o.Rules) == 0 {
+			continue
+		}
+		check := fmt.Sprintf("if x, ok := in.%s.(*pb.%s); ok {\n", f.Name, o.Type.Message.Name)
+		var checks int
+		for _, r := range o.Rules {
+			if c := h.ruleCheck(m, o, "x."+o.Name, r); c != "" {
+				check += c + "\n"
+				checks++
+			}
+		}
+		if checks > 0 {
+			rv = append(rv, check+"}")
+		}
+	}
+
+	held := heldMessage(f)
+	if held == nil || !checked[held.Name] || len(f.Type.Oneof) > 0 {
+		return rv
+	}
+	validate := "violations = append(violations, validate" + held.Name + "(m, prefix+"
+	switch {
+	case f.Type.Map != nil:
+		h.imports["fmt"] = true
+		rv = append(rv, fmt.Sprintf("for k, m := range in.%s {\n%s%q+fmt.Sprint(k)+\"].\")...)\n}", f.Name, validate, f.PBFieldName+"["))
+	case f.Type.ArrayType:
+		h.imports["strconv"] = true
+		rv = append(rv, fmt.Sprintf("for i, m := range in.%s {\n%s%q+strconv.Itoa(i)+\"].\")...)\n}", f.Name, validate, f.PBFieldName+"["))
+	default:
+		rv = append(rv, fmt.Sprintf("if m := in.%s; m != nil {\n%s%q)...)\n}", f.Name, validate, f.PBFieldName+"."))
+	}
+	return rv
+}
+
+// ruleCheck returns the statement checking the rule r of the field f of the
+// message m, where expr is the Go expression of the value of f. Rules which
+// are not supported, such as those of protoc-gen-validate missing from the
+// validate.proto of truss, or which do not apply to the type of f, are
+// skipped with a warning, returning "".
+func (h *Helper) ruleCheck(m *svcdef.Message, f *svcdef.Field, expr string, r *svcdef.Rule) string {
+	var cond, desc string
+	// Only the rules of lists and maps apply to them as a whole
+	switch {
+	case f.Type.Map != nil && r.Type != "map":
+	case f.Type.ArrayType && r.Type != "repeated" && r.Type != "bytes":
+	default:
+		cond, desc = h.ruleCond(m, f, expr, r)
+	}
+	if cond == "" {
+		log.WithField("Message", m.Name).WithField("Field", f.PBFieldName).
+			Warnf("Validation rule %q is not supported and is not checked", r.Type+"."+r.Name)
+		return ""
+	}
+
+	return fmt.Sprintf("if %s {\nviolations = append(violations, FieldViolation{Field: prefix + %q, Description: %q})\n}",
+		cond, f.PBFieldName, desc)
+}
+
+// ruleCond returns the condition under which the value expr violates the rule
+// r of the field f of the message m, and the description of the violation.
+// The condition is empty if the rule is not supported.
+func (h *Helper) ruleCond(m *svcdef.Message, f *svcdef.Field, expr string, r *svcdef.Rule) (cond, desc string) {
+	switch r.Type {
+	case "string":
+		switch r.Name {
+		case "const":
+			cond, desc = fmt.Sprintf("%s != %q", expr, r.Value), fmt.Sprintf("must equal %q", r.Value)
+		case "min_len":
+			h.imports["unicode/utf8"] = true
+			cond, desc = fmt.Sprintf("utf8.RuneCountInString(%s) < %s", expr, r.Value), fmt.Sprintf("must be at least %s characters long", r.Value)
+		case "max_len":
+			h.imports["unicode/utf8"] = true
+			cond, desc = fmt.Sprintf("utf8.RuneCountInString(%s) > %s", expr, r.Value), fmt.Sprintf("must be at most %s characters long", r.Value)
+		case "pattern":
+			h.imports["regexp"] = true
+			p := &Pattern{
+				Var:  fmt.Sprintf("pattern%s%s", m.Name, f.Name),
+				Expr: strconv.Quote(r.Value),
+			}
+			h.Patterns = append(h.Patterns, p)
+			cond, desc = fmt.Sprintf("!%s.MatchString(%s)", p.Var, expr), fmt.Sprintf("must match the pattern %q", r.Value)
+		case "prefix":
+			cond, desc = fmt.Sprintf("!strings.HasPrefix(%s, %q)", expr, r.Value), fmt.Sprintf("must start with %q", r.Value)
+		case "suffix":
+			cond, desc = fmt.Sprintf("!strings.HasSuffix(%s, %q)", expr, r.Value), fmt.Sprintf("must end with %q", r.Value)
+		}
+	case "bytes":
+		switch r.Name {
+		case "min_len":
+			cond, desc = fmt.Sprintf("len(%s) < %s", expr, r.Value), fmt.Sprintf("must be at least %s bytes long", r.Value)
+		case "max_len":
+			cond, desc = fmt.Sprintf("len(%s) > %s", expr, r.Value), fmt.Sprintf("must be at most %s bytes long", r.Value)
+		}
+	case "enum":
+		if r.Name == "defined_only" {
+			cond = fmt.Sprintf("_, ok := pb.%s_name[int32(%s)]; !ok", f.Type.Enum.Name, expr)
+			desc = "must be a defined value of " + f.Type.Enum.Name
+		}
+	case "message":
+		if r.Name == "required" {
+			cond, desc = expr+" == nil", "is required"
+		}
+	case "repeated":
+		switch r.Name {
+		case "min_items":
+			cond, desc = fmt.Sprintf("len(%s) < %s", expr, r.Value), fmt.Sprintf("must contain at least %s items", r.Value)
+		case "max_items":
+			cond, desc = fmt.Sprintf("len(%s) > %s", expr, r.Value), fmt.Sprintf("must contain at most %s items", r.Value)
+		}
+	case "map":
+		switch r.Name {
+		case "min_pairs":
+			cond, desc = fmt.Sprintf("len(%s) < %s", expr, r.Value), fmt.Sprintf("must contain at least %s pairs", r.Value)
+		case "max_pairs":
+			cond, desc = fmt.Sprintf("len(%s) > %s", expr, r.Value), fmt.Sprintf("must contain at most %s pairs", r.Value)
+		}
+	default:
+		// Every other type of rule is numeric. Negating the comparisons
+		// catches NaN for floats.
+		switch r.Name {
+		case "const":
+			cond, desc = fmt.Sprintf("%s != %s", expr, r.Value), "must equal "+r.Value
+		case "lt":
+			cond, desc = fmt.Sprintf("!(%s < %s)", expr, r.Value), "must be less than "+r.Value
+		case "lte":
+			cond, desc = fmt.Sprintf("!(%s <= %s)", expr, r.Value), "must be less than or equal to "+r.Value
+		case "gt":
+			cond, desc = fmt.Sprintf("!(%s > %s)", expr, r.Value), "must be greater than "+r.Value
+		case "gte":
+			cond, desc = fmt.Sprintf("!(%s >= %s)", expr, r.Value), "must be greater than or equal to "+r.Value
+		}
+	}
+	return cond, desc
+}
@@ -0,0 +1,157 @@
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/metaverse/truss/svcdef"
+)
+
+var gopath []string
+
+func init() {
+	gopath = filepath.SplitList(os.Getenv("GOPATH"))
+}
+
+const def = `
+syntax = "proto3";
+package validation;
+
+import "github.com/metaverse/truss/deftree/validate/validate.proto";
+
+enum Status {
+  UNKNOWN = 0;
+  ACTIVE = 1;
+}
+
+message Request {
+  string name = 1 [(validate.rules).string = {min_len: 2, pattern: "^[a-z]+$"}];
+  int64 age = 2 [(validate.rules).int64.gte = 0];
+  Status status = 3 [(validate.rules).enum.defined_only = true];
+  Owner owner = 4 [(validate.rules).message.required = true];
+  repeated Owner co_owners = 5;
+  map<string, Owner> by_name = 6;
+  oneof contact {
+    string email = 7 [(validate.rules).string.suffix = ".com"];
+    string phone = 8;
+  }
+}
+
+message Owner {
+  int64 id = 1 [(validate.rules).int64.gt = 0];
+}
+
+message Unchecked {
+  string name = 1;
+}
+
+service Validation {
+  rpc Check (Request) returns (Unchecked) {}
+  rpc CheckAgain (Request) returns (Unchecked) {}
+  rpc Plain (Unchecked) returns (Unchecked) {}
+}
+`
+
+func TestNewHelper(t *testing.T) {
+	sd, err := svcdef.NewFromString(def, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHelper(sd)
+
+	var names []string
+	for _, m := range h.Messages {
+		names = append(names, m.Name)
+	}
+	if want := []string{"Request", "Owner"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Messages = %v, want %v", names, want)
+	}
+	if want := []string{"Request"}; !reflect.DeepEqual(h.Requests, want) {
+		t.Errorf("Requests = %v, want %v", h.Requests, want)
+	}
+	if want := []string{"fmt", "regexp", "strconv", "unicode/utf8"}; !reflect.DeepEqual(h.Imports(), want) {
+		t.Errorf("Imports() = %v, want %v", h.Imports(), want)
+	}
+	if len(h.Patterns) != 1 || h.Patterns[0].Var != "patternRequestName" || h.Patterns[0].Expr != `"^[a-z]+$"` {
+		t.Errorf("Patterns = %+v, want patternRequestName for ^[a-z]+$", h.Patterns)
+	}
+
+	checks := strings.Join(h.Messages[0].Checks, "\n")
+	for _, want := range []string{
+		`if utf8.RuneCountInString(in.Name) < 2 {`,
+		`if !patternRequestName.MatchString(in.Name) {`,
+		`if !(in.Age >= 0) {`,
+		`if _, ok := pb.Status_name[int32(in.Status)]; !ok {`,
+		`violations = append(violations, FieldViolation{Field: prefix + "owner", Description: "is required"})`,
+		`if m := in.Owner; m != nil {`,
+		`violations = append(violations, validateOwner(m, prefix+"co_owners["+strconv.Itoa(i)+"].")...)`,
+		`violations = append(violations, validateOwner(m, prefix+"by_name["+fmt.Sprint(k)+"].")...)`,
+		`if x, ok := in.Contact.(*pb.Request_Email); ok {`,
+		`if !strings.HasSuffix(x.Email, ".com") {`,
+	} {
+		if !strings.Contains(checks, want) {
+			t.Errorf("checks of Request do not contain %q:\n%s", want, checks)
+		}
+	}
+	if strings.Contains(checks, "Phone") {
+		t.Errorf("checks of Request check phone, which has no rules:\n%s", checks)
+	}
+}
+
+func TestNewHelperUnsupported(t *testing.T) {
+	sd, err := svcdef.NewFromString(`
+syntax = "proto3";
+package unsupportedvalidation;
+
+import "github.com/metaverse/truss/deftree/validate/validate.proto";
+
+enum Color {
+  NONE = 0;
+  RED = 1;
+}
+
+message Palette {
+  repeated Color favorites = 1 [(validate.rules).repeated.max_items = 3];
+  string contact = 2 [(validate.rules).string.min_len = 1];
+}
+
+service Palettes {
+  rpc Paint (Palette) returns (Palette) {}
+}
+`, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules of protoc-gen-validate which truss does not check, as when the
+	// definition imports its validate.proto, and rules which do not apply to
+	// the whole of a repeated field
+	for _, f := range sd.Messages[0].Fields {
+		switch f.PBFieldName {
+		case "favorites":
+			f.Rules = append(f.Rules,
+				&svcdef.Rule{Type: "repeated", Name: "unique", Value: "true"},
+				&svcdef.Rule{Type: "enum", Name: "defined_only", Value: "true"})
+		case "contact":
+			f.Rules = append(f.Rules, &svcdef.Rule{Type: "string", Name: "email", Value: "true"})
+		}
+	}
+
+	h := NewHelper(sd)
+	got := h.Messages[0].Checks
+	want := []string{
+		"if len(in.Favorites) > 3 {\nviolations = append(violations, FieldViolation{Field: prefix + \"favorites\", Description: \"must contain at most 3 items\"})\n}",
+		"if utf8.RuneCountInString(in.Contact) < 1 {\nviolations = append(violations, FieldViolation{Field: prefix + \"contact\", Description: \"must be at least 1 characters long\"})\n}",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("checks of Palette:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("check %d of Palette:\n%s\nwant:\n%s", i, got[i], want[i])
+		}
+	}
+}
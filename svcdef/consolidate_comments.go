@@ -0,0 +1,91 @@
+package svcdef
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	gogen "github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	"github.com/pkg/errors"
+
+	"github.com/metaverse/truss/svcdef/svcparse"
+)
+
+// consolidateComments accepts a Svcdef and the io.Readers for the proto files
+// comprising the definition. It sets the Description of the messages, enums,
+// fields and enum values declared with a comment right above them.
+func consolidateComments(sd *Svcdef, protoFiles map[string]io.Reader) error {
+	var paths []string
+	for path := range protoFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		lex := svcparse.NewProtoLexer(protoFiles[path])
+		comments, err := svcparse.ParseComments(lex)
+		if err != nil {
+			return errors.Wrapf(err, "cannot parse comments of %q", path)
+		}
+
+		for _, c := range comments {
+			switch c.Kind {
+			case "message":
+				if m := messageNamed(sd, joinName(c.Parent, c.Name)); m != nil {
+					m.Description = c.Text
+				}
+			case "enum":
+				if e := enumNamed(sd, joinName(c.Parent, c.Name)); e != nil {
+					e.Description = c.Text
+				}
+			case "field":
+				if f := ruleField(sd, c.Parent, c.Name); f != nil {
+					f.Description = c.Text
+				}
+			case "value":
+				e := enumNamed(sd, c.Parent)
+				if e == nil {
+					continue
+				}
+				for _, v := range e.Values {
+					if v.Name == c.Name {
+						v.Description = c.Text
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// joinName returns the proto name of a declaration named name within parent.
+func joinName(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// messageNamed returns the message of sd with the proto name name, in which
+// nested messages are joined to their parents with a ".".
+func messageNamed(sd *Svcdef, name string) *Message {
+	goName := gogen.CamelCaseSlice(strings.Split(name, "."))
+	for _, m := range sd.Messages {
+		if m.Name == goName {
+			return m
+		}
+	}
+	return nil
+}
+
+// enumNamed returns the enum of sd with the proto name name, in which nested
+// enums are joined to their parents with a ".".
+func enumNamed(sd *Svcdef, name string) *Enum {
+	goName := gogen.CamelCaseSlice(strings.Split(name, "."))
+	for _, e := range sd.Enums {
+		if e.Name == goName {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package svcdef
+
+import (
+	"testing"
+)
+
+func TestComments(t *testing.T) {
+	def := `
+syntax = "proto3";
+package comments;
+
+// Status is the state of a thing.
+// It has two lines.
+enum Status {
+  // Nothing is known.
+  UNKNOWN = 0;
+  ACTIVE = 1;
+}
+
+// Thing is a thing.
+message Thing {
+  // name of the thing
+  string name = 1;
+  Status status = 2;
+  enum Kind {
+    K_NONE = 0;
+    // Big things
+    K_BIG = 1;
+  }
+}
+
+service Things {
+  rpc Get (Thing) returns (Thing) {}
+}
+`
+	sd, err := NewFromString(def, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmap := newTypeMap(sd)
+
+	status := tmap["Status"].Enum
+	if got, want := status.Description, "Status is the state of a thing.\nIt has two lines."; got != want {
+		t.Errorf("Status description = %q, want %q", got, want)
+	}
+	if got, want := status.Values[0].Description, "Nothing is known."; got != want {
+		t.Errorf("UNKNOWN description = %q, want %q", got, want)
+	}
+	if got := status.Values[1].Description; got != "" {
+		t.Errorf("ACTIVE description = %q, want none", got)
+	}
+	kind := tmap["Thing_Kind"].Enum
+	if kind.Description != "" {
+		t.Errorf("Thing_Kind description = %q, want none", kind.Description)
+	}
+	if got, want := kind.Values[1].Description, "Big things"; got != want {
+		t.Errorf("K_BIG description = %q, want %q", got, want)
+	}
+
+	thing := tmap["Thing"].Message
+	if got, want := thing.Description, "Thing is a thing."; got != want {
+		t.Errorf("Thing description = %q, want %q", got, want)
+	}
+	if got, want := thing.Fields[0].Description, "name of the thing"; got != want {
+		t.Errorf("name description = %q, want %q", got, want)
+	}
+	if got := thing.Fields[1].Description; got != "" {
+		t.Errorf("status description = %q, want none", got)
+	}
+
+	// Types generated in the .pb.go files have no description
+	for _, m := range sd.Messages {
+		if m.Name != "Thing" && m.Description != "" {
+			t.Errorf("%s description = %q, want none", m.Name, m.Description)
+		}
+	}
+}
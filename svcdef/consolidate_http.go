@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -35,17 +37,28 @@ func isEOF(err error) bool {
 // their associated HTTPParamters are added to each ServiceMethod. After this,
 // each `HTTPBinding` will have a populated list of all the http parameters
 // that that binding requires, where that parameter should be located, and the
-// type of each parameter.
+// type of each parameter. Bindings which would result in invalid generated
+// code are reported together, each with the file and line of the problem.
 func consolidateHTTP(sd *Svcdef, protoFiles map[string]io.Reader) error {
-	for _, pfile := range protoFiles {
-		lex := svcparse.NewSvcLexer(pfile)
+	// Parse the files in a stable order so problems are always reported in
+	// the same order
+	var paths []string
+	for path := range protoFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	routes := make(map[string]route)
+	var problems locationErrors
+	for _, path := range paths {
+		lex := svcparse.NewSvcLexer(protoFiles[path])
 		protosvc, err := svcparse.ParseService(lex)
 		if err != nil {
 			if isOptionalError(err) {
 				log.Warnf("Parser found rpc method which lacks HTTP " +
 					"annotations; this is allowed, but will result in HTTP " +
 					"transport not being generated.")
-				return nil
+				break
 			} else if isEOF(err) {
 				continue
 			}
@@ -56,6 +69,23 @@ func consolidateHTTP(sd *Svcdef, protoFiles map[string]io.Reader) error {
 		if err != nil {
 			return errors.Wrap(err, "while assembling HTTP parameters")
 		}
+		problems = append(problems, validateHTTP(sd.Service, protosvc, path, routes)...)
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// methodNamed returns the method of svc with the given name from the proto
+// file, or nil if svc has no such method.
+func methodNamed(svc *Service, name string) *ServiceMethod {
+	for _, m := range svc.Methods {
+		// Have to CamelCase the data from the parser since it may be lowercase
+		// while the name from the Go file will be CamelCased
+		if m.Name == gogen.CamelCase(name) {
+			return m
+		}
 	}
 	return nil
 }
@@ -64,17 +94,6 @@ func consolidateHTTP(sd *Svcdef, protoFiles map[string]io.Reader) error {
 // HTTPParams for each service RequestType field indicating that parameters
 // location, and the field to which it refers.
 func assembleHTTPParams(svc *Service, httpsvc *svcparse.Service) error {
-	getMethNamed := func(name string) *ServiceMethod {
-		for _, m := range svc.Methods {
-			// Have to CamelCase the data from the parser since it may be lowercase
-			// while the name from the Go file will be CamelCased
-			if m.Name == gogen.CamelCase(name) {
-				return m
-			}
-		}
-		return nil
-	}
-
 	// This logic has been broken out of the for loop below to flatten
 	// this function and avoid difficult to read nesting
 	createParams := func(meth *ServiceMethod, parsedbind *svcparse.HTTPBinding) {
@@ -96,7 +115,7 @@ func assembleHTTPParams(svc *Service, httpsvc *svcparse.Service) error {
 	// Iterate through every HTTPBinding on every ServiceMethod, and create the
 	// HTTPParameters for that HTTPBinding.
 	for _, hm := range httpsvc.Methods {
-		m := getMethNamed(hm.Name)
+		m := methodNamed(svc, hm.Name)
 		if m == nil {
 			return fmt.Errorf("cannot not find service method named %q", hm.Name)
 		}
@@ -107,6 +126,143 @@ func assembleHTTPParams(svc *Service, httpsvc *svcparse.Service) error {
 	return nil
 }
 
+// locationErrors holds every problem found in the definition of a service.
+type locationErrors []error
+
+func (le locationErrors) Error() string {
+	msgs := make([]string, len(le))
+	for i, err := range le {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// route records where a verb and path were first bound.
+type route struct {
+	method string
+	path   string
+	line   int
+}
+
+// validateHTTP checks the HTTP bindings parsed from the proto file at path
+// against the request messages of svc. Path parameters and body fields must
+// name fields of the request message, body fields must be messages, and no
+// verb and path may be bound twice. The verbs and paths seen so far are kept
+// in routes so that duplicates across files are found as well.
+func validateHTTP(svc *Service, httpsvc *svcparse.Service, path string, routes map[string]route) []error {
+	var problems []error
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, NewLocationError(fmt.Sprintf(format, args...), path, strconv.Itoa(line)))
+	}
+
+	for _, hm := range httpsvc.Methods {
+		m := methodNamed(svc, hm.Name)
+		msg := m.RequestType.Message
+		for _, binding := range hm.HTTPBindings {
+			verb, bindPath := getVerb(binding)
+			line := pathLine(binding)
+
+			for _, param := range getPathParams(binding) {
+				if fieldAtPath(msg, param) == nil {
+					report(line, "path %q of method %q refers to field %q, which does not exist in message %q",
+						bindPath, m.Name, param, m.RequestType.Name)
+				}
+			}
+
+			for _, f := range binding.Fields {
+				if f.Kind != "body" || f.Value == "*" {
+					continue
+				}
+				bodyField := fieldAtPath(msg, f.Value)
+				if bodyField == nil {
+					report(f.Line, "body of method %q refers to field %q, which does not exist in message %q",
+						m.Name, f.Value, m.RequestType.Name)
+				} else if !isMessage(bodyField.Type) {
+					report(f.Line, "body of method %q refers to field %q, which is not a message",
+						m.Name, f.Value)
+				}
+			}
+
+			if verb == "" {
+				continue
+			}
+			key := strings.ToUpper(verb) + " " + pathVariables.ReplaceAllString(bindPath, "{}")
+			if first, ok := routes[key]; ok {
+				report(line, "%s %q of method %q is already bound by method %q on line %d of %q",
+					strings.ToUpper(verb), bindPath, m.Name, first.method, first.line, first.path)
+				continue
+			}
+			routes[key] = route{method: m.Name, path: path, line: line}
+		}
+	}
+	return problems
+}
+
+// pathLine returns the line on which the path of a svcparse.HTTPBinding is
+// declared, or 0 if it has no path.
+func pathLine(binding *svcparse.HTTPBinding) int {
+	if binding.CustomHTTPPattern != nil {
+		for _, field := range binding.CustomHTTPPattern {
+			if field.Kind == "path" {
+				return field.Line
+			}
+		}
+		return 0
+	}
+	for _, field := range binding.Fields {
+		switch field.Kind {
+		case "get", "put", "post", "delete", "patch":
+			return field.Line
+		}
+	}
+	return 0
+}
+
+// fieldAtPath returns the field of msg named by fieldPath, a dot separated
+// list of field names as written in the proto file, or nil if there is no
+// such field.
+func fieldAtPath(msg *Message, fieldPath string) *Field {
+	var field *Field
+	for _, name := range strings.Split(fieldPath, ".") {
+		if msg == nil {
+			return nil
+		}
+		if field = messageField(msg, name); field == nil {
+			return nil
+		}
+		msg = field.Type.Message
+	}
+	return field
+}
+
+// messageField returns the field of msg with the given name, including the
+// fields within its oneofs, or nil if msg has no such field.
+func messageField(msg *Message, name string) *Field {
+	// Have to CamelCase the names from the protobuf file, as they may be
+	// lowercase while the name from the Go file will be CamelCased.
+	name = gogen.CamelCase(name)
+	for _, f := range msg.Fields {
+		if f.Name == name {
+			return f
+		}
+		for _, o := range f.Type.Oneof {
+			if o.Name == name {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// isMessage reports whether ft is a message, including the well-known types
+// from other packages.
+func isMessage(ft *FieldType) bool {
+	if ft.Map != nil {
+		return false
+	}
+	return ft.Message != nil || strings.Contains(ft.Name, ".")
+}
+
 // getVerb returns the verb of a svcparse.HTTPBinding. The verb is found by
 // first checking if there's a 'customHTTPPattern' for a binding and using
 // that. If there's no custom verb defined, then we search through the defined
@@ -160,11 +316,13 @@ func paramLocation(field *Field, binding *svcparse.HTTPBinding) string {
 	return "query"
 }
 
+// pathVariables matches the variables within the path of an HTTP binding.
+var pathVariables = regexp.MustCompile("{(.*?)}")
+
 // Returns a slice of strings containing all parameters in the path
 func getPathParams(binding *svcparse.HTTPBinding) []string {
 	_, path := getVerb(binding)
-	findParams := regexp.MustCompile("{(.*?)}")
-	params := findParams.FindAllString(path, -1)
+	params := pathVariables.FindAllString(path, -1)
 	rv := []string{}
 	for _, p := range params {
 		rv = append(rv, strings.Split(p[1:len(p)-1], "=")[0])
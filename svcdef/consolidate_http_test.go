@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
+
 	"github.com/metaverse/truss/svcdef/svcparse"
 )
 
@@ -162,3 +164,162 @@ service Map {
 		}
 	}
 }
+
+func TestValidateHTTP(t *testing.T) {
+	goCode := `
+package TEST
+
+type Owner struct {
+	Id int64
+}
+type Thing struct {
+	Owner  *Owner
+	Name   string
+	Labels map[string]string
+}
+
+type ValidateServer interface {
+	GetThing(context.Context, *Thing) (*Thing, error)
+	PostThing(context.Context, *Thing) (*Thing, error)
+}
+`
+	protoHead := `
+syntax = "proto3";
+package TEST;
+import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+
+message Owner {
+  int64 id = 1;
+}
+
+message Thing {
+  Owner owner = 1;
+  string name = 2;
+  map<string, string> labels = 3;
+}
+`
+	tests := []struct {
+		name    string
+		service string
+		// want holds a substring of each expected problem, in order. The
+		// parser returns additional bindings before the binding containing
+		// them.
+		want []string
+	}{
+		{
+			name: "valid",
+			service: `
+service Validate {
+  rpc GetThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      get: "/thing/{name}/{owner.id}"
+      additional_bindings {
+        put: "/thing/{name}"
+        body: "owner"
+      }
+    };
+  }
+  rpc PostThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      post: "/thing/{name}"
+      body: "*"
+    };
+  }
+}`,
+		},
+		{
+			name: "unknown path fields",
+			service: `
+service Validate {
+  rpc GetThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      get: "/thing/{nmae}/{owner.name}"
+    };
+  }
+}`,
+			want: []string{
+				`path "/thing/{nmae}/{owner.name}" of method "GetThing" refers to field "nmae", which does not exist in message "Thing" in file "/tmp/alsonotreal" at line 19`,
+				`refers to field "owner.name", which does not exist in message "Thing" in file "/tmp/alsonotreal" at line 19`,
+			},
+		},
+		{
+			name: "invalid body fields",
+			service: `
+service Validate {
+  rpc GetThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      post: "/thing"
+      body: "name"
+    };
+  }
+  rpc PostThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      post: "/other"
+      body: "labels"
+      additional_bindings {
+        post: "/another"
+        body: "owners"
+      }
+    };
+  }
+}`,
+			want: []string{
+				`body of method "GetThing" refers to field "name", which is not a message in file "/tmp/alsonotreal" at line 20`,
+				`body of method "PostThing" refers to field "owners", which does not exist in message "Thing" in file "/tmp/alsonotreal" at line 29`,
+				`body of method "PostThing" refers to field "labels", which is not a message in file "/tmp/alsonotreal" at line 26`,
+			},
+		},
+		{
+			name: "duplicate bindings",
+			service: `
+service Validate {
+  rpc GetThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      get: "/thing/{name}"
+    };
+  }
+  rpc PostThing (Thing) returns (Thing) {
+    option (google.api.http) = {
+      post: "/thing/{name}"
+      additional_bindings {
+        get: "/thing/{owner.id}"
+      }
+    };
+  }
+}`,
+			want: []string{
+				`GET "/thing/{owner.id}" of method "PostThing" is already bound by method "GetThing" on line 19 of "/tmp/alsonotreal" in file "/tmp/alsonotreal" at line 26`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(map[string]io.Reader{"/tmp/notreal": strings.NewReader(goCode)},
+				map[string]io.Reader{"/tmp/alsonotreal": strings.NewReader(protoHead + tt.service)})
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected %d problems, got none", len(tt.want))
+			}
+			problems, ok := errors.Cause(err).(locationErrors)
+			if !ok {
+				t.Fatalf("expected locationErrors, got %T: %v", errors.Cause(err), err)
+			}
+			if len(problems) != len(tt.want) {
+				t.Fatalf("expected %d problems, got %d: %v", len(tt.want), len(problems), problems)
+			}
+			for i, want := range tt.want {
+				if _, ok := problems[i].(LocationError); !ok {
+					t.Errorf("problem %d is a %T, not a LocationError", i, problems[i])
+				}
+				if got := problems[i].Error(); !strings.Contains(got, want) {
+					t.Errorf("problem %d = %q, want it to contain %q", i, got, want)
+				}
+			}
+		})
+	}
+}
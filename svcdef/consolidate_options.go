@@ -0,0 +1,106 @@
+package svcdef
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	gogen "github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	"github.com/pkg/errors"
+
+	"github.com/metaverse/truss/svcdef/svcparse"
+)
+
+// consolidateOptions accepts a Svcdef and the source of the proto files
+// comprising the definition. It sets the Options of every method of the
+// service with custom options, named by the fully-qualified name of their
+// extension. Options are checked by protoc, so only the value last declared
+// is kept for repeated ones.
+func consolidateOptions(sd *Svcdef, protoSrc map[string][]byte) error {
+	if sd.Service == nil {
+		return nil
+	}
+
+	// Extensions are lexed from every file before the options, which may
+	// use those declared by later files
+	var paths []string
+	for path := range protoSrc {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	extensions := make(map[string]bool)
+	for _, path := range paths {
+		exts, err := svcparse.ParseExtensions(svcparse.NewProtoLexer(bytes.NewReader(protoSrc[path])))
+		if err != nil {
+			return errors.Wrapf(err, "cannot parse extensions of %q", path)
+		}
+		for _, e := range exts {
+			extensions[e] = true
+		}
+	}
+
+	for _, path := range paths {
+		lex := svcparse.NewProtoLexer(bytes.NewReader(protoSrc[path]))
+		methodOptions, err := svcparse.ParseMethodOptions(lex)
+		if err != nil {
+			return errors.Wrapf(err, "cannot parse method options of %q", path)
+		}
+
+		for _, mo := range methodOptions {
+			if gogen.CamelCase(mo.Service) != sd.Service.Name {
+				continue
+			}
+			meth := methodNamed(sd.Service, mo.Method)
+			if meth == nil {
+				continue
+			}
+			if meth.Options == nil {
+				meth.Options = make(map[string]string)
+			}
+			for _, o := range mo.Options {
+				meth.Options[optionName(mo.Package, o, extensions)] = o.Value
+			}
+		}
+	}
+	return nil
+}
+
+// optionName returns the name of option o set in package pkg, with the name
+// of its extension fully qualified. The extension is looked up among
+// extensions, those declared by the definition, from the scope of pkg
+// outwards, as protoc does. Extensions declared elsewhere are taken to be
+// qualified already, unless their name has no package, which is then pkg.
+func optionName(pkg string, o *svcparse.Option, extensions map[string]bool) string {
+	if o.Extension == "" {
+		return o.Name
+	}
+	field := strings.TrimPrefix(o.Name, o.Extension)
+	if strings.HasPrefix(o.Extension, ".") {
+		return o.Extension[1:] + field
+	}
+
+	scope := pkg
+	for {
+		name := o.Extension
+		if scope != "" {
+			name = scope + "." + name
+		}
+		if extensions[name] {
+			return name + field
+		}
+		if scope == "" {
+			break
+		}
+		if i := strings.LastIndex(scope, "."); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+
+	if pkg != "" && !strings.Contains(o.Extension, ".") {
+		return pkg + "." + o.Extension + field
+	}
+	return o.Extension + field
+}
@@ -0,0 +1,103 @@
+package svcdef
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/metaverse/truss/svcdef/svcparse"
+)
+
+const optionsHead = `
+syntax = "proto3";
+package options;
+
+import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+import "github.com/metaverse/truss/deftree/googlethirdparty/descriptor.proto";
+
+message Policy {
+  string role = 1;
+  bool audit = 2;
+}
+
+extend google.protobuf.MethodOptions {
+  bool public = 50001;
+  Policy policy = 50002;
+}
+
+message Empty {}
+`
+
+func TestOptions(t *testing.T) {
+	def := optionsHead + `
+service Options {
+  rpc Public (Empty) returns (Empty) {
+    option (public) = true;
+    option (google.api.http) = {
+      get: "/public"
+    };
+  }
+  rpc Admin (Empty) returns (Empty) {
+    option (google.api.http) = {
+      get: "/admin"
+    };
+    option (options.policy) = {role: "admin"};
+    option (options.policy).audit = true;
+  }
+  rpc Plain (Empty) returns (Empty) {
+    option (google.api.http) = {
+      get: "/plain"
+    };
+  }
+}
+`
+	sd, err := NewFromString(def, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]map[string]string{}
+	for _, m := range sd.Service.Methods {
+		got[m.Name] = m.Options
+		if len(m.Bindings) == 0 {
+			t.Errorf("method %q has no HTTP bindings", m.Name)
+		}
+	}
+	want := map[string]map[string]string{
+		"Public": {"options.public": "true"},
+		"Admin":  {"options.policy.role": "admin", "options.policy.audit": "true"},
+		"Plain":  nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("options = %v, want %v", got, want)
+	}
+}
+
+func TestOptionName(t *testing.T) {
+	extensions := map[string]bool{
+		"auth.public":           true,
+		"auth.v1.public":        true,
+		"auth.v1.Policy.policy": true,
+	}
+	cases := []struct {
+		pkg, ext, name, want string
+	}{
+		{"auth.v1", "public", "public", "auth.v1.public"},
+		{"auth", "public", "public", "auth.public"},
+		{"auth.v1.admin", "public", "public", "auth.v1.public"},
+		{"auth.v1", "Policy.policy", "Policy.policy.role", "auth.v1.Policy.policy.role"},
+		{"other", "auth.public", "auth.public", "auth.public"},
+		{"auth.v1", ".auth.public", ".auth.public", "auth.public"},
+		// Extensions declared outside of the definition
+		{"other", "hidden", "hidden", "other.hidden"},
+		{"other", "ext.hidden", "ext.hidden.role", "ext.hidden.role"},
+		{"", "hidden", "hidden", "hidden"},
+		// Options which are not extensions
+		{"other", "", "deprecated", "deprecated"},
+	}
+	for _, c := range cases {
+		o := svcparse.Option{Name: c.name, Extension: c.ext}
+		if got := optionName(c.pkg, &o, extensions); got != c.want {
+			t.Errorf("name of option %q in package %q = %q, want %q", c.name, c.pkg, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package svcdef
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gogen "github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/metaverse/truss/svcdef/svcparse"
+)
+
+// scalarRuleTypes maps the types of rules on scalar fields to the Go type of
+// the fields they apply to.
+var scalarRuleTypes = map[string]string{
+	"float":    "float32",
+	"double":   "float64",
+	"int32":    "int32",
+	"sint32":   "int32",
+	"sfixed32": "int32",
+	"int64":    "int64",
+	"sint64":   "int64",
+	"sfixed64": "int64",
+	"uint32":   "uint32",
+	"fixed32":  "uint32",
+	"uint64":   "uint64",
+	"fixed64":  "uint64",
+	"string":   "string",
+}
+
+// supportedRules lists the rules which truss generates validation for, by
+// type of rule. Numeric types all support the same rules.
+var supportedRules = map[string][]string{
+	"number":   {"const", "lt", "lte", "gt", "gte"},
+	"string":   {"const", "min_len", "max_len", "pattern", "prefix", "suffix"},
+	"bytes":    {"min_len", "max_len"},
+	"enum":     {"defined_only"},
+	"message":  {"required"},
+	"repeated": {"min_items", "max_items"},
+	"map":      {"min_pairs", "max_pairs"},
+}
+
+// consolidateRules accepts a Svcdef and the io.Readers for the proto files
+// comprising the definition. It sets the Rules of every message field with a
+// (validate.rules) option. Rules which cannot apply to their field are
+// reported together, each with the file and line of the rule, while rules
+// truss does not support are skipped with a warning.
+func consolidateRules(sd *Svcdef, protoFiles map[string]io.Reader) error {
+	var paths []string
+	for path := range protoFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var problems locationErrors
+	for _, path := range paths {
+		lex := svcparse.NewProtoLexer(protoFiles[path])
+		fieldRules, err := svcparse.ParseFieldRules(lex)
+		if err != nil {
+			return errors.Wrapf(err, "cannot parse validation rules of %q", path)
+		}
+
+		for _, fr := range fieldRules {
+			field := ruleField(sd, fr.Message, fr.Field)
+			if field == nil {
+				problems = append(problems, NewLocationError(
+					fmt.Sprintf("validation rules refer to field %q of message %q, which does not exist", fr.Field, fr.Message),
+					path, strconv.Itoa(fr.Rules[0].Line)))
+				continue
+			}
+			// Fields of oneofs may be shared by definitions parsed earlier, so
+			// their rules are replaced rather than added to
+			var rules []*Rule
+			for _, r := range fr.Rules {
+				name := r.Type + "." + r.Name
+				keep, err := checkRule(field, r)
+				if err != nil {
+					problems = append(problems, NewLocationError(
+						fmt.Sprintf("validation rule %q of field %q in message %q %v", name, fr.Field, fr.Message, err),
+						path, strconv.Itoa(r.Line)))
+					continue
+				}
+				if !keep {
+					log.Warn(NewLocationError(
+						fmt.Sprintf("validation rule %q of field %q in message %q is not supported and will not be enforced", name, fr.Field, fr.Message),
+						path, strconv.Itoa(r.Line)))
+					continue
+				}
+				if r.Value == "false" && (r.Name == "required" || r.Name == "defined_only") {
+					continue
+				}
+				rules = append(rules, &Rule{
+					Type:  r.Type,
+					Name:  r.Name,
+					Value: r.Value,
+				})
+			}
+			field.Rules = rules
+		}
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// ruleField returns the field named field in the proto file of the message
+// named msgName, where nested messages are joined with ".". Fields within
+// oneofs are included. If there is no such field, nil is returned.
+func ruleField(sd *Svcdef, msgName, field string) *Field {
+	goName := gogen.CamelCaseSlice(strings.Split(msgName, "."))
+	for _, m := range sd.Messages {
+		if m.Name != goName {
+			continue
+		}
+		for _, f := range m.Fields {
+			if f.PBFieldName == field {
+				return f
+			}
+			for _, o := range f.Type.Oneof {
+				if o.PBFieldName == field {
+					return o
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkRule returns whether r is supported, and an error completing the
+// sentence "validation rule ... of field ..." if r cannot apply to field or
+// has an invalid value.
+func checkRule(field *Field, r *svcparse.Rule) (bool, error) {
+	ft := field.Type
+	kind := r.Type
+	var fits bool
+	switch goType, scalar := scalarRuleTypes[r.Type]; {
+	case scalar:
+		fits = ft.Name == goType && !ft.ArrayType
+		if goType != "string" {
+			kind = "number"
+		}
+	case r.Type == "bytes":
+		fits = ft.Name == "byte" && ft.ArrayType
+	case r.Type == "enum":
+		fits = ft.Enum != nil && !ft.ArrayType
+	case r.Type == "message":
+		fits = ft.StarExpr && !ft.ArrayType
+	case r.Type == "repeated":
+		fits = ft.ArrayType && ft.Name != "byte"
+	case r.Type == "map":
+		fits = ft.Map != nil
+	default:
+		return false, nil
+	}
+	if !contains(supportedRules[kind], r.Name) {
+		return false, nil
+	}
+	if !fits {
+		return true, errors.Errorf("cannot apply to a field of type %s", typeName(ft))
+	}
+
+	var err error
+	switch {
+	case kind == "number":
+		// The value must fit the Go type of the field to compare with it
+		goType := scalarRuleTypes[r.Type]
+		bits := 64
+		if strings.HasSuffix(goType, "32") {
+			bits = 32
+		}
+		switch {
+		case strings.HasPrefix(goType, "float"):
+			_, err = strconv.ParseFloat(r.Value, bits)
+		case strings.HasPrefix(goType, "uint"):
+			_, err = strconv.ParseUint(r.Value, 0, bits)
+		default:
+			_, err = strconv.ParseInt(r.Value, 0, bits)
+		}
+	case strings.HasPrefix(r.Name, "min_") || strings.HasPrefix(r.Name, "max_"):
+		_, err = strconv.ParseUint(r.Value, 10, 64)
+	case r.Name == "pattern":
+		_, err = regexp.Compile(r.Value)
+	case r.Name == "required" || r.Name == "defined_only":
+		_, err = strconv.ParseBool(r.Value)
+	}
+	if err != nil {
+		return true, errors.Errorf("has invalid value %q: %v", r.Value, errors.Cause(err))
+	}
+	return true, nil
+}
+
+// typeName returns the name of ft as it would be written in Go.
+func typeName(ft *FieldType) string {
+	switch {
+	case ft.Map != nil:
+		return "map[" + typeName(ft.Map.KeyType) + "]" + typeName(ft.Map.ValueType)
+	case ft.ArrayType:
+		elem := *ft
+		elem.ArrayType = false
+		return "[]" + typeName(&elem)
+	case ft.StarExpr:
+		return "*" + ft.Name
+	}
+	return ft.Name
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package svcdef
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/metaverse/truss/svcdef/svcparse"
+)
+
+const rulesHead = `
+syntax = "proto3";
+package rules;
+
+import "github.com/metaverse/truss/deftree/validate/validate.proto";
+`
+
+func TestRules(t *testing.T) {
+	def := rulesHead + `
+message Thing {
+  string name = 1 [(validate.rules).string = {min_len: 1, pattern: "^[a-z]+$"}];
+  int32 age = 2 [(validate.rules).int32.gte = 0];
+  Owner owner = 3 [(validate.rules).message.required = false];
+  repeated Owner owners = 4 [(validate.rules).repeated = {min_items: 1}];
+  oneof id {
+    uint64 number = 5 [(validate.rules).uint64.gt = 0];
+  }
+  message Inner {
+    bytes data = 1 [(validate.rules).bytes.max_len = 16];
+  }
+}
+
+message Owner {
+  map<string, string> tags = 1 [(validate.rules).map.max_pairs = 4];
+}
+`
+	sd, err := NewFromString(def, gopath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := map[string][]*Rule{}
+	for _, m := range sd.Messages {
+		for _, f := range m.Fields {
+			rules[m.Name+"."+f.PBFieldName] = f.Rules
+			for _, o := range f.Type.Oneof {
+				rules[m.Name+"."+o.PBFieldName] = o.Rules
+			}
+		}
+	}
+
+	want := map[string][]*Rule{
+		"Thing.name": {
+			{Type: "string", Name: "min_len", Value: "1"},
+			{Type: "string", Name: "pattern", Value: "^[a-z]+$"},
+		},
+		"Thing.age":        {{Type: "int32", Name: "gte", Value: "0"}},
+		"Thing.owner":      nil,
+		"Thing.owners":     {{Type: "repeated", Name: "min_items", Value: "1"}},
+		"Thing.number":     {{Type: "uint64", Name: "gt", Value: "0"}},
+		"Thing_Inner.data": {{Type: "bytes", Name: "max_len", Value: "16"}},
+		"Owner.tags":       {{Type: "map", Name: "max_pairs", Value: "4"}},
+	}
+	for name, w := range want {
+		if got := rules[name]; !reflect.DeepEqual(got, w) {
+			t.Errorf("rules of %s = %v, want %v", name, got, w)
+		}
+	}
+}
+
+func TestRulesProblems(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		// want is a substring of the error, empty if there should be none
+		want string
+	}{
+		{
+			name:  "valid",
+			field: `string name = 1 [(validate.rules).string.prefix = "a"];`,
+		},
+		{
+			name:  "wrong type",
+			field: `int32 name = 1 [(validate.rules).string.min_len = 1];`,
+			want:  `validation rule "string.min_len" of field "name" in message "Thing" cannot apply to a field of type int32 in file "/tmp/doesntexist.proto" at line 8`,
+		},
+		{
+			name:  "repeated field",
+			field: `repeated int64 name = 1 [(validate.rules).int64.gt = 1];`,
+			want:  `cannot apply to a field of type []int64`,
+		},
+		{
+			name:  "repeated enum",
+			field: "enum Status {\n    NONE = 0;\n  }\n  repeated Status name = 1 [(validate.rules).enum.defined_only = true];",
+			want:  `validation rule "enum.defined_only" of field "name" in message "Thing" cannot apply to a field of type []Thing_Status`,
+		},
+		{
+			name:  "invalid pattern",
+			field: `string name = 1 [(validate.rules).string.pattern = "(a"];`,
+			want:  `validation rule "string.pattern" of field "name" in message "Thing" has invalid value "(a"`,
+		},
+		{
+			name:  "value out of range",
+			field: `float name = 1 [(validate.rules).float.lt = 1e40];`,
+			want:  `has invalid value "1e40"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := rulesHead + "\nmessage Thing {\n  " + tt.field + "\n}\n"
+			_, err := NewFromString(def, gopath)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %v does not contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRuleUnsupported(t *testing.T) {
+	// Definitions importing the validate.proto of protoc-gen-validate may
+	// set rules which truss does not support
+	field := &Field{Type: &FieldType{Name: "string"}}
+	for _, r := range []*svcparse.Rule{
+		{Type: "string", Name: "email", Value: "true"},
+		{Type: "timestamp", Name: "lt_now", Value: "true"},
+	} {
+		keep, err := checkRule(field, r)
+		if keep || err != nil {
+			t.Errorf("checkRule(%s.%s) = %v, %v; want false, nil", r.Type, r.Name, keep, err)
+		}
+	}
+}
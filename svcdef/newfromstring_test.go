@@ -60,6 +60,7 @@ func TestMessages(t *testing.T) {
 				&Field{
 					Name:        "A",
 					PBFieldName: "a",
+					JSONName:    "a",
 					Type: &FieldType{
 						Name:      "int64",
 						Enum:      nil,
@@ -72,6 +73,7 @@ func TestMessages(t *testing.T) {
 				&Field{
 					Name:        "B",
 					PBFieldName: "b",
+					JSONName:    "b",
 					Type: &FieldType{
 						Name:      "int64",
 						Enum:      nil,
@@ -89,6 +91,7 @@ func TestMessages(t *testing.T) {
 				&Field{
 					Name:        "V",
 					PBFieldName: "v",
+					JSONName:    "v",
 					Type: &FieldType{
 						Name:      "int64",
 						Enum:      nil,
@@ -101,6 +104,7 @@ func TestMessages(t *testing.T) {
 				&Field{
 					Name:        "Err",
 					PBFieldName: "err",
+					JSONName:    "err",
 					Type: &FieldType{
 						Name:      "string",
 						Enum:      nil,
@@ -136,6 +140,7 @@ func TestHTTPBinding(t *testing.T) {
 					Field: &Field{
 						Name:        "A",
 						PBFieldName: "a",
+						JSONName:    "a",
 						Type: &FieldType{
 							Name: "int64",
 						},
@@ -146,6 +151,7 @@ func TestHTTPBinding(t *testing.T) {
 					Field: &Field{
 						Name:        "B",
 						PBFieldName: "b",
+						JSONName:    "b",
 						Type: &FieldType{
 							Name: "int64",
 						},
@@ -17,12 +17,15 @@ the path of an HTTP annotation.
 package svcdef
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"io"
+	"io/ioutil"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -44,12 +47,27 @@ type Svcdef struct {
 
 // Message represents a protobuf Message, though greatly simplified.
 type Message struct {
-	Name   string
-	Fields []*Field
+	Name string
+	// Description is the comment above the message in the .proto file.
+	Description string
+	Fields      []*Field
 }
 
 type Enum struct {
 	Name string
+	// Description is the comment above the enum in the .proto file.
+	Description string
+	// Values are the values of the enum, in the order they are declared.
+	Values []*EnumValue
+}
+
+// EnumValue is a single value of an Enum.
+type EnumValue struct {
+	// Name is the name of the value in the .proto file, for example "ACTIVE".
+	Name   string
+	Number int32
+	// Description is the comment above the value in the .proto file.
+	Description string
 }
 
 type Map struct {
@@ -70,6 +88,14 @@ type ServiceMethod struct {
 	// Bindings contains information for mapping http paths and paramters onto
 	// the fields of this ServiceMethods RequestType.
 	Bindings []*HTTPBinding
+	// Options maps the names of the custom options declared on the rpc to
+	// their values. Options are named by the fully-qualified name of their
+	// extension, so an extension role declared by package auth gives
+	// "auth.role", whether written (auth.role), (.auth.role) or, within
+	// package auth, (role). Fields of aggregate values are joined to the name
+	// of their option with a ".", as in "auth.policy.role". HTTP bindings are
+	// not included.
+	Options map[string]string
 }
 
 // Field represents a field on a protobuf message.
@@ -79,14 +105,37 @@ type Field struct {
 	// For Example: 'snake_case' from below -- where Name would be 'SnakeCase'
 	// `protobuf:"varint,1,opt,name=snake_case,json=snakeCase" json:"snake_case,omitempty"`
 	PBFieldName string
+	// JSONName is the name of the field in the proto3 JSON mapping, taken
+	// from the 'json=' of the protobuf tag. It is 'snakeCase' for the example
+	// above, and the same as PBFieldName when the tag has no 'json='.
+	JSONName string
+	// Description is the comment above the field in the .proto file.
+	Description string
 	Type        *FieldType
+	// Rules are the validation rules declared on the field with the
+	// (validate.rules) option, in the order they are declared.
+	Rules []*Rule
+}
+
+// Rule is a single validation rule of a Field. For example the option
+// `(validate.rules).string.min_len = 1` is the Rule
+// {Type: "string", Name: "min_len", Value: "1"}.
+type Rule struct {
+	// Type is the kind of field the rule applies to, for example "string",
+	// "int64", "message" or "repeated".
+	Type string
+	// Name is the constraint, for example "min_len", "gte" or "required".
+	Name string
+	// Value is the value of the constraint, with string literals unquoted.
+	Value string
 }
 
 // FieldType contains information about the type of one Field on a message,
 // such as if that Field is a slice or if it's a pointer, as well as a
 // reference to the definition of the type of this Field.
 type FieldType struct {
-	// Name will contain the name of the type, for example "string" or "bool"
+	// Name will contain the name of the type, for example "string" or "bool".
+	// Types from other packages are qualified, for example "types.Timestamp"
 	Name string
 	// Enum contains a pointer to the Enum type this fieldtype represents, if
 	// this FieldType represents an Enum. If not, Enum is nil.
@@ -292,6 +341,7 @@ func New(goFiles map[string]io.Reader, protoFiles map[string]io.Reader) (*Svcdef
 			}
 		}
 
+		fileEnums := len(rv.Enums)
 		for _, t := range typespecs {
 			switch typdf := t.Type.(type) {
 			case *ast.Ident:
@@ -318,22 +368,166 @@ func New(goFiles map[string]io.Reader, protoFiles map[string]io.Reader) (*Svcdef
 				rv.Messages = append(rv.Messages, nmsg)
 			}
 		}
+		setEnumValues(fileAst, rv.Enums[fileEnums:])
 	}
 	resolveTypes(&rv)
-	err := consolidateHTTP(&rv, protoFiles)
+
+	// Each proto file is parsed once for HTTP annotations, once for
+	// validation rules, twice for method options, lexing the extensions
+	// they may use first, and once for comments
+	protoSrc := map[string][]byte{}
+	for path, r := range protoFiles {
+		src, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read proto file %q", path)
+		}
+		protoSrc[path] = src
+	}
+
+	err := consolidateHTTP(&rv, readers(protoSrc))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to consolidate HTTP")
 	}
+	err = consolidateRules(&rv, readers(protoSrc))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consolidate validation rules")
+	}
+	err = consolidateOptions(&rv, protoSrc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consolidate method options")
+	}
+	err = consolidateComments(&rv, readers(protoSrc))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consolidate comments")
+	}
 
 	return &rv, nil
 }
 
+// readers returns a reader over each of the files in src.
+func readers(src map[string][]byte) map[string]io.Reader {
+	rv := make(map[string]io.Reader, len(src))
+	for path, b := range src {
+		rv[path] = bytes.NewReader(b)
+	}
+	return rv
+}
+
 func NewEnum(e *ast.TypeSpec) (*Enum, error) {
 	return &Enum{
 		Name: e.Name.Name,
 	}, nil
 }
 
+// setEnumValues sets the Values of enums, which must be declared in f. The
+// values are read from the constants of each enum type, which carry their
+// number, and the "{ENUM}_value" map generated for each enum, which carries
+// their names as declared in the .proto file.
+func setEnumValues(f *ast.File, enums []*Enum) {
+	byName := make(map[string]*Enum)
+	for _, e := range enums {
+		byName[e.Name] = e
+	}
+	type constant struct {
+		enum   *Enum
+		goName string
+		number int32
+	}
+	var consts []constant
+	names := make(map[*Enum]map[string]int32)
+
+	for _, d := range f.Decls {
+		decl, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			switch decl.Tok {
+			case token.CONST:
+				typ, ok := vs.Type.(*ast.Ident)
+				if !ok || byName[typ.Name] == nil {
+					continue
+				}
+				number, ok := intLit(vs.Values[0])
+				if !ok {
+					continue
+				}
+				consts = append(consts, constant{
+					enum:   byName[typ.Name],
+					goName: vs.Names[0].Name,
+					number: number,
+				})
+			case token.VAR:
+				e := byName[strings.TrimSuffix(vs.Names[0].Name, "_value")]
+				lit, ok := vs.Values[0].(*ast.CompositeLit)
+				if e == nil || !ok {
+					continue
+				}
+				names[e] = make(map[string]int32)
+				for _, elt := range lit.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					key, ok := kv.Key.(*ast.BasicLit)
+					if !ok {
+						continue
+					}
+					name, err := strconv.Unquote(key.Value)
+					number, ok := intLit(kv.Value)
+					if err == nil && ok {
+						names[e][name] = number
+					}
+				}
+			}
+		}
+	}
+
+	for _, c := range consts {
+		// The constant is named "{PREFIX}_{NAME}", where the prefix is the
+		// name of the enum, or of the message the enum is nested in. Names
+		// may contain underscores, so the longest matching name is used.
+		name, longest := c.goName, 0
+		for n, number := range names[c.enum] {
+			if number != c.number || len(n) <= longest {
+				continue
+			}
+			if c.goName == n || strings.HasSuffix(c.goName, "_"+n) {
+				name, longest = n, len(n)
+			}
+		}
+		c.enum.Values = append(c.enum.Values, &EnumValue{
+			Name:   name,
+			Number: c.number,
+		})
+	}
+}
+
+// intLit returns the value of an integer literal, which may be negated.
+func intLit(e ast.Expr) (int32, bool) {
+	neg := false
+	if u, ok := e.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		neg = true
+		e = u.X
+	}
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(lit.Value, 0, 32)
+	if err != nil {
+		return 0, false
+	}
+	if neg {
+		n = -n
+	}
+	return int32(n), true
+}
+
 // NewMessage returns a new Message struct derived from an *ast.TypeSpec with a
 // Type of *ast.StructType.
 func NewMessage(m *ast.TypeSpec) (*Message, error) {
@@ -515,6 +709,12 @@ func NewField(f *ast.Field) (*Field, error) {
 					rv.PBFieldName = subFields[4][idx+1:]
 				}
 			}
+			rv.JSONName = rv.PBFieldName
+			for _, sub := range subFields {
+				if strings.HasPrefix(sub, "json=") {
+					rv.JSONName = strings.TrimPrefix(sub, "json=")
+				}
+			}
 		}
 
 		switch ex := e.(type) {
@@ -523,6 +723,13 @@ func NewField(f *ast.Field) (*Field, error) {
 			if oneof, ok := oneofs[ex.Name]; ok {
 				rv.Type.Oneof = oneof
 			}
+		case *ast.SelectorExpr:
+			// Types from other packages, such as the well-known types
+			// mapped to github.com/gogo/protobuf/types, keep their package
+			// name, e.g. "types.Timestamp"
+			if pkg, ok := ex.X.(*ast.Ident); ok {
+				rv.Type.Name += pkg.Name + "." + ex.Sel.Name
+			}
 		case *ast.StarExpr:
 			rv.Type.StarExpr = true
 			typeFollower(ex.X)
@@ -139,3 +139,145 @@ type MsgWithMap struct {
 	}
 
 }
+
+func TestFieldNames(t *testing.T) {
+	caseCode := "package TEST\n" +
+		"type ListRequest struct {\n" +
+		"	PageSize int32 `protobuf:\"varint,1,opt,name=page_size,json=pageSize,proto3\" json:\"page_size,omitempty\"`\n" +
+		"	Filter string `protobuf:\"bytes,2,opt,name=filter,proto3\" json:\"filter,omitempty\"`\n" +
+		"	Custom []string `protobuf:\"bytes,3,rep,name=custom,json=renamed,proto3\" json:\"custom,omitempty\"`\n" +
+		"}\n"
+	sd, err := New(map[string]io.Reader{"/tmp/notreal": strings.NewReader(caseCode)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases = []struct {
+		name, pbName, jsonName string
+	}{
+		{"PageSize", "page_size", "pageSize"},
+		{"Filter", "filter", "filter"},
+		{"Custom", "custom", "renamed"},
+	}
+	fields := sd.Messages[0].Fields
+	for i, c := range cases {
+		f := fields[i]
+		if f.Name != c.name || f.PBFieldName != c.pbName || f.JSONName != c.jsonName {
+			t.Errorf("got field (%q, %q, %q), want (%q, %q, %q)", f.Name, f.PBFieldName, f.JSONName, c.name, c.pbName, c.jsonName)
+		}
+	}
+}
+
+func TestSelectorTypeNames(t *testing.T) {
+	caseCode := `
+package TEST
+
+type Event struct {
+	At *types.Timestamp
+	History []*types.Timestamp
+	Count *types.Int64Value
+}
+`
+	sd, err := New(map[string]io.Reader{"/tmp/notreal": strings.NewReader(caseCode)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases = []struct {
+		name     string
+		star     bool
+		repeated bool
+	}{
+		{"types.Timestamp", true, false},
+		{"types.Timestamp", true, true},
+		{"types.Int64Value", true, false},
+	}
+	for i, c := range cases {
+		ft := sd.Messages[0].Fields[i].Type
+		if ft.Name != c.name || ft.StarExpr != c.star || ft.ArrayType != c.repeated {
+			t.Errorf("got type (%q, %v, %v), want (%q, %v, %v)", ft.Name, ft.StarExpr, ft.ArrayType, c.name, c.star, c.repeated)
+		}
+		if ft.Message != nil {
+			t.Errorf("%s resolved to message %q", ft.Name, ft.Message.Name)
+		}
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	caseCode := `
+package TEST
+
+type Status int32
+
+const (
+	Status_UNKNOWN Status = 0
+	Status_ACTIVE  Status = 1
+	Status_NEG     Status = -1
+)
+
+var Status_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "ACTIVE",
+	-1: "NEG",
+}
+
+var Status_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ACTIVE":  1,
+	"NEG":     -1,
+}
+
+type Thing_Kind int32
+
+const (
+	Thing_K_NONE Thing_Kind = 0
+	Thing_NONE   Thing_Kind = 0
+	Thing_K_BIG Thing_Kind = 1
+)
+
+var Thing_Kind_value = map[string]int32{
+	"K_NONE": 0,
+	"NONE":   0,
+	"K_BIG":  1,
+}
+
+type Thing struct {
+	Name   string     ` + "`" + `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` + "`" + `
+	Status Status     ` + "`" + `protobuf:"varint,2,opt,name=status,proto3,enum=cm.Status" json:"status,omitempty"` + "`" + `
+	Kind   Thing_Kind ` + "`" + `protobuf:"varint,3,opt,name=kind,proto3,enum=cm.Thing_Kind" json:"kind,omitempty"` + "`" + `
+}`
+	sd, err := New(map[string]io.Reader{"/tmp/notreal": strings.NewReader(caseCode)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmap := newTypeMap(sd)
+
+	status := tmap["Status"].Enum
+	kind := tmap["Thing_Kind"].Enum
+
+	var cases = []struct {
+		enum   *Enum
+		values []EnumValue
+	}{
+		{status, []EnumValue{
+			{Name: "UNKNOWN", Number: 0},
+			{Name: "ACTIVE", Number: 1},
+			{Name: "NEG", Number: -1},
+		}},
+		{kind, []EnumValue{
+			{Name: "K_NONE", Number: 0},
+			{Name: "NONE", Number: 0},
+			{Name: "K_BIG", Number: 1},
+		}},
+	}
+	for _, c := range cases {
+		if len(c.enum.Values) != len(c.values) {
+			t.Fatalf("%s has %d values, want %d", c.enum.Name, len(c.enum.Values), len(c.values))
+		}
+		for i, want := range c.values {
+			if got := *c.enum.Values[i]; got != want {
+				t.Errorf("%s value %d = %+v, want %+v", c.enum.Name, i, got, want)
+			}
+		}
+	}
+}
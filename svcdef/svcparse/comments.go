@@ -0,0 +1,179 @@
+package svcparse
+
+import (
+	"strings"
+)
+
+// Comment is the comment right above the declaration of a message, enum,
+// field or enum value in a proto file. Comments separated from the
+// declaration by a blank line, and comments trailing a line, are not
+// attached to it, as protoc does.
+type Comment struct {
+	// Kind is "message", "enum", "field" or "value".
+	Kind string
+	// Parent is the name of the message declaring a message, enum or field,
+	// or of the enum declaring a value. Nested declarations are joined with
+	// a ".", as in "Outer.Inner". It is empty for top level messages and
+	// enums.
+	Parent string
+	// Name is the name of the declaration in the proto file.
+	Name string
+	// Text is the comment without its markers.
+	Text string
+}
+
+// ParseComments returns the comments of the messages, enums, fields and enum
+// values read by lex, which should be created with NewProtoLexer.
+// Declarations without a comment are not returned.
+func ParseComments(lex *SvcLexer) ([]*Comment, error) {
+	var rv []*Comment
+	var blocks []block
+	// The last three tokens, ignoring comments and whitespace
+	var prev [3]string
+
+	// pending is the comment which would lead the next token, and comment
+	// the one leading the current statement
+	var pending, comment string
+	// first is the first token of the current statement
+	var first string
+	// brackets is the depth of the field options being skipped
+	var brackets int
+	// newline is whether a new line started since the last token
+	newline := true
+
+	for {
+		tk, val := lex.GetToken()
+		switch tk {
+		case EOF:
+			return rv, nil
+		case ILLEGAL:
+			return nil, parserErr{
+				expected: "legal token while parsing comments",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		case WHITESPACE:
+			n := strings.Count(val, "\n")
+			if n > 0 {
+				newline = true
+			}
+			// A blank line detaches the comment from what follows
+			if n >= 2 || (n == 1 && strings.HasSuffix(pending, "\n")) {
+				pending = ""
+			}
+			continue
+		case COMMENT:
+			if !newline {
+				// The first line trails the last token, while the lexer
+				// joins the lines after it, which lead the next one
+				i := strings.Index(val, "\n")
+				if i < 0 {
+					continue
+				}
+				val = val[i+1:]
+			}
+			pending = val
+			newline = strings.HasSuffix(val, "\n")
+			continue
+		}
+
+		newline = false
+		if first == "" {
+			first, comment = val, pending
+		}
+		pending = ""
+
+		switch {
+		case brackets > 0:
+			if val == "[" {
+				brackets++
+			} else if val == "]" {
+				brackets--
+			}
+		case val == "[":
+			brackets++
+		case tk == OPEN_BRACE:
+			switch prev[1] {
+			case "message", "enum":
+				if comment != "" {
+					rv = append(rv, &Comment{
+						Kind:   prev[1],
+						Parent: scopeName(blocks),
+						Name:   prev[2],
+						Text:   commentText(comment),
+					})
+				}
+				fallthrough
+			case "oneof", "service", "extend":
+				blocks = append(blocks, block{kind: prev[1], name: prev[2]})
+			default:
+				blocks = append(blocks, block{})
+			}
+			first = ""
+		case tk == CLOSE_BRACE:
+			if len(blocks) == 0 {
+				return nil, parserErr{
+					expected: "no '}' outside of a block",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			blocks = blocks[:len(blocks)-1]
+			first = ""
+		case val == ";":
+			first = ""
+		case val == "=" && comment != "" && first != "option" && first != "reserved":
+			switch {
+			case inMessage(blocks):
+				rv = append(rv, &Comment{
+					Kind:   "field",
+					Parent: messageName(blocks),
+					Name:   prev[2],
+					Text:   commentText(comment),
+				})
+			case len(blocks) > 0 && blocks[len(blocks)-1].kind == "enum":
+				rv = append(rv, &Comment{
+					Kind:   "value",
+					Parent: scopeName(blocks),
+					Name:   prev[2],
+					Text:   commentText(comment),
+				})
+			}
+			comment = ""
+		}
+		prev[0], prev[1], prev[2] = prev[1], prev[2], val
+	}
+}
+
+// scopeName returns the names of the messages and enums of blocks joined
+// with a ".".
+func scopeName(blocks []block) string {
+	var names []string
+	for _, b := range blocks {
+		if b.kind == "message" || b.kind == "enum" {
+			names = append(names, b.name)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// commentText returns the text of comment, which may be made of several line
+// and block comments, without the comment markers and the first space of
+// each line, as go/ast does for doc comments.
+func commentText(comment string) string {
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "//"):
+			line = strings.TrimPrefix(line, "//")
+		case strings.HasPrefix(line, "/*"):
+			line = strings.TrimPrefix(line, "/*")
+		case strings.HasPrefix(line, "*") && !strings.HasPrefix(line, "*/"):
+			line = strings.TrimPrefix(line, "*")
+		}
+		line = strings.TrimSuffix(line, "*/")
+		lines = append(lines, strings.TrimPrefix(line, " "))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,80 @@
+package svcparse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+const commentsProto = `
+// Package comment, detached by the blank line
+
+// Status is the state of a thing.
+// It has two lines.
+enum Status {
+  option allow_alias = true;
+  // Nothing is known.
+  UNKNOWN = 0;
+  ACTIVE = 1; // trailing, not a comment of ACTIVE
+  // Gone things
+  GONE = 2 [deprecated = true];
+}
+
+/* Thing is a thing. */
+message Thing {
+  // name of the thing
+  string name = 1 [(validate.rules).string = {min_len: 1}];
+  Status status = 2;
+
+  // Kind is nested
+  enum Kind {
+    K_NONE = 0;
+    // Big things
+    K_BIG = 1;
+  }
+  map<string, string> labels = 3;
+  oneof choice {
+    // A first choice
+    int32 first = 4;
+  }
+  message Part {
+    /*
+     * id of the part
+     */
+    int64 id = 1;
+  }
+}
+
+service Things {
+  // Get gets
+  rpc Get (Thing) returns (Thing) {
+    option (google.api.http) = {
+      get: "/thing"
+    };
+  }
+}
+`
+
+func TestParseComments(t *testing.T) {
+	got, err := ParseComments(NewProtoLexer(strings.NewReader(commentsProto)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Comment{
+		{Kind: "enum", Name: "Status", Text: "Status is the state of a thing.\nIt has two lines."},
+		{Kind: "value", Parent: "Status", Name: "UNKNOWN", Text: "Nothing is known."},
+		{Kind: "value", Parent: "Status", Name: "GONE", Text: "Gone things"},
+		{Kind: "message", Name: "Thing", Text: "Thing is a thing."},
+		{Kind: "field", Parent: "Thing", Name: "name", Text: "name of the thing"},
+		{Kind: "enum", Parent: "Thing", Name: "Kind", Text: "Kind is nested"},
+		{Kind: "value", Parent: "Thing.Kind", Name: "K_BIG", Text: "Big things"},
+		{Kind: "field", Parent: "Thing", Name: "first", Text: "A first choice"},
+		{Kind: "field", Parent: "Thing.Part", Name: "id", Text: "id of the part"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Comments differ from expected:\n%s", DiffStrings(spew.Sdump(want), spew.Sdump(got), "want", "got"))
+	}
+}
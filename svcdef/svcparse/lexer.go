@@ -54,7 +54,10 @@ func NewTokenGroup(scn *SvcScanner) *TokenGroup {
 		for {
 			one_pos := scn.UnitPos
 			one, err := scn.ReadUnit()
-			if err != nil {
+			if err == io.EOF {
+				// The file may end with a comment
+				break
+			} else if err != nil {
 				panic(err)
 			}
 			onestr := string(one)
@@ -63,7 +66,10 @@ func NewTokenGroup(scn *SvcScanner) *TokenGroup {
 			} else if unicode.IsSpace(one[0]) {
 				if strings.Count(onestr, "\n") == 0 {
 					two, err := scn.ReadUnit()
-					if err != nil {
+					if err == io.EOF {
+						scn.UnReadToPosition(one_pos)
+						break
+					} else if err != nil {
 						panic(err)
 					}
 					twostr := string(two)
@@ -93,8 +99,19 @@ func NewTokenGroup(scn *SvcScanner) *TokenGroup {
 }
 
 func NewSvcLexer(r io.Reader) *SvcLexer {
-	b := make([]*TokenGroup, 0)
+	return newLexer(NewSvcScanner(r))
+}
+
+// NewProtoLexer returns a lexer over every token of the proto file read from
+// r, where NewSvcLexer only returns the tokens of service definitions.
+func NewProtoLexer(r io.Reader) *SvcLexer {
 	scn := NewSvcScanner(r)
+	scn.wholeFile = true
+	return newLexer(scn)
+}
+
+func newLexer(scn *SvcScanner) *SvcLexer {
+	b := make([]*TokenGroup, 0)
 	for {
 		grp := NewTokenGroup(scn)
 		if grp.token != ILLEGAL && grp.token != EOF {
@@ -0,0 +1,314 @@
+package svcparse
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// httpOption is the name of the method option declaring HTTP bindings, which
+// are parsed by ParseService rather than ParseMethodOptions.
+const httpOption = "(google.api.http)"
+
+// MethodOptions are the custom options declared on a single rpc of a service.
+type MethodOptions struct {
+	// Package is the proto package of the file declaring the service.
+	Package string
+	Service string
+	Method  string
+	Options []*Option
+}
+
+// Option is a single value of a method option. Both
+//
+//	option (auth.policy).role = "admin";
+//
+// and
+//
+//	option (auth.policy) = {role: "admin"};
+//
+// result in the Option {Name: "auth.policy.role", Value: "admin"}.
+type Option struct {
+	// Name is the name of the option without parentheses. Fields of
+	// aggregate values are joined to it with a ".".
+	Name string
+	// Extension is the name of the extension set by the option, as written
+	// between the parentheses, such as "auth.policy". It is empty for the
+	// options which are not extensions.
+	Extension string
+	// Value is the value of the option. String literals are unquoted.
+	Value string
+	// Line is the line of the proto file on which Value was declared.
+	Line int
+}
+
+// ParseMethodOptions returns the options declared on the rpcs of every
+// service read by lex, which should be created with NewProtoLexer, other than
+// their HTTP bindings. Rpcs without such options are not returned.
+func ParseMethodOptions(lex *SvcLexer) ([]*MethodOptions, error) {
+	var rv []*MethodOptions
+	var blocks []block
+	var pkg string
+	// The name of the rpc being declared, until its '{' or ';'
+	var rpc string
+	var prev string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case tk == EOF:
+			return rv, nil
+		case tk == ILLEGAL:
+			return nil, parserErr{
+				expected: "legal token while parsing services",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		case tk == OPEN_BRACE:
+			if rpc != "" {
+				blocks = append(blocks, block{kind: "rpc", name: rpc})
+				rpc = ""
+				break
+			}
+			blocks = append(blocks, block{})
+		case tk == CLOSE_BRACE:
+			if len(blocks) == 0 {
+				return nil, parserErr{
+					expected: "no '}' outside of a block",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			blocks = blocks[:len(blocks)-1]
+		case prev == "rpc" && tk == IDENT && len(blocks) == 1 && blocks[0].kind == "service":
+			rpc = val
+		case val == ";":
+			rpc = ""
+		case val == "package" && len(blocks) == 0:
+			var err error
+			if pkg, err = parsePackageName(lex); err != nil {
+				return nil, err
+			}
+		case val == "service" && len(blocks) == 0:
+			_, name := lex.GetTokenIgnoreCommentAndWhitespace()
+			tk, val = lex.GetTokenIgnoreCommentAndWhitespace()
+			if tk != OPEN_BRACE {
+				return nil, parserErr{
+					expected: "'{' after service name",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			blocks = append(blocks, block{kind: "service", name: name})
+		case val == "option" && len(blocks) == 2 && blocks[1].kind == "rpc":
+			name, err := parseOptionName(lex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot parse options of rpc %q", blocks[1].name)
+			}
+			opts, err := parseMethodOption(lex, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot parse options of rpc %q", blocks[1].name)
+			}
+			if len(opts) == 0 {
+				break
+			}
+			if len(rv) == 0 || rv[len(rv)-1].Method != blocks[1].name || rv[len(rv)-1].Service != blocks[0].name {
+				rv = append(rv, &MethodOptions{Package: pkg, Service: blocks[0].name, Method: blocks[1].name})
+			}
+			last := rv[len(rv)-1]
+			last.Options = append(last.Options, opts...)
+		}
+		prev = val
+	}
+}
+
+// parseOptionName parses the name of an option following the 'option'
+// keyword up to and including the '='.
+func parseOptionName(lex *SvcLexer) (string, error) {
+	var name string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		if tk == EOF || tk == ILLEGAL || val == ";" {
+			return "", parserErr{
+				expected: "'=' after method option name",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		}
+		if val == "=" {
+			return name, nil
+		}
+		name += val
+	}
+}
+
+// parseMethodOption parses the value of the rpc option named name following
+// the '=' up to and including the closing ';'. HTTP bindings are parsed only
+// to be skipped.
+func parseMethodOption(lex *SvcLexer, name string) ([]*Option, error) {
+	values, err := parseOptionValue(lex, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+	if val != ";" {
+		return nil, parserErr{
+			expected: "';' after method option",
+			line:     lex.GetLineNumber(),
+			val:      tk.String() + " " + val,
+		}
+	}
+
+	if name == httpOption || strings.HasPrefix(name, httpOption+".") {
+		return nil, nil
+	}
+	var ext string
+	if i := strings.Index(name, ")"); strings.HasPrefix(name, "(") && i > 0 {
+		ext = name[1:i]
+	}
+	name = strings.NewReplacer("(", "", ")", "").Replace(name)
+	var opts []*Option
+	for _, v := range values {
+		opt := Option{Name: name, Extension: ext, Value: v.Value, Line: v.Line}
+		for _, part := range []string{v.Type, v.Name} {
+			if part != "" {
+				opt.Name += "." + part
+			}
+		}
+		opts = append(opts, &opt)
+	}
+	return opts, nil
+}
+
+// methodOptionsMessage is the message extended to declare method options.
+const methodOptionsMessage = "google.protobuf.MethodOptions"
+
+// ParseExtensions returns the fully-qualified names of the method options
+// declared by extending google.protobuf.MethodOptions in the proto file read
+// by lex, which should be created with NewProtoLexer. An extension declared in
+// message Policy of package auth is named "auth.Policy.name".
+func ParseExtensions(lex *SvcLexer) ([]string, error) {
+	var rv []string
+	var blocks []block
+	var pkg string
+	// start is whether the next token starts a statement, and named whether
+	// the extension declared by the current statement was already found
+	start := true
+	var named bool
+	var prev string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case tk == EOF:
+			return rv, nil
+		case tk == ILLEGAL:
+			return nil, parserErr{
+				expected: "legal token while parsing extensions",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		case tk == OPEN_BRACE:
+			blocks = append(blocks, block{})
+		case tk == CLOSE_BRACE:
+			if len(blocks) == 0 {
+				return nil, parserErr{
+					expected: "no '}' outside of a block",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			blocks = blocks[:len(blocks)-1]
+		case start && val == "package" && len(blocks) == 0:
+			var err error
+			if pkg, err = parsePackageName(lex); err != nil {
+				return nil, err
+			}
+			val = ";"
+		case start && (val == "message" || val == "extend"):
+			// Fields named as these keywords are not followed by a block
+			if name, ok := parseBlockName(lex); ok {
+				blocks = append(blocks, block{kind: val, name: name})
+				val = "{"
+			}
+		case val == "=" && !named && len(blocks) > 0:
+			b := blocks[len(blocks)-1]
+			if b.kind == "extend" && strings.TrimPrefix(b.name, ".") == methodOptionsMessage {
+				names := []string{pkg}
+				for _, b := range blocks {
+					if b.kind == "message" {
+						names = append(names, b.name)
+					}
+				}
+				rv = append(rv, strings.TrimPrefix(strings.Join(append(names, prev), "."), "."))
+			}
+			named = true
+		}
+		start = val == ";" || val == "{" || val == "}"
+		if start {
+			named = false
+		}
+		prev = val
+	}
+}
+
+// parsePackageName parses the name of a package following the 'package'
+// keyword up to and including the ';'.
+func parsePackageName(lex *SvcLexer) (string, error) {
+	var name string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		if tk == EOF || tk == ILLEGAL || tk == OPEN_BRACE || tk == CLOSE_BRACE {
+			return "", parserErr{
+				expected: "';' after package name",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		}
+		if val == ";" {
+			return name, nil
+		}
+		name += val
+	}
+}
+
+// parseBlockName parses the name of a message or of an extended message
+// following its keyword, up to and including the '{'. It returns false, with
+// lex left as it was, if the keyword is not followed by a name and a '{'.
+func parseBlockName(lex *SvcLexer) (string, bool) {
+	start := lex.GetPosition()
+	var name string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case tk == OPEN_BRACE && name != "":
+			return name, true
+		case tk == IDENT || val == ".":
+			name += val
+		default:
+			lex.UnGetToPosition(start)
+			return "", false
+		}
+	}
+}
+
+// skipMethodOptions skips the option statements of an rpc other than its HTTP
+// bindings, leaving lex before the next HTTP binding or the end of the rpc.
+func skipMethodOptions(lex *SvcLexer) error {
+	for {
+		start := lex.GetPosition()
+		_, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		if val != "option" {
+			return lex.UnGetToPosition(start)
+		}
+		name, err := parseOptionName(lex)
+		if err != nil {
+			return err
+		}
+		if name == httpOption {
+			return lex.UnGetToPosition(start)
+		}
+		if _, err := parseMethodOption(lex, name); err != nil {
+			return errors.Wrapf(err, "cannot parse option %q", name)
+		}
+	}
+}
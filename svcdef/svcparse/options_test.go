@@ -0,0 +1,147 @@
+package svcparse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+const optionsProto = `
+syntax = "proto3";
+package options;
+
+message Empty {
+  string rpc = 1 [(validate.rules).string.min_len = 1];
+}
+
+service Options {
+  rpc Public (Empty) returns (Empty) {
+    option (auth.public) = true;
+    option (google.api.http) = {
+      get: "/public"
+      additional_bindings {
+        post: "/public"
+      }
+    };
+  }
+  // Admin needs a role
+  rpc Admin (Empty) returns (Empty) {
+    option (google.api.http) = {
+      get: "/admin"
+    };
+    option (auth.policy) = {role: "admin", scopes: ["read", "write"]};
+    option (auth.policy).audit = true;
+  }
+  rpc Plain (Empty) returns (Empty) {
+    option (google.api.http) = {
+      get: "/plain"
+    };
+  }
+  rpc Bare (Empty) returns (Empty);
+}
+`
+
+func TestParseMethodOptions(t *testing.T) {
+	got, err := ParseMethodOptions(NewProtoLexer(strings.NewReader(optionsProto)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*MethodOptions{
+		{
+			Package: "options",
+			Service: "Options",
+			Method:  "Public",
+			Options: []*Option{{Name: "auth.public", Extension: "auth.public", Value: "true", Line: 11}},
+		},
+		{
+			Package: "options",
+			Service: "Options",
+			Method:  "Admin",
+			Options: []*Option{
+				{Name: "auth.policy.role", Extension: "auth.policy", Value: "admin", Line: 24},
+				{Name: "auth.policy.scopes", Extension: "auth.policy", Value: `["read","write"]`, Line: 24},
+				{Name: "auth.policy.audit", Extension: "auth.policy", Value: "true", Line: 25},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Options differ from expected:\n%s", DiffStrings(spew.Sdump(want), spew.Sdump(got), "want", "got"))
+	}
+}
+
+func TestParseExtensions(t *testing.T) {
+	r := strings.NewReader(`
+syntax = "proto3";
+package auth.v1;
+
+message Policy {
+  string message = 1;
+  option (policy.kind) = { message: "x" };
+
+  extend google.protobuf.MethodOptions {
+    Policy policy = 50002;
+  }
+}
+
+extend google.protobuf.MethodOptions {
+  // Public marks the rpcs without authentication
+  bool public = 50001 [deprecated = false];
+  repeated string scopes = 50003;
+}
+
+extend google.protobuf.FieldOptions {
+  bool secret = 50001;
+}
+
+extend .google.protobuf.MethodOptions {
+  string role = 50004;
+}
+`)
+	got, err := ParseExtensions(NewProtoLexer(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"auth.v1.Policy.policy", "auth.v1.public", "auth.v1.scopes", "auth.v1.role"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("extensions = %v, want %v", got, want)
+	}
+}
+
+func TestParseMethodOptionsUnterminated(t *testing.T) {
+	r := strings.NewReader(`
+service Broken {
+  rpc Get (Empty) returns (Empty) {
+    option (auth.public) = true
+  }
+}
+`)
+	_, err := ParseMethodOptions(NewProtoLexer(r))
+	if err == nil {
+		t.Fatal("expected an error for an unterminated method option")
+	}
+}
+
+func TestParseServiceSkipsMethodOptions(t *testing.T) {
+	svc, err := ParseService(NewSvcLexer(strings.NewReader(optionsProto)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, m := range svc.Methods {
+		names = append(names, m.Name)
+		if len(m.HTTPBindings) == 0 {
+			t.Errorf("method %q has no HTTP bindings", m.Name)
+		}
+	}
+	if got, want := names, []string{"Public", "Admin", "Plain"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("methods = %v, want = %v", got, want)
+	}
+	if got, want := len(svc.Methods[0].HTTPBindings), 2; got != want {
+		t.Errorf("bindings of Public = %d, want = %d", got, want)
+	}
+}
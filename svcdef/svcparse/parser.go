@@ -157,6 +157,8 @@ type Field struct {
 	Description string
 	Kind        string
 	Value       string
+	// Line is the line of the proto file on which Value was declared.
+	Line int
 }
 
 // ParseService will parse a proto file and return the the struct
@@ -359,6 +361,10 @@ func ParseMethod(lex *SvcLexer) (*Method, error) {
 		}
 	}
 
+	// Options other than the HTTP bindings are parsed by ParseMethodOptions
+	if err := skipMethodOptions(lex); err != nil {
+		return nil, err
+	}
 	bindings, err := ParseHttpBindings(lex)
 	if err != nil {
 		return nil, err
@@ -380,6 +386,9 @@ func ParseMethod(lex *SvcLexer) (*Method, error) {
 		}
 	}
 
+	if err := skipMethodOptions(lex); err != nil {
+		return nil, err
+	}
 	tk, val = lex.GetTokenIgnoreCommentAndWhitespace()
 	if tk != CLOSE_BRACE {
 		return nil, parserErr{
@@ -564,6 +573,7 @@ func ParseBindingFields(lex *SvcLexer) (fields []*Field, custom []*Field, err er
 			return nil, nil, errors.Wrapf(err, "cannot unquote value %q", val)
 		}
 		field.Value = noqoute
+		field.Line = lex.GetLineNumber()
 
 		fields = append(fields, field)
 		field = &Field{}
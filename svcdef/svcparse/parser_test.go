@@ -101,6 +101,7 @@ service Example_Service {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/ExamplePost",
+					Line:  10,
 				},
 			},
 		},
@@ -111,11 +112,13 @@ service Example_Service {
 					Description: "// Some example comment\n",
 					Kind:        "get",
 					Value:       "/ExampleGet",
+					Line:        6,
 				},
 				&Field{
 					Name:  "body",
 					Kind:  "body",
 					Value: "*",
+					Line:  7,
 				},
 			},
 		},
@@ -181,6 +184,7 @@ service Example_Service {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/ExamplePost",
+					Line:  10,
 				},
 			},
 		},
@@ -191,11 +195,13 @@ service Example_Service {
 					Description: "// Some example comment\n",
 					Kind:        "get",
 					Value:       "/ExampleGet",
+					Line:        6,
 				},
 				&Field{
 					Name:  "body",
 					Kind:  "body",
 					Value: "*",
+					Line:  7,
 				},
 			},
 		},
@@ -261,6 +267,7 @@ service Example_Service {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/ExamplePost",
+					Line:  10,
 				},
 			},
 		},
@@ -271,11 +278,13 @@ service Example_Service {
 					Description: "// Some example comment\n",
 					Kind:        "get",
 					Value:       "/ExampleGet",
+					Line:        6,
 				},
 				&Field{
 					Name:  "body",
 					Kind:  "body",
 					Value: "*",
+					Line:  7,
 				},
 			},
 		},
@@ -363,6 +372,7 @@ service Example_Service {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/ExamplePost",
+					Line:  10,
 				},
 			},
 		},
@@ -373,11 +383,13 @@ service Example_Service {
 					Description: "// Some example comment\n",
 					Kind:        "get",
 					Value:       "/ExampleGet",
+					Line:        6,
 				},
 				&Field{
 					Name:  "body",
 					Kind:  "body",
 					Value: "*",
+					Line:  7,
 				},
 			},
 		},
@@ -395,6 +407,7 @@ service Example_Service {
 					Description: "// Second binding, this time for post\n",
 					Kind:        "post",
 					Value:       "/ExamplePost",
+					Line:        23,
 				},
 			},
 		},
@@ -405,11 +418,13 @@ service Example_Service {
 					Description: "// Second group of example comments\n",
 					Kind:        "get",
 					Value:       "/SecondExampleGet",
+					Line:        17,
 				},
 				&Field{
 					Name:  "body",
 					Kind:  "body",
 					Value: "*",
+					Line:  18,
 				},
 			},
 		},
@@ -495,6 +510,7 @@ service FlowCombination {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/rpc/empty/stream",
+					Line:  5,
 				},
 			},
 		},
@@ -511,6 +527,7 @@ service FlowCombination {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/stream/empty/rpc",
+					Line:  10,
 				},
 			},
 		},
@@ -526,6 +543,7 @@ service FlowCombination {
 					Name:  "post",
 					Kind:  "post",
 					Value: "/stream/empty/stream",
+					Line:  15,
 				},
 			},
 		},
@@ -634,6 +652,9 @@ func TestCustomHTTPPatternFieldOrder(t *testing.T) {
 		t.Error(err)
 	}
 
+	// The fields are declared on different lines in each definition
+	clearLines(below)
+	clearLines(above)
 	if !reflect.DeepEqual(below, above) {
 		t.Log(DiffStrings(spew.Sdump(below), spew.Sdump(above), "below", "above"))
 		t.Errorf("Custom HTTP verb declaration below = %#v, above = %#v\n", below, above)
@@ -703,6 +724,7 @@ func TestCustomHTTPPatternOutputExample(t *testing.T) {
 					Name:        "body",
 					Kind:        "body",
 					Value:       "*",
+					Line:        13,
 				},
 			},
 			CustomHTTPPattern: []*Field{
@@ -711,12 +733,14 @@ func TestCustomHTTPPatternOutputExample(t *testing.T) {
 					Name:        "kind",
 					Kind:        "kind",
 					Value:       "MYVERBHERE",
+					Line:        7,
 				},
 				&Field{
 					Description: "// Likewise, path goes in the \"path\" field. As always, the path\n\t\t\t\t\t// may have parameters within it.\n",
 					Name:        "path",
 					Kind:        "path",
 					Value:       "/foo/bar/{SomeFieldName}",
+					Line:        10,
 				},
 			},
 		},
@@ -727,3 +751,14 @@ func TestCustomHTTPPatternOutputExample(t *testing.T) {
 		t.Errorf("Custom HTTP verb declaration got = %#v, want = %#v\n", got, want)
 	}
 }
+
+// clearLines zeroes the line numbers of all fields of the bindings of svc.
+func clearLines(svc *Service) {
+	for _, m := range svc.Methods {
+		for _, b := range m.HTTPBindings {
+			for _, f := range append(b.Fields, b.CustomHTTPPattern...) {
+				f.Line = 0
+			}
+		}
+	}
+}
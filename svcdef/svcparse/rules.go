@@ -0,0 +1,290 @@
+package svcparse
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// rulesOption is the name of the field option declaring validation rules, as
+// defined by protoc-gen-validate.
+const rulesOption = "(validate.rules)"
+
+// FieldRules are the validation rules declared on a single field of a
+// message.
+type FieldRules struct {
+	// Message is the name of the message declaring the field. Nested messages
+	// are joined to their parents with a ".", as in "Outer.Inner".
+	Message string
+	// Field is the name of the field in the proto file.
+	Field string
+	Rules []*Rule
+}
+
+// Rule is a single constraint of a (validate.rules) field option. Both
+//
+//	string name = 1 [(validate.rules).string.min_len = 1];
+//
+// and
+//
+//	string name = 1 [(validate.rules).string = {min_len: 1}];
+//
+// result in the Rule {Type: "string", Name: "min_len", Value: "1"}.
+type Rule struct {
+	// Type is the kind of field the constraint applies to, such as "string",
+	// "int32", "message" or "repeated".
+	Type string
+	// Name is the constraint, such as "min_len" or "required". Constraints
+	// nested within others are joined with a ".", as in "items.string.min_len".
+	Name string
+	// Value is the value of the constraint. String literals are unquoted.
+	Value string
+	// Line is the line of the proto file on which Value was declared.
+	Line int
+}
+
+// block is a brace delimited block of a proto file
+type block struct {
+	kind string
+	name string
+}
+
+// ParseFieldRules returns the validation rules declared on the fields of every
+// message read by lex, which should be created with NewProtoLexer. Fields
+// without validation rules are not returned.
+func ParseFieldRules(lex *SvcLexer) ([]*FieldRules, error) {
+	var rv []*FieldRules
+	var blocks []block
+	// The last three tokens, ignoring comments and whitespace, used to
+	// identify blocks and fields
+	var prev [3]string
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case tk == EOF:
+			return rv, nil
+		case tk == ILLEGAL:
+			return nil, parserErr{
+				expected: "legal token while parsing messages",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		case tk == OPEN_BRACE:
+			switch prev[1] {
+			case "message", "oneof", "enum", "service", "extend":
+				blocks = append(blocks, block{kind: prev[1], name: prev[2]})
+			default:
+				blocks = append(blocks, block{})
+			}
+		case tk == CLOSE_BRACE:
+			if len(blocks) == 0 {
+				return nil, parserErr{
+					expected: "no '}' outside of a block",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			blocks = blocks[:len(blocks)-1]
+		case val == "[" && prev[1] == "=" && inMessage(blocks):
+			rules, err := parseFieldOptions(lex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot parse options of field %q", prev[0])
+			}
+			if len(rules) > 0 {
+				rv = append(rv, &FieldRules{
+					Message: messageName(blocks),
+					Field:   prev[0],
+					Rules:   rules,
+				})
+			}
+		}
+		prev[0], prev[1], prev[2] = prev[1], prev[2], val
+	}
+}
+
+// inMessage reports whether the innermost block is a message, or a oneof
+// within a message, where fields are declared.
+func inMessage(blocks []block) bool {
+	if len(blocks) == 0 {
+		return false
+	}
+	kind := blocks[len(blocks)-1].kind
+	return kind == "message" || kind == "oneof"
+}
+
+// messageName returns the name of the innermost message of blocks, joined
+// with the names of the messages it is nested in.
+func messageName(blocks []block) string {
+	var names []string
+	for _, b := range blocks {
+		if b.kind == "message" {
+			names = append(names, b.name)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// parseFieldOptions parses the options of a field following the opening '['
+// up to and including the closing ']', returning the validation rules among
+// them.
+func parseFieldOptions(lex *SvcLexer) ([]*Rule, error) {
+	var rules []*Rule
+	for {
+		var name string
+		for {
+			tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+			if tk == EOF || tk == ILLEGAL || val == "]" {
+				return nil, parserErr{
+					expected: "'=' after field option name",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			if val == "=" {
+				break
+			}
+			name += val
+		}
+
+		path := ""
+		if name != rulesOption && !strings.HasPrefix(name, rulesOption+".") {
+			// Other options are parsed only to be skipped
+			path = "-"
+		} else if name != rulesOption {
+			path = strings.TrimPrefix(name, rulesOption+".")
+		}
+
+		found, err := parseOptionValue(lex, path)
+		if err != nil {
+			return nil, err
+		}
+		if path != "-" {
+			rules = append(rules, found...)
+		}
+
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case val == "]":
+			return rules, nil
+		case val == ",":
+			continue
+		default:
+			return nil, parserErr{
+				expected: "',' or ']' after field option",
+				line:     lex.GetLineNumber(),
+				val:      tk.String() + " " + val,
+			}
+		}
+	}
+}
+
+// parseOptionValue parses the value of the option at path, either a scalar or
+// an aggregate within braces, and returns a Rule for each scalar within it.
+func parseOptionValue(lex *SvcLexer, path string) ([]*Rule, error) {
+	tk, _ := lex.GetTokenIgnoreCommentAndWhitespace()
+	if tk == OPEN_BRACE {
+		return parseAggregate(lex, path)
+	}
+	lex.UnGetToken()
+
+	value, err := parseScalar(lex)
+	if err != nil {
+		return nil, err
+	}
+	ruleType, ruleName := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		ruleType, ruleName = path[:i], path[i+1:]
+	}
+	return []*Rule{{
+		Type:  ruleType,
+		Name:  ruleName,
+		Value: value,
+		Line:  lex.GetLineNumber(),
+	}}, nil
+}
+
+// parseAggregate parses the fields of an aggregate value in the protobuf text
+// format following the opening '{' up to and including the closing '}'.
+func parseAggregate(lex *SvcLexer, path string) ([]*Rule, error) {
+	var rules []*Rule
+	for {
+		tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+		switch {
+		case tk == CLOSE_BRACE:
+			return rules, nil
+		case val == "," || val == ";":
+			continue
+		case tk != IDENT:
+			return nil, parserErr{
+				expected: "field name in aggregate value",
+				line:     lex.GetLineNumber(),
+				val:      val,
+			}
+		}
+
+		key := val
+		if path != "" {
+			key = path + "." + val
+		}
+		// The ':' is optional before values which are messages
+		tk, val = lex.GetTokenIgnoreCommentAndWhitespace()
+		if val != ":" {
+			lex.UnGetToken()
+		}
+
+		found, err := parseOptionValue(lex, key)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, found...)
+	}
+}
+
+// parseScalar parses a scalar option value, which is either a string literal
+// or the run of tokens up to the next whitespace or separator, such as "-1.5"
+// or "true". Lists of values are returned as written.
+func parseScalar(lex *SvcLexer) (string, error) {
+	tk, val := lex.GetTokenIgnoreCommentAndWhitespace()
+	switch {
+	case tk == STRING_LITERAL:
+		s, err := strconv.Unquote(val)
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot unquote value %q", val)
+		}
+		return s, nil
+	case val == "[":
+		list := val
+		for val != "]" {
+			tk, val = lex.GetTokenIgnoreCommentAndWhitespace()
+			if tk == EOF || tk == ILLEGAL {
+				return "", parserErr{
+					expected: "']' closing list value",
+					line:     lex.GetLineNumber(),
+					val:      val,
+				}
+			}
+			list += val
+		}
+		return list, nil
+	case tk != IDENT && tk != SYMBOL:
+		return "", parserErr{
+			expected: "option value",
+			line:     lex.GetLineNumber(),
+			val:      val,
+		}
+	}
+
+	value := val
+	for {
+		tk, val = lex.GetToken()
+		if tk == EOF {
+			return value, nil
+		}
+		if (tk != IDENT && tk != SYMBOL) || strings.Contains(",;]", val) {
+			lex.UnGetToken()
+			return value, nil
+		}
+		value += val
+	}
+}
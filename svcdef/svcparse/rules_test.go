@@ -0,0 +1,102 @@
+package svcparse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestParseFieldRules(t *testing.T) {
+	r := strings.NewReader(`
+syntax = "proto3";
+package rules;
+
+// Outer has rules in both forms
+message Outer {
+  string name = 1 [(validate.rules).string = {min_len: 1, pattern: "^[a-z]+\\d*$"}];
+  int32 age = 2 [deprecated = true, (validate.rules).int32.gte = -5];
+  message Inner {
+    double ratio = 1 [(validate.rules).double = {gt: 0.5 lte: 1e3}];
+  }
+  Inner inner = 3 [(validate.rules).message.required = true];
+  oneof id {
+    string label = 4 [(validate.rules).string = {prefix: "l-"}];
+  }
+  repeated string tags = 5 [(validate.rules).repeated = {items {string {min_len: 2}}}];
+  string free = 6 [json_name = "loose"];
+}
+
+enum Status {
+  UNKNOWN = 0 [deprecated = true];
+}
+
+service Rules {
+  rpc Get (Outer) returns (Outer) {
+    option (google.api.http) = {
+      get: "/get"
+    };
+  }
+}
+// The file may end with a comment`)
+
+	got, err := ParseFieldRules(NewProtoLexer(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*FieldRules{
+		{
+			Message: "Outer",
+			Field:   "name",
+			Rules: []*Rule{
+				{Type: "string", Name: "min_len", Value: "1", Line: 7},
+				{Type: "string", Name: "pattern", Value: `^[a-z]+\d*$`, Line: 7},
+			},
+		},
+		{
+			Message: "Outer",
+			Field:   "age",
+			Rules:   []*Rule{{Type: "int32", Name: "gte", Value: "-5", Line: 8}},
+		},
+		{
+			Message: "Outer.Inner",
+			Field:   "ratio",
+			Rules: []*Rule{
+				{Type: "double", Name: "gt", Value: "0.5", Line: 10},
+				{Type: "double", Name: "lte", Value: "1e3", Line: 10},
+			},
+		},
+		{
+			Message: "Outer",
+			Field:   "inner",
+			Rules:   []*Rule{{Type: "message", Name: "required", Value: "true", Line: 12}},
+		},
+		{
+			Message: "Outer",
+			Field:   "label",
+			Rules:   []*Rule{{Type: "string", Name: "prefix", Value: "l-", Line: 14}},
+		},
+		{
+			Message: "Outer",
+			Field:   "tags",
+			Rules:   []*Rule{{Type: "repeated", Name: "items.string.min_len", Value: "2", Line: 16}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Rules differ from expected:\n%s", DiffStrings(spew.Sdump(want), spew.Sdump(got), "want", "got"))
+	}
+}
+
+func TestParseFieldRulesUnclosedOptions(t *testing.T) {
+	r := strings.NewReader(`
+message Broken {
+  string name = 1 [(validate.rules).string.min_len = 1;
+}
+`)
+	_, err := ParseFieldRules(NewProtoLexer(r))
+	if err == nil {
+		t.Fatal("expected an error for unclosed field options")
+	}
+}
@@ -260,6 +260,9 @@ type SvcScanner struct {
 	Buf          []*ScanUnit
 	UnitPos      int
 	lineNo       int
+	// wholeFile disables fast forwarding, so the whole input is scanned
+	// rather than only the service definitions.
+	wholeFile bool
 }
 
 func NewSvcScanner(r io.Reader) *SvcScanner {
@@ -287,7 +290,7 @@ func NewSvcScanner(r io.Reader) *SvcScanner {
 // beginning of the next service definition. If the scanner is in the middle of
 // an existing service definition, this method will do nothing.
 func (self *SvcScanner) FastForward() error {
-	if self.InBody || self.InDefinition {
+	if self.InBody || self.InDefinition || self.wholeFile {
 		return nil
 	}
 	search_str := string("service")
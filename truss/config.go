@@ -14,6 +14,9 @@ type Config struct {
 	ServicePackage string
 	ServicePath    string
 
+	// Whether the handler of each new rpc is written to a file of its own
+	HandlersPerRPC bool
+
 	// The paths to each of the .proto files truss is being run against
 	DefPaths []string
 	// The files of a previously generated service, may be nil
@@ -20,6 +20,7 @@ func GeneratePBDotGo(protoPaths, gopath []string, outDir string) error {
 	genGoCode := "--gogofaster_out=" +
 		"Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types," +
+		"Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types," +
@@ -0,0 +1,234 @@
+// Package inspect provides a machine readable view of what truss understood
+// from a set of protobuf definition files. It is the output of the `truss
+// inspect` subcommand, and is meant both for debugging generation and for
+// tooling which would otherwise have to parse the definition files itself.
+package inspect
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/metaverse/truss/gengokit/httptransport"
+	"github.com/metaverse/truss/svcdef"
+	"github.com/metaverse/truss/truss/parsesvcdef"
+)
+
+// Definition is everything truss derives from a service definition before
+// generating code: the Svcdef itself and the helper used to template the
+// HTTP transport.
+type Definition struct {
+	Svcdef     *Svcdef
+	HTTPHelper *httptransport.Helper
+}
+
+// Svcdef mirrors svcdef.Svcdef. Messages and enums are listed once, at the
+// top level, and referred to by name everywhere else so that recursive
+// messages can be written out.
+type Svcdef struct {
+	PkgName  string
+	Messages []*Message
+	Enums    []*svcdef.Enum
+	Service  *Service
+}
+
+// Message mirrors svcdef.Message.
+type Message struct {
+	Name        string
+	Description string `json:",omitempty"`
+	Fields      []*Field
+}
+
+// Field mirrors svcdef.Field.
+type Field struct {
+	Name        string
+	PBFieldName string
+	JSONName    string
+	Description string `json:",omitempty"`
+	Type        *FieldType
+	// Rules are the validation rules of the field.
+	Rules []*svcdef.Rule `json:",omitempty"`
+}
+
+// FieldType mirrors svcdef.FieldType. Enum and Message hold the name of the
+// enum or message, and are empty if the type is neither.
+type FieldType struct {
+	Name      string
+	Enum      string   `json:",omitempty"`
+	Message   string   `json:",omitempty"`
+	Map       *Map     `json:",omitempty"`
+	Oneof     []*Field `json:",omitempty"`
+	StarExpr  bool
+	ArrayType bool
+}
+
+// Map mirrors svcdef.Map.
+type Map struct {
+	KeyType   *FieldType
+	ValueType *FieldType
+}
+
+// Service mirrors svcdef.Service.
+type Service struct {
+	Name    string
+	Methods []*ServiceMethod
+}
+
+// ServiceMethod mirrors svcdef.ServiceMethod.
+type ServiceMethod struct {
+	Name         string
+	RequestType  *FieldType
+	ResponseType *FieldType
+	Bindings     []*HTTPBinding
+	Options      map[string]string `json:",omitempty"`
+}
+
+// HTTPBinding mirrors svcdef.HTTPBinding.
+type HTTPBinding struct {
+	Verb   string
+	Path   string
+	Params []*HTTPParameter
+}
+
+// HTTPParameter mirrors svcdef.HTTPParameter, naming the field of the request
+// it refers to.
+type HTTPParameter struct {
+	Field    string
+	Location string
+}
+
+// New returns the Definition of sd.
+func New(sd *svcdef.Svcdef) *Definition {
+	rv := Definition{
+		Svcdef: &Svcdef{
+			PkgName: sd.PkgName,
+		},
+	}
+	for _, m := range sd.Messages {
+		rv.Svcdef.Messages = append(rv.Svcdef.Messages, newMessage(m))
+	}
+	rv.Svcdef.Enums = sd.Enums
+	if sd.Service != nil {
+		rv.Svcdef.Service = newService(sd.Service)
+		rv.HTTPHelper = httptransport.NewHelper(sd.Service)
+	}
+	return &rv
+}
+
+// FromPaths accepts the paths of protobuf definition files and returns the
+// Definition of the service they describe. The .pb.go files needed to do so
+// are generated in a temporary directory, leaving the definition directory
+// untouched.
+func FromPaths(gopath []string, protoDefPaths []string) (*Definition, error) {
+	sd, err := parsesvcdef.FromPaths(gopath, protoDefPaths)
+	if err != nil {
+		return nil, err
+	}
+	return New(sd), nil
+}
+
+// Write writes d to w in format, which is either "json" or "yaml".
+func (d *Definition) Write(w io.Writer, format string) error {
+	out, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal definition to JSON")
+	}
+
+	switch format {
+	case "json":
+		out = append(out, '\n')
+	case "yaml":
+		// JSON is valid YAML, so converting the JSON keeps the field names
+		// and their order the same in both formats
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(out, &doc); err != nil {
+			return errors.Wrap(err, "cannot convert definition to YAML")
+		}
+		if out, err = yaml.Marshal(doc); err != nil {
+			return errors.Wrap(err, "cannot marshal definition to YAML")
+		}
+	default:
+		return errors.Errorf("unknown format %q, must be json or yaml", format)
+	}
+
+	_, err = w.Write(out)
+	return err
+}
+
+func newService(svc *svcdef.Service) *Service {
+	rv := Service{Name: svc.Name}
+	for _, m := range svc.Methods {
+		meth := ServiceMethod{
+			Name:         m.Name,
+			RequestType:  newFieldType(m.RequestType),
+			ResponseType: newFieldType(m.ResponseType),
+			Options:      m.Options,
+		}
+		for _, b := range m.Bindings {
+			bind := HTTPBinding{
+				Verb: b.Verb,
+				Path: b.Path,
+			}
+			for _, p := range b.Params {
+				bind.Params = append(bind.Params, &HTTPParameter{
+					Field:    p.Field.Name,
+					Location: p.Location,
+				})
+			}
+			meth.Bindings = append(meth.Bindings, &bind)
+		}
+		rv.Methods = append(rv.Methods, &meth)
+	}
+	return &rv
+}
+
+func newMessage(m *svcdef.Message) *Message {
+	rv := Message{
+		Name:        m.Name,
+		Description: m.Description,
+	}
+	for _, f := range m.Fields {
+		rv.Fields = append(rv.Fields, newField(f))
+	}
+	return &rv
+}
+
+func newField(f *svcdef.Field) *Field {
+	return &Field{
+		Name:        f.Name,
+		PBFieldName: f.PBFieldName,
+		JSONName:    f.JSONName,
+		Description: f.Description,
+		Type:        newFieldType(f.Type),
+		Rules:       f.Rules,
+	}
+}
+
+func newFieldType(ft *svcdef.FieldType) *FieldType {
+	if ft == nil {
+		return nil
+	}
+	rv := FieldType{
+		Name:      ft.Name,
+		StarExpr:  ft.StarExpr,
+		ArrayType: ft.ArrayType,
+	}
+	if ft.Enum != nil {
+		rv.Enum = ft.Enum.Name
+	}
+	if ft.Message != nil {
+		rv.Message = ft.Message.Name
+	}
+	if ft.Map != nil {
+		rv.Map = &Map{
+			KeyType:   newFieldType(ft.Map.KeyType),
+			ValueType: newFieldType(ft.Map.ValueType),
+		}
+	}
+	for _, f := range ft.Oneof {
+		rv.Oneof = append(rv.Oneof, newField(f))
+	}
+	return &rv
+}
@@ -0,0 +1,163 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/metaverse/truss/svcdef"
+)
+
+const def = `
+syntax = "proto3";
+package inspect;
+
+import "github.com/metaverse/truss/deftree/googlethirdparty/annotations.proto";
+import "github.com/metaverse/truss/deftree/googlethirdparty/descriptor.proto";
+
+extend google.protobuf.MethodOptions {
+  string role = 50001;
+}
+
+enum Status {
+  UNKNOWN = 0;
+  // ACTIVE nodes are shown
+  ACTIVE = 1;
+}
+
+// Node is a node of the tree
+message Node {
+  // Name is unique within the tree
+  string name = 1;
+  Status status = 2;
+  repeated Node children = 3;
+  map<string, int64> counts = 4;
+  oneof id {
+    int64 number = 5;
+    string label = 6;
+  }
+}
+
+service Tree {
+  rpc GetNode (Node) returns (Node) {
+    option (google.api.http) = {
+      get: "/node/{name}"
+    };
+    option (role) = "admin";
+  }
+}
+`
+
+func newDefinition(t *testing.T) *Definition {
+	sd, err := svcdef.NewFromString(def, filepath.SplitList(os.Getenv("GOPATH")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(sd)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newDefinition(t).Write(&buf, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got Definition
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("cannot unmarshal output: %v\n%s", err, buf.String())
+	}
+
+	var node *Message
+	for _, m := range got.Svcdef.Messages {
+		if m.Name == "Node" {
+			node = m
+		}
+	}
+	if node == nil {
+		t.Fatalf("message Node not in output:\n%s", buf.String())
+	}
+	if node.Description != "Node is a node of the tree" {
+		t.Errorf("Node: unexpected description %q", node.Description)
+	}
+	types := map[string]*FieldType{}
+	for _, f := range node.Fields {
+		types[f.Name] = f.Type
+		if f.Name == "Name" && f.Description != "Name is unique within the tree" {
+			t.Errorf("Name: unexpected description %q", f.Description)
+		}
+	}
+	if ft := types["Children"]; ft == nil || ft.Message != "Node" || !ft.ArrayType {
+		t.Errorf("Children: want repeated Node, got %+v", ft)
+	}
+	if ft := types["Status"]; ft == nil || ft.Enum != "Status" {
+		t.Errorf("Status: want enum Status, got %+v", ft)
+	}
+	if ft := types["Counts"]; ft == nil || ft.Map == nil || ft.Map.KeyType.Name != "string" || ft.Map.ValueType.Name != "int64" {
+		t.Errorf("Counts: want map of string to int64, got %+v", ft)
+	}
+	if ft := types["Id"]; ft == nil || len(ft.Oneof) != 2 {
+		t.Errorf("Id: want oneof with two fields, got %+v", ft)
+	}
+
+	if e := got.Svcdef.Enums; len(e) != 1 || len(e[0].Values) != 2 ||
+		e[0].Values[1].Name != "ACTIVE" || e[0].Values[1].Number != 1 || e[0].Values[1].Description != "ACTIVE nodes are shown" {
+		t.Errorf("Enums: unexpected values %+v", e)
+	}
+
+	meth := got.Svcdef.Service.Methods[0]
+	if meth.Bindings[0].Verb != "get" || meth.Bindings[0].Path != "/node/{name}" {
+		t.Errorf("binding: want get /node/{name}, got %+v", meth.Bindings[0])
+	}
+	if meth.Options["inspect.role"] != "admin" {
+		t.Errorf("Options: want inspect.role admin, got %v", meth.Options)
+	}
+	for _, p := range meth.Bindings[0].Params {
+		if p.Field == "Name" && p.Location != "path" {
+			t.Errorf("Name: want location path, got %q", p.Location)
+		}
+	}
+
+	if m := got.HTTPHelper.Methods; len(m) != 1 || m[0].Bindings[0].PathTemplate != "/node/{name}" {
+		t.Errorf("HTTPHelper: unexpected methods %+v", m)
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	d := newDefinition(t)
+	var jsonOut, yamlOut bytes.Buffer
+	if err := d.Write(&jsonOut, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Write(&yamlOut, "yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(yamlOut.String(), "Svcdef:\n") {
+		t.Errorf("YAML does not start with the Svcdef key:\n%s", yamlOut.String())
+	}
+
+	// Both formats must hold the same document
+	var fromJSON, fromYAML interface{}
+	if err := yaml.Unmarshal(jsonOut.Bytes(), &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if err := yaml.Unmarshal(yamlOut.Bytes(), &fromYAML); err != nil {
+		t.Fatal(err)
+	}
+	a, _ := yaml.Marshal(fromJSON)
+	b, _ := yaml.Marshal(fromYAML)
+	if !bytes.Equal(a, b) {
+		t.Errorf("JSON and YAML output differ:\n%s\n%s", a, b)
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	err := newDefinition(t).Write(&bytes.Buffer{}, "xml")
+	if err == nil || !strings.Contains(err.Error(), `unknown format "xml"`) {
+		t.Errorf("want unknown format error, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Package parsesvcdef creates the svcdef of the service described by a set of
+// protobuf definition files, as both the generation of a service and `truss
+// inspect` need it.
+package parsesvcdef
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/metaverse/truss/svcdef"
+	"github.com/metaverse/truss/truss/execprotoc"
+)
+
+// FromPaths accepts the paths of protobuf definition files and returns the
+// svcdef of the service they describe. The .pb.go files needed to do so are
+// generated in a temporary directory, leaving the definition directory
+// untouched.
+func FromPaths(gopath []string, protoDefPaths []string) (*svcdef.Svcdef, error) {
+	td, err := ioutil.TempDir("", "parsesvcdef")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory for .pb.go files")
+	}
+	defer os.RemoveAll(td)
+
+	err = execprotoc.GeneratePBDotGo(protoDefPaths, gopath, td)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate .pb.go files from proto definition files")
+	}
+
+	return FromPBGoDir(td, protoDefPaths)
+}
+
+// FromPBGoDir returns the svcdef of the service described by the protobuf
+// definition files at protoDefPaths, whose .pb.go files were already
+// generated in pbgoDir.
+func FromPBGoDir(pbgoDir string, protoDefPaths []string) (*svcdef.Svcdef, error) {
+	pbgoPaths := []string{}
+	for _, p := range protoDefPaths {
+		base := filepath.Base(p)
+		barename := strings.TrimSuffix(base, filepath.Ext(p))
+		pbgoPaths = append(pbgoPaths, filepath.Join(pbgoDir, barename+".pb.go"))
+	}
+
+	pbgoFiles, closePBGo, err := openFiles(pbgoPaths)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open all .pb.go files")
+	}
+	defer closePBGo()
+
+	pbFiles, closePB, err := openFiles(protoDefPaths)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open all .proto files")
+	}
+	defer closePB()
+
+	sd, err := svcdef.New(pbgoFiles, pbFiles)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create service definition; did you pass ALL the protobuf files to truss?")
+	}
+
+	return sd, nil
+}
+
+// openFiles opens the files at paths, keyed by path, returning a func which
+// closes them.
+func openFiles(paths []string) (map[string]io.Reader, func(), error) {
+	var files []*os.File
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+
+	rv := map[string]io.Reader{}
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if err != nil {
+			closeAll()
+			return nil, nil, errors.Wrapf(err, "cannot open file %q", p)
+		}
+		files = append(files, f)
+		rv[p] = f
+	}
+	return rv, closeAll, nil
+}
@@ -0,0 +1,51 @@
+package parsesvcdef
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFromPaths(t *testing.T) {
+	protoStr := `
+	syntax = "proto3";
+	package echo;
+
+	service BounceEcho {
+	  rpc Echo (EchoRequest) returns (EchoResponse) {}
+	}
+	message EchoRequest {
+	  string In = 1;
+	}
+	message EchoResponse {
+	  string Out = 1;
+	}
+	`
+	protoDir, err := ioutil.TempDir("", "parsesvcdef-test")
+	if err != nil {
+		t.Fatal("cannot create temp directory to store proto definition: ", err)
+	}
+	defer os.RemoveAll(protoDir)
+	path := filepath.Join(protoDir, "echo.proto")
+	if err := ioutil.WriteFile(path, []byte(protoStr), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sd, err := FromPaths([]string{os.Getenv("GOPATH")}, []string{path})
+	if err != nil {
+		t.Fatal("failed to create the svcdef from path: ", err)
+	}
+	if sd.Service == nil || sd.Service.Name != "BounceEcho" {
+		t.Fatalf("service = %+v, want BounceEcho", sd.Service)
+	}
+	if got := len(sd.Service.Methods); got != 1 {
+		t.Errorf("got %d methods, want 1", got)
+	}
+}
+
+func TestFromPBGoDirMissingFiles(t *testing.T) {
+	if _, err := FromPBGoDir(os.TempDir(), []string{"does-not-exist.proto"}); err == nil {
+		t.Error("FromPBGoDir of missing files did not fail")
+	}
+}